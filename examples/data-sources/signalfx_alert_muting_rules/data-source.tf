@@ -0,0 +1,12 @@
+# Lists the active and upcoming muting rules for the detector.
+data "signalfx_alert_muting_rules" "maintenance" {
+  detector_id = signalfx_detector.application.id
+}
+
+# Fails the plan while a maintenance window is active.
+check "no_active_maintenance" {
+  assert {
+    condition     = length([for r in data.signalfx_alert_muting_rules.maintenance.rules : r if r.active]) == 0
+    error_message = "Deploys are paused while a maintenance window is active."
+  }
+}
@@ -0,0 +1,9 @@
+# Lists the Kubernetes metrics that automated archival would archive.
+data "signalfx_automated_archival_candidates" "k8s" {
+  name_pattern = "k8s.*"
+}
+
+# Exempts the node metrics that the platform team relies on outside of charts and detectors.
+resource "signalfx_automated_archival_exempt_metrics" "k8s" {
+  names = [for name in data.signalfx_automated_archival_candidates.k8s.names : name if startswith(name, "k8s.node.")]
+}
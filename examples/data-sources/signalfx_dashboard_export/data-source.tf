@@ -0,0 +1,34 @@
+# Reads a dashboard that was exported as JSON from the UI.
+data "signalfx_dashboard_export" "example" {
+  path = "${path.module}/dashboards/hosts.json"
+}
+
+# Each of the exported time charts is created using the exported arguments.
+resource "signalfx_time_chart" "exported" {
+  for_each = {
+    for id, chart in data.signalfx_dashboard_export.example.charts : id => chart.arguments
+    if chart.resource == "signalfx_time_chart"
+  }
+
+  name         = each.value.name
+  program_text = each.value.program_text
+  plot_type    = try(each.value.plot_type, null)
+  time_range   = try(each.value.time_range, null)
+}
+
+resource "signalfx_dashboard" "exported" {
+  name            = data.signalfx_dashboard_export.example.dashboard.name
+  dashboard_group = signalfx_dashboard_group.example.id
+
+  dynamic "chart" {
+    for_each = data.signalfx_dashboard_export.example.dashboard.chart
+    content {
+      # The dashboard refers to the charts by their exported ID.
+      chart_id = signalfx_time_chart.exported[chart.value.chart_id].id
+      row      = try(chart.value.row, null)
+      column   = try(chart.value.column, null)
+      width    = try(chart.value.width, null)
+      height   = try(chart.value.height, null)
+    }
+  }
+}
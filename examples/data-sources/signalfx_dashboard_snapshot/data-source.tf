@@ -0,0 +1,22 @@
+# Reads the dashboard from the staging organization.
+data "signalfx_dashboard_snapshot" "staging" {
+  provider     = signalfx.staging
+  dashboard_id = "ABCDEFGHIJK"
+}
+
+# The snapshot uses the same format as the dashboards exported from the UI,
+# so it can be converted into resource arguments for the production organization.
+locals {
+  promoted = provider::signalfx::decode_dashboard_export(data.signalfx_dashboard_snapshot.staging.json)
+}
+
+resource "signalfx_text_chart" "promoted" {
+  provider = signalfx.production
+  for_each = {
+    for ref, chart in local.promoted.charts : ref => chart.arguments
+    if chart.resource == "signalfx_text_chart"
+  }
+
+  name     = each.value.name
+  markdown = each.value.markdown
+}
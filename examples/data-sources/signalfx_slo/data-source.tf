@@ -0,0 +1,7 @@
+data "signalfx_slo" "checkout" {
+  name = "Checkout availability"
+}
+
+output "checkout_error_budget" {
+  value = one(data.signalfx_slo.checkout.target[0].status[*].remaining_error_budget)
+}
@@ -0,0 +1,16 @@
+# Lists the SLOs owned by the payments team.
+data "signalfx_slos" "payments" {
+  tags = ["team:payments"]
+}
+
+# Fails the plan when an SLO has spent its error budget.
+check "payments_error_budget" {
+  assert {
+    condition = alltrue([
+      for s in data.signalfx_slos.payments.slos : alltrue([
+        for t in s.target : alltrue([for st in t.status : st.remaining_error_budget > 0])
+      ])
+    ])
+    error_message = "A payments SLO has spent its error budget, deploys are paused."
+  }
+}
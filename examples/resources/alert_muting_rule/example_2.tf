@@ -0,0 +1,18 @@
+resource "signalfx_alert_muting_rule" "patching" {
+  description = "Monthly patching window"
+
+  detectors = [signalfx_detector.some_detector.id]
+
+  schedule {
+    timezone = "America/New_York"
+    start    = "23:00"
+    end      = "02:00"
+
+    monthly {
+      week = 2
+      day  = "TUE"
+    }
+
+    exclude_dates = ["2026-12-08"]
+  }
+}
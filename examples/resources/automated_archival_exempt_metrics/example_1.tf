@@ -0,0 +1,6 @@
+resource "signalfx_automated_archival_exempt_metrics" "platform" {
+  names = [
+    "k8s.node.cpu.utilization",
+    "k8s.node.memory.usage",
+  ]
+}
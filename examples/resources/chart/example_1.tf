@@ -0,0 +1,23 @@
+resource "signalfx_chart" "cpu" {
+  type = "time"
+  name = "CPU Total Idle"
+
+  program_text = <<-EOF
+        data("cpu.total.idle").publish(label="CPU Idle")
+        EOF
+
+  time_range = 3600
+  plot_type  = "LineChart"
+
+  viz_options = [
+    {
+      label = "CPU Idle"
+      axis  = "left"
+      color = "orange"
+    },
+  ]
+
+  time_options = {
+    show_data_markers = true
+  }
+}
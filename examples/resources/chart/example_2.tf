@@ -0,0 +1,31 @@
+# The chart was previously managed as a `signalfx_list_chart`,
+# the moved block updates the state without recreating the chart.
+moved {
+  from = signalfx_list_chart.hosts
+  to   = signalfx_chart.hosts
+}
+
+resource "signalfx_chart" "hosts" {
+  type = "list"
+  name = "Hosts by CPU"
+
+  program_text = <<-EOF
+        data("cpu.utilization").publish(label="CPU")
+        EOF
+
+  color_by = "Scale"
+  color_scale = [
+    {
+      gt    = 80
+      color = "red"
+    },
+    {
+      lte   = 80
+      color = "green"
+    },
+  ]
+
+  list_options = {
+    sort_by = "-value"
+  }
+}
@@ -0,0 +1,33 @@
+resource "signalfx_dashboard" "inline_example" {
+  name            = "Inline"
+  dashboard_group = signalfx_dashboard_group.example.id
+  time_range      = "-15m"
+
+  chart_definition {
+    key   = "cpu"
+    width = 6
+
+    time_chart {
+      name         = "CPU Total Idle"
+      program_text = <<-EOF
+        data("cpu.total.idle").publish(label="CPU Idle")
+        EOF
+      plot_type    = "LineChart"
+    }
+  }
+
+  chart_definition {
+    key    = "notes"
+    column = 6
+    width  = 6
+
+    text_chart {
+      name     = "Notes"
+      markdown = "CPU usage for all hosts"
+    }
+  }
+}
+
+output "cpu_chart_id" {
+  value = signalfx_dashboard.inline_example.chart_definition[0].chart_id
+}
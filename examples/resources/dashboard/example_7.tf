@@ -0,0 +1,36 @@
+resource "signalfx_dashboard" "layout_example" {
+  name            = "Layout"
+  dashboard_group = signalfx_dashboard_group.example.id
+
+  layout {
+    section {
+      name = "Overview"
+
+      row {
+        chart_ids = [signalfx_time_chart.cpu.id, signalfx_single_value_chart.errors.id]
+        widths    = [3, 1]
+        height    = 2
+      }
+    }
+
+    section {
+      name = "Hosts"
+
+      flow {
+        chart_id = signalfx_time_chart.memory.id
+        width    = 6
+        height   = 2
+      }
+
+      flow {
+        chart_id = signalfx_time_chart.disk.id
+        width    = 6
+      }
+
+      flow {
+        chart_id = signalfx_time_chart.network.id
+        width    = 6
+      }
+    }
+  }
+}
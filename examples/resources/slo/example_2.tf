@@ -0,0 +1,29 @@
+resource "signalfx_slo" "checkout" {
+  name = "checkout SLO"
+  type = "RequestBased"
+
+  input {
+    program_text       = "G = data('spans.count', filter=filter('sf_error', 'false') and filter('sf_service', 'checkout'))\nT = data('spans.count', filter=filter('sf_service', 'checkout'))"
+    good_events_label  = "G"
+    total_events_label = "T"
+  }
+
+  target {
+    type              = "RollingWindow"
+    slo               = 99.9
+    compliance_period = "30d"
+
+    alert_rule {
+      type = "BREACH"
+
+      rule {
+        severity = "Warning"
+      }
+    }
+
+    # Pages on a fast burn (1h and 5m windows at 14.4x) or a slow burn (6h and 30m windows at 6x).
+    burn_rate_policy {
+      notifications = ["PagerDuty,credentialId"]
+    }
+  }
+}
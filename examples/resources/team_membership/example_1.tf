@@ -0,0 +1,7 @@
+# Adds the on-call engineers to a team that is managed by another stack.
+resource "signalfx_team_membership" "oncall" {
+  for_each = toset(["alice@example.com", "bob@example.com"])
+
+  team_id = var.platform_team_id
+  email   = each.value
+}
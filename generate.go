@@ -0,0 +1,104 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/signalfx/signalfx-go"
+
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	sfx "github.com/splunk-terraform/terraform-provider-signalfx/signalfx"
+)
+
+// GenerateCommand is the subcommand used to write the configuration for existing objects.
+const GenerateCommand = "generate"
+
+type stringValues []string
+
+func (sv *stringValues) String() string {
+	return strings.Join(*sv, ",")
+}
+
+func (sv *stringValues) Set(v string) error {
+	*sv = append(*sv, v)
+	return nil
+}
+
+// runGenerate fetches the requested objects and writes them as terraform configuration.
+// The credentials are read from the same environment variables that the provider uses.
+func runGenerate(ctx context.Context, args []string, stdout io.Writer) error {
+	var (
+		fs         = flag.NewFlagSet(GenerateCommand, flag.ContinueOnError)
+		groups     stringValues
+		dashboards stringValues
+		detectors  stringValues
+		output     = fs.String("output", "", "File to write the generated configuration to, defaults to stdout")
+	)
+	fs.Var(&groups, "dashboard-group", "ID of a dashboard group to generate, along with its dashboards, charts and detectors (repeatable)")
+	fs.Var(&dashboards, "dashboard", "ID of a dashboard to generate, along with its charts and detectors (repeatable)")
+	fs.Var(&detectors, "detector", "ID of a detector to generate (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if len(groups)+len(dashboards)+len(detectors) == 0 {
+		return errors.New("at least one dashboard group, dashboard or detector must be provided")
+	}
+
+	meta := &pmeta.Meta{
+		AuthToken: os.Getenv("SFX_AUTH_TOKEN"),
+		APIURL:    os.Getenv("SFX_API_URL"),
+	}
+	if meta.APIURL == "" {
+		meta.APIURL = "https://api.signalfx.com"
+	}
+	if err := meta.Validate(); err != nil {
+		return err
+	}
+
+	client, err := signalfx.NewClient(meta.AuthToken, signalfx.APIUrl(meta.APIURL))
+	if err != nil {
+		return err
+	}
+	meta.Client = client
+
+	gen := sfx.NewConfigGenerator(meta)
+	for _, id := range groups {
+		if err := gen.AddDashboardGroup(ctx, id); err != nil {
+			return err
+		}
+	}
+	for _, id := range dashboards {
+		if err := gen.AddDashboard(ctx, id); err != nil {
+			return err
+		}
+	}
+	for _, id := range detectors {
+		if err := gen.AddDetector(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	w := stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if _, err := gen.WriteTo(w); err != nil {
+		return fmt.Errorf("unable to write configuration: %w", err)
+	}
+	return nil
+}
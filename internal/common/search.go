@@ -0,0 +1,44 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultSearchPageSize is the number of results requested per page
+// when searching the API.
+const DefaultSearchPageSize = 100
+
+// SearchPageFunc fetches a single page of results starting from the offset.
+type SearchPageFunc[T any] func(ctx context.Context, limit, offset int) ([]T, error)
+
+// SearchAll returns an iterator that walks through all the pages of the search,
+// only fetching the next page once the previous page has been consumed.
+// The search ends once a page is returned with fewer results than the page size,
+// or when an error is returned which is yielded as the final value.
+func SearchAll[T any](ctx context.Context, pageSize int, search SearchPageFunc[T]) iter.Seq2[T, error] {
+	if pageSize <= 0 {
+		pageSize = DefaultSearchPageSize
+	}
+	return func(yield func(T, error) bool) {
+		for offset := 0; ; offset += pageSize {
+			results, err := search(ctx, pageSize, offset)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, r := range results {
+				if !yield(r, nil) {
+					return
+				}
+			}
+			if len(results) < pageSize {
+				return
+			}
+		}
+	}
+}
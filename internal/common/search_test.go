@@ -0,0 +1,75 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchAll(t *testing.T) {
+	t.Parallel()
+
+	newSearch := func(total int, failAt int) (SearchPageFunc[int], *int) {
+		calls := 0
+		return func(_ context.Context, limit, offset int) ([]int, error) {
+			calls++
+			if failAt >= 0 && offset >= failAt {
+				return nil, errors.New("failed search")
+			}
+			var results []int
+			for i := offset; i < total && i < offset+limit; i++ {
+				results = append(results, i)
+			}
+			return results, nil
+		}, &calls
+	}
+
+	for _, tc := range []struct {
+		name     string
+		total    int
+		failAt   int
+		pageSize int
+		take     int
+		expect   []int
+		calls    int
+		err      error
+	}{
+		{name: "no results", total: 0, failAt: -1, pageSize: 2, expect: nil, calls: 1},
+		{name: "single page", total: 1, failAt: -1, pageSize: 2, expect: []int{0}, calls: 1},
+		{name: "exact pages", total: 4, failAt: -1, pageSize: 2, expect: []int{0, 1, 2, 3}, calls: 3},
+		{name: "partial page", total: 5, failAt: -1, pageSize: 2, expect: []int{0, 1, 2, 3, 4}, calls: 3},
+		{name: "default page size", total: 3, failAt: -1, pageSize: 0, expect: []int{0, 1, 2}, calls: 1},
+		{name: "stops early", total: 10, failAt: -1, pageSize: 2, take: 3, expect: []int{0, 1, 2}, calls: 2},
+		{name: "failed search", total: 10, failAt: 2, pageSize: 2, expect: []int{0, 1}, calls: 2, err: errors.New("failed search")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			search, calls := newSearch(tc.total, tc.failAt)
+
+			var (
+				actual []int
+				err    error
+			)
+			for v, e := range SearchAll(context.Background(), tc.pageSize, search) {
+				if e != nil {
+					err = e
+					break
+				}
+				actual = append(actual, v)
+				if tc.take > 0 && len(actual) == tc.take {
+					break
+				}
+			}
+
+			assert.Equal(t, tc.expect, actual, "Must match the expected results")
+			assert.Equal(t, tc.err, err, "Must match the expected error")
+			assert.Equal(t, tc.calls, *calls, "Must match the expected number of requests")
+		})
+	}
+}
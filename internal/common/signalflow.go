@@ -0,0 +1,326 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// signalflowOperators are the operators that are made up of multiple characters,
+// any other symbol is read as a single character operator.
+var signalflowOperators = []string{"**", "//", "==", "!=", "<=", ">=", "->"}
+
+type signalflowToken struct {
+	value string
+	name  bool
+}
+
+type signalflowLine struct {
+	indent int
+	tokens []signalflowToken
+}
+
+type signalflowStatement struct {
+	text string
+	defs []string
+	uses []string
+}
+
+// NormalizeSignalFlow returns the canonical form of the SignalFlow program
+// so that programs that only differ by formatting can be compared.
+// The canonical form removes comments, uses the same spacing and quote style throughout,
+// and orders the top level statements by their content while keeping any statement
+// that reads or assigns a variable after the statements it depends on.
+func NormalizeSignalFlow(program string) string {
+	var statements []*signalflowStatement
+	for _, line := range tokenizeSignalFlow(program) {
+		if line.indent == 0 || len(statements) == 0 {
+			statements = append(statements, &signalflowStatement{})
+		}
+		current := statements[len(statements)-1]
+
+		values := make([]string, len(line.tokens))
+		for i, t := range line.tokens {
+			values[i] = t.value
+		}
+		if current.text != "" {
+			current.text += "\n"
+		}
+		current.text += strings.Repeat("  ", line.indent) + strings.Join(values, " ")
+
+		defs, uses := signalflowNames(line)
+		current.defs = append(current.defs, defs...)
+		current.uses = append(current.uses, uses...)
+	}
+	return strings.Join(orderSignalFlowStatements(statements), "\n")
+}
+
+// SignalFlowEqual reports if both programs have the same canonical form.
+func SignalFlowEqual(a, b string) bool {
+	return NormalizeSignalFlow(a) == NormalizeSignalFlow(b)
+}
+
+// SuppressSignalFlowDiff is a [schema.SchemaDiffSuppressFunc] that ignores
+// changes to SignalFlow programs that do not change the program.
+func SuppressSignalFlowDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return SignalFlowEqual(old, new)
+}
+
+// orderSignalFlowStatements orders the statements by their text,
+// only allowing a statement to be placed once all the statements it depends on have been placed.
+// A statement depends on an earlier statement if it uses a variable the earlier statement assigns,
+// or if it assigns a variable the earlier statement uses or assigns.
+func orderSignalFlowStatements(statements []*signalflowStatement) []string {
+	depends := make([][]int, len(statements))
+	remaining := make([]int, len(statements))
+	for j, later := range statements {
+		for i, earlier := range statements[:j] {
+			if intersects(later.uses, earlier.defs) || intersects(later.defs, earlier.defs) || intersects(later.defs, earlier.uses) {
+				depends[i] = append(depends[i], j)
+				remaining[j]++
+			}
+		}
+	}
+
+	var ready []int
+	for i, n := range remaining {
+		if n == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	ordered := make([]string, 0, len(statements))
+	for len(ready) > 0 {
+		slices.SortStableFunc(ready, func(a, b int) int {
+			return strings.Compare(statements[a].text, statements[b].text)
+		})
+		next := ready[0]
+		ready = ready[1:]
+
+		ordered = append(ordered, statements[next].text)
+		for _, j := range depends[next] {
+			if remaining[j]--; remaining[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+	return ordered
+}
+
+func intersects(a, b []string) bool {
+	return slices.ContainsFunc(a, func(v string) bool {
+		return slices.Contains(b, v)
+	})
+}
+
+// signalflowNames returns the variables that are assigned by the line,
+// and the names it reads excluding attributes and keyword arguments.
+func signalflowNames(line signalflowLine) (defs, uses []string) {
+	tokens := line.tokens
+
+	// Assignments are in the form of `A = ...` or `A, B = ...`,
+	// and functions are in the form of `def name(...)`.
+	if len(tokens) > 1 && tokens[0].value == "def" && tokens[1].name {
+		defs = append(defs, tokens[1].value)
+		tokens = tokens[2:]
+	} else {
+		for i := 0; i+1 < len(tokens) && tokens[i].name; i += 2 {
+			if tokens[i+1].value == "=" {
+				for k := 0; k <= i; k += 2 {
+					defs = append(defs, tokens[k].value)
+				}
+				tokens = tokens[i+2:]
+				break
+			}
+			if tokens[i+1].value != "," {
+				break
+			}
+		}
+	}
+
+	depth := 0
+	for i, t := range tokens {
+		switch t.value {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		}
+		if !t.name {
+			continue
+		}
+		if i > 0 && tokens[i-1].value == "." {
+			continue
+		}
+		if depth > 0 && i+1 < len(tokens) && tokens[i+1].value == "=" {
+			continue
+		}
+		uses = append(uses, t.value)
+	}
+	return defs, uses
+}
+
+// tokenizeSignalFlow splits the program into logical lines,
+// where a line continues while there are open brackets or the line ends with a backslash.
+// Comments and blank lines are removed, and the indentation is converted into nesting levels
+// relative to the least indented line.
+func tokenizeSignalFlow(program string) []signalflowLine {
+	type rawLine struct {
+		width  int
+		tokens []signalflowToken
+	}
+
+	var (
+		raw     []rawLine
+		current []signalflowToken
+		width   = 0
+		depth   = 0
+		start   = true
+		runes   = []rune(program)
+	)
+
+	endLine := func() {
+		if len(current) > 0 {
+			raw = append(raw, rawLine{width: width, tokens: current})
+		}
+		current, width, depth, start = nil, 0, 0, true
+	}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\n':
+			i++
+			if depth == 0 {
+				endLine()
+			}
+		case r == '\\' && i+1 < len(runes) && runes[i+1] == '\n':
+			i += 2
+		case unicode.IsSpace(r):
+			if start {
+				width++
+			}
+			i++
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		default:
+			start = false
+
+			var t signalflowToken
+			t, i = readSignalFlowToken(runes, i)
+			switch t.value {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				depth = max(depth-1, 0)
+			}
+			current = append(current, t)
+		}
+	}
+	endLine()
+
+	if len(raw) == 0 {
+		return nil
+	}
+
+	base := raw[0].width
+	for _, l := range raw {
+		base = min(base, l.width)
+	}
+
+	lines := make([]signalflowLine, len(raw))
+	indents := []int{base}
+	for i, l := range raw {
+		for l.width < indents[len(indents)-1] {
+			indents = indents[:len(indents)-1]
+		}
+		if l.width > indents[len(indents)-1] {
+			indents = append(indents, l.width)
+		}
+		lines[i] = signalflowLine{indent: len(indents) - 1, tokens: l.tokens}
+	}
+	return lines
+}
+
+func readSignalFlowToken(runes []rune, i int) (signalflowToken, int) {
+	r := runes[i]
+	switch {
+	case r == '\'' || r == '"':
+		return readSignalFlowString(runes, i, "")
+	case r == '_' || unicode.IsLetter(r):
+		j := i
+		for j < len(runes) && (runes[j] == '_' || unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+			j++
+		}
+		name := string(runes[i:j])
+		if j < len(runes) && (runes[j] == '\'' || runes[j] == '"') && slices.Contains([]string{"r", "u", "b", "rb", "br"}, strings.ToLower(name)) {
+			return readSignalFlowString(runes, j, strings.ToLower(name))
+		}
+		return signalflowToken{value: name, name: true}, j
+	case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+		j := i + 1
+		for j < len(runes) {
+			c := runes[j]
+			if c == '.' || c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c) {
+				j++
+				continue
+			}
+			if (c == '+' || c == '-') && (runes[j-1] == 'e' || runes[j-1] == 'E') {
+				j++
+				continue
+			}
+			break
+		}
+		return signalflowToken{value: string(runes[i:j])}, j
+	}
+
+	for _, op := range signalflowOperators {
+		if strings.HasPrefix(string(runes[i:min(i+len(op), len(runes))]), op) {
+			return signalflowToken{value: op}, i + len(op)
+		}
+	}
+	return signalflowToken{value: string(r)}, i + 1
+}
+
+// readSignalFlowString reads the quoted string starting at i and returns it using double quotes.
+// Escaped quotes are only required for the quote that is used, so they are converted to match.
+// Raw strings are kept as they are since escapes are not processed within them.
+func readSignalFlowString(runes []rune, i int, prefix string) (signalflowToken, int) {
+	quote := string(runes[i])
+	if strings.HasPrefix(string(runes[i:min(i+3, len(runes))]), strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+
+	var (
+		content strings.Builder
+		j       = i + len([]rune(quote))
+		raw     = strings.Contains(prefix, "r")
+	)
+	for j < len(runes) && !strings.HasPrefix(string(runes[j:min(j+len(quote), len(runes))]), quote) {
+		if runes[j] == '\\' && j+1 < len(runes) {
+			if !raw && (runes[j+1] == '\'' || runes[j+1] == '"') {
+				content.WriteRune(runes[j+1])
+			} else {
+				content.WriteRune(runes[j])
+				content.WriteRune(runes[j+1])
+			}
+			j += 2
+			continue
+		}
+		content.WriteRune(runes[j])
+		j++
+	}
+	end := min(j+len(quote), len(runes))
+
+	if raw {
+		return signalflowToken{value: prefix + string(runes[i:end])}, end
+	}
+	return signalflowToken{value: prefix + `"` + strings.ReplaceAll(content.String(), `"`, `\"`) + `"`}, end
+}
@@ -0,0 +1,327 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSignalFlow(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		program string
+		expect  string
+	}{
+		{
+			name:    "empty program",
+			program: "",
+			expect:  "",
+		},
+		{
+			name:    "only comments",
+			program: "# Nothing to see here\n\n   # or here\n",
+			expect:  "",
+		},
+		{
+			name:    "single statement",
+			program: "A = data('cpu.utilization').publish(label='A')",
+			expect:  `A = data ( "cpu.utilization" ) . publish ( label = "A" )`,
+		},
+		{
+			name:    "multiple line arguments",
+			program: "signal = data('app.delay',\n    filter('cluster', 'prod'),\n    extrapolation='last_value').max()",
+			expect:  `signal = data ( "app.delay" , filter ( "cluster" , "prod" ) , extrapolation = "last_value" ) . max ( )`,
+		},
+		{
+			name:    "line continuation",
+			program: "A = data('cpu.utilization') \\\n    .publish()",
+			expect:  `A = data ( "cpu.utilization" ) . publish ( )`,
+		},
+		{
+			name:    "escaped quotes",
+			program: `A = data('it\'s').publish(label="say \"hi\"")`,
+			expect:  `A = data ( "it's" ) . publish ( label = "say \"hi\"" )`,
+		},
+		{
+			name:    "raw strings",
+			program: `A = data(r'cpu\.utilization').publish()`,
+			expect:  `A = data ( r'cpu\.utilization' ) . publish ( )`,
+		},
+		{
+			name:    "hash within string",
+			program: "A = data('cpu#1') # comment",
+			expect:  `A = data ( "cpu#1" )`,
+		},
+		{
+			name:    "operators",
+			program: "A = (data('a') ** 2 // 3) >= 1 and data('b') != 2",
+			expect:  `A = ( data ( "a" ) ** 2 // 3 ) >= 1 and data ( "b" ) != 2`,
+		},
+		{
+			name:    "numbers",
+			program: "A = data('a').scale(1.5e-3).above(.5)",
+			expect:  `A = data ( "a" ) . scale ( 1.5e-3 ) . above ( .5 )`,
+		},
+		{
+			name:    "sorted independent statements",
+			program: "T = data('spans.count')\nG = data('spans.count')",
+			expect:  "G = data ( \"spans.count\" )\nT = data ( \"spans.count\" )",
+		},
+		{
+			name:    "dependent statements",
+			program: "signal = data('app.delay')\ndetect(when(signal > 60, '5m')).publish('5m')",
+			expect:  "signal = data ( \"app.delay\" )\ndetect ( when ( signal > 60 , \"5m\" ) ) . publish ( \"5m\" )",
+		},
+		{
+			name:    "function definition",
+			program: "def double(s):\n    return s * 2\n\ndouble(data('a')).publish()",
+			expect:  "def double ( s ) :\n  return s * 2\ndouble ( data ( \"a\" ) ) . publish ( )",
+		},
+		{
+			name:    "indented program",
+			program: "    A = data('a').publish()\n    B = data('b').publish()\n",
+			expect:  "A = data ( \"a\" ) . publish ( )\nB = data ( \"b\" ) . publish ( )",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := NormalizeSignalFlow(tc.program)
+			assert.Equal(t, tc.expect, actual, "Must match the expected canonical form")
+			assert.Equal(t, actual, NormalizeSignalFlow(actual), "Must not change the canonical form")
+		})
+	}
+}
+
+func TestSignalFlowEqual(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name  string
+		a, b  string
+		equal bool
+	}{
+		{
+			name:  "identical",
+			a:     "A = data('cpu.utilization').publish(label='A')",
+			b:     "A = data('cpu.utilization').publish(label='A')",
+			equal: true,
+		},
+		{
+			name:  "quote style",
+			a:     "A = events(eventType='My Event Type').publish(label='A')",
+			b:     `A = events(eventType="My Event Type").publish(label="A")`,
+			equal: true,
+		},
+		{
+			name:  "whitespace",
+			a:     `data("cpu.total.idle").publish(label="CPU Idle")`,
+			b:     "\n\n  data( \"cpu.total.idle\" ).publish( label = \"CPU Idle\" )  \n",
+			equal: true,
+		},
+		{
+			name:  "comments",
+			a:     "myfilters = filter(\"cluster_name\", \"prod\") and filter(\"role\", \"search\")\ndata(\"cpu.total.idle\", filter=myfilters).publish()",
+			b:     "# Only production search hosts\nmyfilters = filter(\"cluster_name\", \"prod\") and filter(\"role\", \"search\") # hosts\ndata(\"cpu.total.idle\", filter=myfilters).publish()\n",
+			equal: true,
+		},
+		{
+			name:  "independent statements reordered",
+			a:     "G = data('spans.count', filter=filter('sf_error', 'false') and filter('sf_service', 'foo-service'))\nT = data('spans.count', filter=filter('sf_service', 'foo-service'))",
+			b:     "T = data('spans.count', filter=filter('sf_service', 'foo-service'))\nG = data('spans.count', filter=filter('sf_error', 'false') and filter('sf_service', 'foo-service'))",
+			equal: true,
+		},
+		{
+			name:  "publish statements reordered",
+			a:     "detect(when(signal > 60, '5m')).publish('Processing old messages 5m')\ndetect(when(signal > 60, '30m')).publish('Processing old messages 30m')",
+			b:     "detect(when(signal > 60, '30m')).publish('Processing old messages 30m')\ndetect(when(signal > 60, '5m')).publish('Processing old messages 5m')",
+			equal: true,
+		},
+		{
+			name:  "statements reordered after their dependency",
+			a:     "signal = data('app.delay').max()\ndetect(when(signal > 60, '5m')).publish('5m')\ndetect(when(signal > 60, '30m')).publish('30m')",
+			b:     "signal = data('app.delay').max()\ndetect(when(signal > 60, '30m')).publish('30m')\ndetect(when(signal > 60, '5m')).publish('5m')",
+			equal: true,
+		},
+		{
+			name:  "statement moved before its dependency",
+			a:     "signal = data('app.delay').max()\ndetect(when(signal > 60, '5m')).publish('5m')",
+			b:     "detect(when(signal > 60, '5m')).publish('5m')\nsignal = data('app.delay').max()",
+			equal: false,
+		},
+		{
+			name:  "reassigned variable reordered",
+			a:     "A = data('a')\nA.publish('first')\nA = data('b')\nA.publish('second')",
+			b:     "A = data('b')\nA.publish('second')\nA = data('a')\nA.publish('first')",
+			equal: false,
+		},
+		{
+			name:  "different metric",
+			a:     "A = data('cpu.utilization').publish(label='A')",
+			b:     "A = data('cpu.utilization.total').publish(label='A')",
+			equal: false,
+		},
+		{
+			name:  "different threshold",
+			a:     "detect(when(signal > 60, '5m')).publish('5m')",
+			b:     "detect(when(signal > 61, '5m')).publish('5m')",
+			equal: false,
+		},
+		{
+			name:  "whitespace within strings",
+			a:     "logs(filter=field('message') == 'Transaction processed').publish()",
+			b:     "logs(filter=field('message') == 'Transaction  processed').publish()",
+			equal: false,
+		},
+		{
+			name:  "comment markers within strings",
+			a:     "A = data('cpu # 1').publish()",
+			b:     "A = data('cpu ').publish()",
+			equal: false,
+		},
+		{
+			name:  "function body indentation",
+			a:     "def f(s):\n    x = s * 2\n    return x\nf(data('a')).publish()",
+			b:     "def f(s):\n  x = s * 2\n  return x\nf(data('a')).publish()",
+			equal: true,
+		},
+		{
+			name:  "statement moved out of function body",
+			a:     "def f(s):\n    x = s * 2\n    return x\nf(data('a')).publish()",
+			b:     "def f(s):\n    x = s * 2\nreturn x\nf(data('a')).publish()",
+			equal: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.equal, SignalFlowEqual(tc.a, tc.b), "Must match the expected equality:\n%s\n%s", NormalizeSignalFlow(tc.a), NormalizeSignalFlow(tc.b))
+			assert.Equal(t, tc.equal, SignalFlowEqual(tc.b, tc.a), "Must be symmetric")
+			assert.Equal(t, tc.equal, SuppressSignalFlowDiff("program_text", tc.a, tc.b, nil), "Must suppress equal programs")
+		})
+	}
+}
+
+// TestSignalFlowExamples checks every program used within the examples,
+// so that the normaliser is exercised against programs that users are expected to write.
+func TestSignalFlowExamples(t *testing.T) {
+	t.Parallel()
+
+	programs := loadExamplePrograms(t, filepath.Join("..", "..", "examples"))
+	require.NotEmpty(t, programs, "Must find programs within the examples")
+
+	for name, program := range programs {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			normalized := NormalizeSignalFlow(program)
+			require.NotEmpty(t, normalized, "Must produce a canonical form")
+			assert.Equal(t, normalized, NormalizeSignalFlow(normalized), "Must not change the canonical form")
+
+			swapped := strings.Map(func(r rune) rune {
+				switch r {
+				case '\'':
+					return '"'
+				case '"':
+					return '\''
+				}
+				return r
+			}, program)
+			assert.True(t, SignalFlowEqual(program, swapped), "Must ignore the quote style")
+
+			var formatted strings.Builder
+			formatted.WriteString("# Example program\n\n")
+			for _, line := range strings.Split(program, "\n") {
+				formatted.WriteString("  " + strings.NewReplacer("(", "( ", ",", " , ").Replace(line) + "  # comment\n")
+			}
+			assert.True(t, SignalFlowEqual(program, formatted.String()), "Must ignore whitespace and comments")
+
+			var reversed []string
+			for _, line := range strings.Split(program, "\n") {
+				if strings.TrimSpace(line) != "" {
+					reversed = append([]string{line}, reversed...)
+				}
+			}
+			independent := !strings.Contains(program, "=") || !strings.Contains(program, "\n")
+			if independent {
+				assert.True(t, SignalFlowEqual(program, strings.Join(reversed, "\n")), "Must ignore the order of independent statements")
+			}
+
+			quote := strings.IndexAny(program, `'"`)
+			require.NotEqual(t, -1, quote, "Must contain a string to modify")
+			modified := program[:quote+1] + "modified." + program[quote+1:]
+			assert.False(t, SignalFlowEqual(program, modified), "Must detect changes to the program")
+		})
+	}
+}
+
+// loadExamplePrograms reads the `program_text` values from the example configuration,
+// where any interpolated values are replaced with a fixed value.
+func loadExamplePrograms(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	programs := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".tf" {
+			return err
+		}
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		f, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+		if diags.HasErrors() {
+			return diags
+		}
+
+		var visit func(body *hclsyntax.Body)
+		visit = func(body *hclsyntax.Body) {
+			if attr, ok := body.Attributes["program_text"]; ok {
+				if program, ok := templateLiteral(attr.Expr); ok {
+					programs[fmt.Sprintf("%s:%d", filepath.ToSlash(path), attr.SrcRange.Start.Line)] = program
+				}
+			}
+			for _, block := range body.Blocks {
+				visit(block.Body)
+			}
+		}
+		visit(f.Body.(*hclsyntax.Body))
+		return nil
+	})
+	require.NoError(t, err, "Must read the examples")
+	return programs
+}
+
+func templateLiteral(expr hclsyntax.Expression) (string, bool) {
+	switch expr := expr.(type) {
+	case *hclsyntax.TemplateWrapExpr:
+		return templateLiteral(expr.Wrapped)
+	case *hclsyntax.LiteralValueExpr:
+		return expr.Val.AsString(), true
+	case *hclsyntax.TemplateExpr:
+		var sb strings.Builder
+		for _, part := range expr.Parts {
+			if lit, ok := part.(*hclsyntax.LiteralValueExpr); ok {
+				sb.WriteString(lit.Val.AsString())
+			} else {
+				sb.WriteString("interpolated")
+			}
+		}
+		return sb.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,41 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// FromConfigValue converts the raw configuration into the generic terraform values,
+// which is useful when values nested within sets can not be read from a diff.
+// Null and unknown values are returned as nil since they can not be read until apply.
+func FromConfigValue(v cty.Value) any {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+	switch t := v.Type(); {
+	case t == cty.String:
+		return v.AsString()
+	case t == cty.Bool:
+		return v.True()
+	case t == cty.Number:
+		f, _ := v.AsBigFloat().Float64()
+		return f
+	case t.IsListType(), t.IsSetType(), t.IsTupleType():
+		var values []any
+		for it := v.ElementIterator(); it.Next(); {
+			_, elem := it.Element()
+			values = append(values, FromConfigValue(elem))
+		}
+		return values
+	case t.IsMapType(), t.IsObjectType():
+		values := make(map[string]any)
+		for it := v.ElementIterator(); it.Next(); {
+			k, elem := it.Element()
+			values[k.AsString()] = FromConfigValue(elem)
+		}
+		return values
+	}
+	return nil
+}
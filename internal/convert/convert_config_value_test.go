@@ -0,0 +1,51 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package convert
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromConfigValue(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		value  cty.Value
+		expect any
+	}{
+		{name: "null", value: cty.NullVal(cty.String), expect: nil},
+		{name: "unknown", value: cty.UnknownVal(cty.String), expect: nil},
+		{name: "string", value: cty.StringVal("value"), expect: "value"},
+		{name: "bool", value: cty.True, expect: true},
+		{name: "number", value: cty.NumberIntVal(10), expect: float64(10)},
+		{
+			name:   "list with unknown",
+			value:  cty.ListVal([]cty.Value{cty.StringVal("a"), cty.UnknownVal(cty.String)}),
+			expect: []any{"a", nil},
+		},
+		{
+			name: "set of objects",
+			value: cty.SetVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"label": cty.StringVal("cpu"),
+					"items": cty.ListVal([]cty.Value{cty.StringVal("a")}),
+					"unset": cty.NullVal(cty.String),
+				}),
+			}),
+			expect: []any{
+				map[string]any{"label": "cpu", "items": []any{"a"}, "unset": nil},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, FromConfigValue(tc.value), "Must match the expected value")
+		})
+	}
+}
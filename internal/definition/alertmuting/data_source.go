@@ -0,0 +1,168 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package alertmuting
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go/alertmuting"
+
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+const (
+	DataSourceName = "signalfx_alert_muting_rules"
+
+	// DetectorIdProperty is the filter property used to mute a detector.
+	DetectorIdProperty = "sf_detectorId"
+)
+
+func NewDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the active and upcoming alert muting rules, optionally filtered by detector, property or time window.",
+		SchemaFunc:  newSchema,
+		ReadContext: datasourceRead,
+	}
+}
+
+// period is a single muting period of a rule.
+type period struct {
+	start time.Time
+	stop  time.Time // zero when the rule does not end
+}
+
+func (p period) active(now time.Time) bool {
+	return !p.start.After(now) && (p.stop.IsZero() || p.stop.After(now))
+}
+
+// nextPeriod returns the first muting period of the rule that ends after t.
+// A rule that has ended returns false.
+func nextPeriod(rule alertmuting.AlertMutingRule, t time.Time) (period, bool) {
+	p := period{start: time.UnixMilli(rule.StartTime)}
+	if rule.StopTime == 0 {
+		return p, true
+	}
+	p.stop = time.UnixMilli(rule.StopTime)
+	if p.stop.After(t) {
+		return p, true
+	}
+
+	if rule.Recurrence == nil || rule.Recurrence.Value < 1 {
+		return p, false
+	}
+	every := time.Duration(rule.Recurrence.Value) * 24 * time.Hour
+	if rule.Recurrence.Unit == "w" {
+		every *= 7
+	}
+	n := t.Sub(p.stop)/every + 1
+	return period{start: p.start.Add(n * every), stop: p.stop.Add(n * every)}, true
+}
+
+// matches reports if the rule has a filter for the property and, when set, the value.
+func matches(rule alertmuting.AlertMutingRule, property, value string) bool {
+	return slices.ContainsFunc(rule.Filters, func(f *alertmuting.AlertMutingRuleFilter) bool {
+		return f != nil && f.Property == property && (value == "" || slices.Contains(f.PropertyValue.Values, value))
+	})
+}
+
+func datasourceRead(ctx context.Context, rd *schema.ResourceData, meta any) diag.Diagnostics {
+	client, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	var (
+		now      = time.Now()
+		from     = now
+		until    time.Time
+		detector = rd.Get("detector_id").(string)
+		property = rd.Get("property").(string)
+		value    = rd.Get("property_value").(string)
+
+		hasher = fnv.New64()
+		rules  = make([]any, 0)
+		limit  = 1000
+	)
+	if v, ok := rd.GetOk("start_time"); ok {
+		from = time.Unix(int64(v.(int)), 0)
+	}
+	if v, ok := rd.GetOk("stop_time"); ok {
+		until = time.Unix(int64(v.(int)), 0)
+	}
+	_, _ = fmt.Fprint(hasher, detector, property, value, rd.Get("start_time"), rd.Get("stop_time"))
+
+	for offset := 0; ; offset += limit {
+		results, err := client.SearchAlertMutingRules(ctx, "", limit, "", offset)
+		if err != nil {
+			return tfext.AsErrorDiagnostics(err)
+		}
+
+		for _, rule := range results.Results {
+			p, ok := nextPeriod(rule, from)
+			switch {
+			case !ok,
+				!until.IsZero() && !p.start.Before(until),
+				detector != "" && !matches(rule, DetectorIdProperty, detector),
+				property != "" && !matches(rule, property, value):
+				continue
+			}
+			tflog.Debug(ctx, "Matched alert muting rule", tfext.NewLogFields().JSON("rule", rule))
+			rules = append(rules, ruleToTF(rule, p, now))
+		}
+
+		if len(results.Results) == 0 || offset+limit >= int(results.Count) {
+			break
+		}
+	}
+	rd.SetId(strconv.FormatUint(hasher.Sum64(), 36))
+
+	return tfext.AsErrorDiagnostics(rd.Set("rules", rules))
+}
+
+func ruleToTF(rule alertmuting.AlertMutingRule, p period, now time.Time) map[string]any {
+	var (
+		detectors = make([]any, 0)
+		filters   = make([]any, 0, len(rule.Filters))
+	)
+	for _, f := range rule.Filters {
+		if f == nil {
+			continue
+		}
+		if f.Property == DetectorIdProperty {
+			for _, v := range f.PropertyValue.Values {
+				detectors = append(detectors, v)
+			}
+			continue
+		}
+		filters = append(filters, map[string]any{
+			"property":       f.Property,
+			"property_value": strings.Join(f.PropertyValue.Values, ","),
+			"negated":        f.NOT,
+		})
+	}
+
+	var stop int
+	if !p.stop.IsZero() {
+		stop = int(p.stop.Unix())
+	}
+	return map[string]any{
+		"id":          rule.Id,
+		"description": rule.Description,
+		"detectors":   detectors,
+		"filter":      filters,
+		"start_time":  int(p.start.Unix()),
+		"stop_time":   stop,
+		"active":      p.active(now),
+	}
+}
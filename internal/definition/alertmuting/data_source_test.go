@@ -0,0 +1,194 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package alertmuting
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/signalfx/signalfx-go/alertmuting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func TestNewDataSource(t *testing.T) {
+	t.Parallel()
+
+	assert.NotNil(t, NewDataSource(), "Must have a valid resource returned")
+}
+
+func TestNextPeriod(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hour = time.Hour.Milliseconds()
+		t0   = time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+	)
+
+	for _, tc := range []struct {
+		name   string
+		rule   alertmuting.AlertMutingRule
+		at     time.Time
+		period period
+		ok     bool
+	}{
+		{
+			name:   "upcoming",
+			rule:   alertmuting.AlertMutingRule{StartTime: t0.UnixMilli() + hour, StopTime: t0.UnixMilli() + 2*hour},
+			at:     t0,
+			period: period{start: t0.Add(time.Hour), stop: t0.Add(2 * time.Hour)},
+			ok:     true,
+		},
+		{
+			name:   "no stop time",
+			rule:   alertmuting.AlertMutingRule{StartTime: t0.UnixMilli() - hour},
+			at:     t0,
+			period: period{start: t0.Add(-time.Hour)},
+			ok:     true,
+		},
+		{
+			name:   "ended",
+			rule:   alertmuting.AlertMutingRule{StartTime: t0.UnixMilli() - 2*hour, StopTime: t0.UnixMilli() - hour},
+			at:     t0,
+			period: period{start: t0.Add(-2 * time.Hour), stop: t0.Add(-time.Hour)},
+			ok:     false,
+		},
+		{
+			name: "daily recurrence",
+			rule: alertmuting.AlertMutingRule{
+				StartTime:  t0.UnixMilli() - 49*hour,
+				StopTime:   t0.UnixMilli() - 47*hour,
+				Recurrence: &alertmuting.AlertMutingRuleRecurrence{Unit: "d", Value: 1},
+			},
+			at:     t0,
+			period: period{start: t0.Add(-time.Hour), stop: t0.Add(time.Hour)},
+			ok:     true,
+		},
+		{
+			name: "weekly recurrence",
+			rule: alertmuting.AlertMutingRule{
+				StartTime:  t0.UnixMilli() - 2*hour,
+				StopTime:   t0.UnixMilli() - hour,
+				Recurrence: &alertmuting.AlertMutingRuleRecurrence{Unit: "w", Value: 1},
+			},
+			at:     t0,
+			period: period{start: t0.Add(166 * time.Hour), stop: t0.Add(167 * time.Hour)},
+			ok:     true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p, ok := nextPeriod(tc.rule, tc.at)
+			assert.Equal(t, tc.ok, ok, "Must match whether the rule has ended")
+			assert.True(t, tc.period.start.Equal(p.start), "Must match the period start, got %s", p.start)
+			assert.True(t, tc.period.stop.Equal(p.stop), "Must match the period stop, got %s", p.stop)
+		})
+	}
+}
+
+func TestDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now  = time.Now()
+		hour = time.Hour.Milliseconds()
+	)
+
+	meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/alertmuting": func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(&alertmuting.SearchResult{
+				Count: 4,
+				Results: []alertmuting.AlertMutingRule{
+					{
+						Id:          "active",
+						Description: "Deploy",
+						StartTime:   now.UnixMilli() - hour,
+						StopTime:    now.UnixMilli() + hour,
+						Filters: []*alertmuting.AlertMutingRuleFilter{
+							{Property: DetectorIdProperty, PropertyValue: alertmuting.StringOrArray{Values: []string{"detector-01"}}},
+							{Property: "env", PropertyValue: alertmuting.StringOrArray{Values: []string{"prod"}}},
+						},
+					},
+					{
+						Id:          "upcoming",
+						Description: "Maintenance",
+						StartTime:   now.UnixMilli() + 48*hour,
+						StopTime:    now.UnixMilli() + 50*hour,
+						Filters: []*alertmuting.AlertMutingRuleFilter{
+							{Property: "env", PropertyValue: alertmuting.StringOrArray{Values: []string{"staging"}}, NOT: true},
+						},
+					},
+					{
+						Id:          "ended",
+						Description: "Old",
+						StartTime:   now.UnixMilli() - 2*hour,
+						StopTime:    now.UnixMilli() - hour,
+					},
+					{
+						Id:          "indefinite",
+						Description: "Forever",
+						StartTime:   now.UnixMilli() - hour,
+						Filters: []*alertmuting.AlertMutingRuleFilter{
+							{Property: DetectorIdProperty, PropertyValue: alertmuting.StringOrArray{Values: []string{"detector-02"}}},
+						},
+					},
+				},
+			})
+		},
+	})
+
+	for _, tc := range []struct {
+		name   string
+		config map[string]any
+		ids    []string
+	}{
+		{name: "active and upcoming", config: map[string]any{}, ids: []string{"active", "upcoming", "indefinite"}},
+		{name: "detector", config: map[string]any{"detector_id": "detector-01"}, ids: []string{"active"}},
+		{name: "property", config: map[string]any{"property": "env"}, ids: []string{"active", "upcoming"}},
+		{name: "property value", config: map[string]any{"property": "env", "property_value": "staging"}, ids: []string{"upcoming"}},
+		{
+			name:   "time window",
+			config: map[string]any{"stop_time": int(now.Add(24 * time.Hour).Unix())},
+			ids:    []string{"active", "indefinite"},
+		},
+		{
+			name:   "future time window",
+			config: map[string]any{"start_time": int(now.Add(3 * time.Hour).Unix())},
+			ids:    []string{"upcoming", "indefinite"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := NewDataSource()
+			rd := data.TestResourceData()
+			for k, v := range tc.config {
+				require.NoError(t, rd.Set(k, v), "Must not error setting %s", k)
+			}
+
+			assert.Equal(t, diag.Diagnostics(nil), data.ReadContext(t.Context(), rd, meta(t)), "Must not error reading rules")
+
+			var ids []string
+			for _, rule := range rd.Get("rules").([]any) {
+				ids = append(ids, rule.(map[string]any)["id"].(string))
+			}
+			assert.Equal(t, tc.ids, ids, "Must match the expected rules")
+		})
+	}
+
+	data := NewDataSource()
+	rd := data.TestResourceData()
+	require.NoError(t, rd.Set("detector_id", "detector-01"), "Must not error setting detector")
+	require.Empty(t, data.ReadContext(t.Context(), rd, meta(t)), "Must not error reading rules")
+	assert.Equal(t, true, rd.Get("rules.0.active"), "Must report the rule is active")
+	assert.Equal(t, []any{"detector-01"}, rd.Get("rules.0.detectors"), "Must separate the detectors from the filters")
+	assert.Equal(t, "prod", rd.Get("rules.0.filter.0.property_value"), "Must read the filters")
+	assert.Equal(t, int(now.Unix())+3600, rd.Get("rules.0.stop_time"), "Must read the stop time in seconds")
+}
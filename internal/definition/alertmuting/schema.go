@@ -0,0 +1,103 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package alertmuting
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"detector_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only include muting rules that apply to this detector",
+		},
+		"property": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only include muting rules that filter by this property",
+		},
+		"property_value": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			RequiredWith: []string{"property"},
+			Description:  "Only include muting rules that filter `property` by this value",
+		},
+		"start_time": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Only include muting rules that are active after this Unix timestamp, in seconds. Defaults to the current time",
+		},
+		"stop_time": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Only include muting rules that start before this Unix timestamp, in seconds",
+		},
+		"rules": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The active and upcoming muting rules that match the filters",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the muting rule",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Description of the muting rule",
+					},
+					"detectors": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Detectors the muting rule applies to",
+					},
+					"filter": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "Filters of the muting rule",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"property": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"property_value": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"negated": {
+									Type:     schema.TypeBool,
+									Computed: true,
+								},
+							},
+						},
+					},
+					"start_time": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Start time of the current or next muting period as a Unix timestamp, in seconds",
+					},
+					"stop_time": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Stop time of the current or next muting period as a Unix timestamp, in seconds. `0` when the rule does not end",
+					},
+					"active": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the muting rule is currently muting notifications",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoarchivecandidates
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/signalfx/signalfx-go/metrics_metadata"
+	"go.uber.org/multierr"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+const (
+	DataSourceName = "signalfx_automated_archival_candidates"
+
+	// metricPageSize is the number of metrics requested per page,
+	// which is larger than the default since organizations can have many metrics.
+	metricPageSize = 1000
+)
+
+var (
+	periodPattern  = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?$`)
+	dataArgPattern = regexp.MustCompile(`data\(\s*(?:metric\s*=\s*)?['"]([^'"]+)['"]`)
+)
+
+func NewDataSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the metrics that are candidates for automated archival given the current lookback and grace periods, excluding exempt metrics and metrics read by the program text of charts or detectors.",
+		SchemaFunc:  newSchema,
+		ReadContext: datasourceRead,
+	}
+}
+
+func datasourceRead(ctx context.Context, rd *schema.ResourceData, meta any) diag.Diagnostics {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	settings, err := sfx.GetSettings(ctx)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	lookback, err := parsePeriod(settings.LookbackPeriod)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	grace, err := parsePeriod(settings.GracePeriod)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	exempt, err := sfx.GetExemptMetrics(ctx)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	used, err := usedMetrics(ctx, sfx)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+	if exempt != nil {
+		for _, m := range *exempt {
+			used = append(used, regexp.MustCompile("^"+regexp.QuoteMeta(m.Name)+"$"))
+		}
+	}
+
+	// A metric can only have gone unused throughout the lookback period once it is at least that old,
+	// and metrics created within the grace period are protected from being archived.
+	var (
+		pattern = rd.Get("name_pattern").(string)
+		matcher = globPattern(pattern)
+		cutoff  = time.Now().Add(-max(lookback, grace)).UnixMilli()
+		names   = []string{}
+	)
+
+	for m, err := range common.SearchAll(ctx, metricPageSize, func(ctx context.Context, limit, offset int) ([]*metrics_metadata.Metric, error) {
+		results, err := sfx.SearchMetric(ctx, "name:"+pattern, "name", limit, offset)
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}) {
+		if err != nil {
+			return tfext.AsErrorDiagnostics(err)
+		}
+		if m == nil || !matcher.MatchString(m.Name) || m.Created > cutoff {
+			continue
+		}
+		if slices.ContainsFunc(used, func(re *regexp.Regexp) bool { return re.MatchString(m.Name) }) {
+			continue
+		}
+		names = append(names, m.Name)
+	}
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	tflog.Debug(ctx, "Retrieved automated archival candidates", tfext.NewLogFields().
+		Field("pattern", pattern).
+		Field("candidates", len(names)),
+	)
+
+	hasher := fnv.New64()
+	_, _ = fmt.Fprint(hasher, pattern)
+	rd.SetId(strconv.FormatUint(hasher.Sum64(), 36))
+
+	return tfext.AsErrorDiagnostics(multierr.Combine(
+		rd.Set("enabled", settings.Enabled),
+		rd.Set("lookback_period", settings.LookbackPeriod),
+		rd.Set("grace_period", settings.GracePeriod),
+		rd.Set("names", names),
+	))
+}
+
+// usedMetrics returns the patterns of the metrics that are read by the program text
+// of any chart or detector, which count as being used throughout the lookback period.
+func usedMetrics(ctx context.Context, sfx *signalfx.Client) ([]*regexp.Regexp, error) {
+	var programs []string
+	for c, err := range common.SearchAll(ctx, common.DefaultSearchPageSize, func(ctx context.Context, limit, offset int) ([]*chart.Chart, error) {
+		results, err := sfx.SearchCharts(ctx, limit, "", offset, "")
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}) {
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			programs = append(programs, c.ProgramText)
+		}
+	}
+
+	for d, err := range common.SearchAll(ctx, common.DefaultSearchPageSize, func(ctx context.Context, limit, offset int) ([]detector.Detector, error) {
+		results, err := sfx.SearchDetectors(ctx, limit, "", offset, "")
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}) {
+		if err != nil {
+			return nil, err
+		}
+		programs = append(programs, d.ProgramText)
+	}
+
+	var metrics []string
+	for _, program := range programs {
+		for _, match := range dataArgPattern.FindAllStringSubmatch(program, -1) {
+			metrics = append(metrics, match[1])
+		}
+	}
+	slices.Sort(metrics)
+
+	patterns := make([]*regexp.Regexp, 0, len(metrics))
+	for _, metric := range slices.Compact(metrics) {
+		patterns = append(patterns, globPattern(metric))
+	}
+	return patterns, nil
+}
+
+// globPattern converts the metric name pattern into a regular expression
+// that matches the whole name, where `*` matches any sequence of characters.
+func globPattern(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// parsePeriod converts the ISO 8601 duration used by the automated archival settings,
+// which is expressed in weeks and days, into a duration.
+func parsePeriod(period string) (time.Duration, error) {
+	match := periodPattern.FindStringSubmatch(period)
+	if match == nil || period == "P" {
+		return 0, fmt.Errorf("unsupported period %q, expected an ISO 8601 duration in weeks or days", period)
+	}
+
+	var days int
+	for i, unit := range []int{7, 1} {
+		if match[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+1])
+		if err != nil {
+			return 0, err
+		}
+		days += n * unit
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
@@ -0,0 +1,202 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoarchivecandidates
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	automated_archival "github.com/signalfx/signalfx-go/automated-archival"
+	"github.com/signalfx/signalfx-go/metrics_metadata"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func newTestCandidatesHandlers(lookbackPeriod, gracePeriod string) map[string]http.HandlerFunc {
+	var (
+		old    = time.Now().Add(-90 * 24 * time.Hour).UnixMilli()
+		young  = time.Now().Add(-10 * 24 * time.Hour).UnixMilli()
+		recent = time.Now().Add(-time.Hour).UnixMilli()
+	)
+	return map[string]http.HandlerFunc{
+		"GET /v2/automated-archival/settings": func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(&automated_archival.AutomatedArchivalSettings{
+				Enabled:        true,
+				LookbackPeriod: lookbackPeriod,
+				GracePeriod:    gracePeriod,
+			})
+		},
+		"GET /v2/automated-archival/exempt-metrics": func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(&[]automated_archival.ExemptMetric{
+				{Name: "k8s.exempt"},
+			})
+		},
+		"GET /v2/chart": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprint(w, `{"count":1,"results":[{"id":"chart-01","programText":"data('k8s.charted').publish()"}]}`)
+		},
+		"GET /v2/detector": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprint(w, `{"count":1,"results":[{"id":"detector-01","programText":"detect(when(data(\"k8s.pod.*\").max() > 1)).publish('Pods')"}]}`)
+		},
+		"GET /v2/metric": func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(&metrics_metadata.RetrieveMetricMetadataResponseModel{
+				Count: 7,
+				Results: []*metrics_metadata.Metric{
+					{Name: "k8s.unused", Created: old},
+					{Name: "k8s.exempt", Created: old},
+					{Name: "k8s.charted", Created: old},
+					{Name: "k8s.pod.restarts", Created: old},
+					{Name: "k8s.young", Created: young},
+					{Name: "k8s.recent", Created: recent},
+					{Name: "cpu.utilization", Created: old},
+				},
+			})
+		},
+	}
+}
+
+func TestNewDataSource(t *testing.T) {
+	t.Parallel()
+
+	assert.NotNil(t, NewDataSource(), "Must have a valid resource returned")
+}
+
+func TestDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		meta    func(tb testing.TB) any
+		pattern string
+		names   []any
+		diags   diag.Diagnostics
+	}{
+		{
+			name: "no provider",
+			meta: func(_ testing.TB) any {
+				return nil
+			},
+			pattern: "*",
+			names:   []any{},
+			diags: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "expected to implement type Meta"},
+			},
+		},
+		{
+			name: "not authorized",
+			meta: tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+				"GET /v2/automated-archival/settings": func(w http.ResponseWriter, _ *http.Request) {
+					http.Error(w, "failed to read", http.StatusUnauthorized)
+				},
+			}),
+			pattern: "*",
+			names:   []any{},
+			diags: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "route \"/v2/automated-archival/settings\" had issues with status code 401"},
+			},
+		},
+		{
+			name:    "unsupported lookback period",
+			meta:    tftest.NewTestHTTPMockMeta(newTestCandidatesHandlers("PT12H", "P1W")),
+			pattern: "*",
+			names:   []any{},
+			diags: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "unsupported period \"PT12H\", expected an ISO 8601 duration in weeks or days"},
+			},
+		},
+		{
+			name:    "unsupported grace period",
+			meta:    tftest.NewTestHTTPMockMeta(newTestCandidatesHandlers("P30D", "PT12H")),
+			pattern: "*",
+			names:   []any{},
+			diags: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "unsupported period \"PT12H\", expected an ISO 8601 duration in weeks or days"},
+			},
+		},
+		{
+			name:    "all metrics",
+			meta:    tftest.NewTestHTTPMockMeta(newTestCandidatesHandlers("P30D", "P1W")),
+			pattern: "*",
+			names:   []any{"cpu.utilization", "k8s.unused"},
+			diags:   nil,
+		},
+		{
+			name:    "matching pattern",
+			meta:    tftest.NewTestHTTPMockMeta(newTestCandidatesHandlers("P0D", "P0D")),
+			pattern: "k8s.*",
+			names:   []any{"k8s.recent", "k8s.unused", "k8s.young"},
+			diags:   nil,
+		},
+		{
+			name:    "grace period protects new metrics",
+			meta:    tftest.NewTestHTTPMockMeta(newTestCandidatesHandlers("P0D", "P1W")),
+			pattern: "k8s.*",
+			names:   []any{"k8s.unused", "k8s.young"},
+			diags:   nil,
+		},
+		{
+			name:    "lookback period longer than the grace period",
+			meta:    tftest.NewTestHTTPMockMeta(newTestCandidatesHandlers("P2W", "P1D")),
+			pattern: "k8s.*",
+			names:   []any{"k8s.unused"},
+			diags:   nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := NewDataSource()
+
+			rd := data.TestResourceData()
+			assert.NoError(t, rd.Set("name_pattern", tc.pattern))
+
+			actual := data.ReadContext(t.Context(), rd, tc.meta(t))
+			assert.Equal(t, tc.diags, actual, "Must match the expected results")
+			assert.Equal(t, tc.names, rd.Get("names"), "Must match the expected candidates")
+		})
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		period   string
+		expect   time.Duration
+		errorMsg string
+	}{
+		{period: "P0D", expect: 0},
+		{period: "P30D", expect: 30 * 24 * time.Hour},
+		{period: "P2W", expect: 14 * 24 * time.Hour},
+		{period: "P1W3D", expect: 10 * 24 * time.Hour},
+		{period: "P", errorMsg: "unsupported period \"P\", expected an ISO 8601 duration in weeks or days"},
+		{period: "", errorMsg: "unsupported period \"\", expected an ISO 8601 duration in weeks or days"},
+		{period: "PT1H", errorMsg: "unsupported period \"PT1H\", expected an ISO 8601 duration in weeks or days"},
+	} {
+		t.Run(tc.period, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parsePeriod(tc.period)
+			if tc.errorMsg != "" {
+				assert.EqualError(t, err, tc.errorMsg, "Must match the expected error")
+				return
+			}
+			assert.NoError(t, err, "Must not error parsing the period")
+			assert.Equal(t, tc.expect, actual, "Must match the expected duration")
+		})
+	}
+}
+
+func TestGlobPattern(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, globPattern("*").MatchString("cpu.utilization"), "Must match any name")
+	assert.True(t, globPattern("k8s.*.restarts").MatchString("k8s.pod.restarts"), "Must match the wildcard")
+	assert.False(t, globPattern("k8s.pod").MatchString("k8s.pod.restarts"), "Must match the whole name")
+	assert.False(t, globPattern("k8s.pod").MatchString("k8s-pod"), "Must not treat the name as a regular expression")
+}
@@ -0,0 +1,44 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoarchivecandidates
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name_pattern": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "*",
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "Only include metrics whose name matches this pattern, where `*` matches any sequence of characters. Defaults to all metrics",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the automated archival is enabled for this organization",
+		},
+		"lookback_period": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The lookback period of the automated archival settings in ISO 8601 duration format",
+		},
+		"grace_period": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The grace period of the automated archival settings in ISO 8601 duration format",
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The names of the metrics that are candidates for archival, ordered by name",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoarchivecandidates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	assert.NotNil(t, newSchema(), "Must have a valid schema")
+}
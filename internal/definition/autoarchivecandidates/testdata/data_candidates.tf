@@ -0,0 +1,3 @@
+data "signalfx_automated_archival_candidates" "example" {
+  name_pattern = "k8s.*"
+}
@@ -0,0 +1,248 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoarchiveexemptmetrics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go"
+	automated_archival "github.com/signalfx/signalfx-go/automated-archival"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+const (
+	ResourceName = "signalfx_automated_archival_exempt_metrics"
+
+	// ResourceID is the ID of the resource since the organization has a single exempt list.
+	ResourceID = "automated-archival-exempt-metrics"
+
+	// batchSize is the number of exempt metrics sent with each create or delete request.
+	batchSize = 100
+)
+
+func NewResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages a set of metrics that are exempt from automated archival, only creating and deleting the exemptions that changed.",
+		SchemaFunc:    newSchema,
+		ReadContext:   resourceRead,
+		CreateContext: resourceCreate,
+		UpdateContext: resourceUpdate,
+		DeleteContext: resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceImport,
+		},
+		CustomizeDiff: customdiff.ComputedIf("metric_ids", func(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+			return d.HasChange("names")
+		}),
+	}
+}
+
+func resourceRead(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	existing, err := exemptMetricIDs(ctx, sfx)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(common.HandleError(ctx, err, data))
+	}
+
+	// Only the exemptions owned by this resource are read back,
+	// so any exemption removed outside of Terraform is planned to be added again.
+	_, names := decodeTerraform(data)
+	ids := make(map[string]string, len(names))
+	for _, name := range names {
+		if id, ok := existing[name]; ok {
+			ids[name] = id
+		}
+	}
+
+	return tfext.AsErrorDiagnostics(encodeTerraform(ids, data))
+}
+
+func resourceCreate(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	_, names := decodeTerraform(data)
+
+	ids, err := createExemptMetrics(ctx, sfx, names)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(common.HandleError(ctx, err, data))
+	}
+	data.SetId(ResourceID)
+
+	return tfext.AsErrorDiagnostics(encodeTerraform(ids, data))
+}
+
+func resourceUpdate(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	var (
+		prior, _ = data.GetChange("metric_ids")
+		old, new = data.GetChange("names")
+		removed  = convert.SliceAll(old.(*schema.Set).Difference(new.(*schema.Set)).List(), convert.ToString)
+		added    = convert.SliceAll(new.(*schema.Set).Difference(old.(*schema.Set)).List(), convert.ToString)
+		ids      = make(map[string]string)
+	)
+	for name, id := range prior.(map[string]any) {
+		ids[name] = convert.ToString(id)
+	}
+
+	var remove []string
+	for _, name := range removed {
+		if id, ok := ids[name]; ok {
+			remove = append(remove, id)
+		}
+		delete(ids, name)
+	}
+
+	tflog.Debug(ctx, "Updating automated archival exempt metrics", tfext.NewLogFields().
+		Field("added", len(added)).
+		Field("removed", len(remove)),
+	)
+
+	// The added metrics are created first so that nothing is deleted
+	// when one of them is already exempt outside of the resource.
+	created, err := createExemptMetrics(ctx, sfx, added)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(common.HandleError(ctx, err, data))
+	}
+	for name, id := range created {
+		ids[name] = id
+	}
+
+	if err := deleteExemptMetrics(ctx, sfx, remove); err != nil {
+		return tfext.AsErrorDiagnostics(common.HandleError(ctx, err, data))
+	}
+
+	return tfext.AsErrorDiagnostics(encodeTerraform(ids, data))
+}
+
+func resourceDelete(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	ids, _ := decodeTerraform(data)
+
+	remove := make([]string, 0, len(ids))
+	for _, id := range ids {
+		remove = append(remove, id)
+	}
+	slices.Sort(remove)
+
+	err = deleteExemptMetrics(ctx, sfx, remove)
+	if err == nil {
+		data.SetId("")
+	}
+	return tfext.AsErrorDiagnostics(common.HandleError(ctx, err, data))
+}
+
+// resourceImport reads the whole exempt list of the organization into the resource.
+func resourceImport(ctx context.Context, data *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := exemptMetricIDs(ctx, sfx)
+	if err != nil {
+		return nil, err
+	}
+	data.SetId(ResourceID)
+
+	return []*schema.ResourceData{data}, encodeTerraform(ids, data)
+}
+
+// exemptMetricIDs returns the IDs of the organization's exempt metrics keyed by name.
+func exemptMetricIDs(ctx context.Context, sfx *signalfx.Client) (map[string]string, error) {
+	metrics, err := sfx.GetExemptMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string)
+	if metrics == nil {
+		return ids, nil
+	}
+	for _, m := range *metrics {
+		if m.Id != nil {
+			ids[m.Name] = *m.Id
+		}
+	}
+	return ids, nil
+}
+
+// createExemptMetrics exempts the metrics in batches and returns their IDs keyed by name.
+// Metrics that are already exempt are not adopted, since removing them from the resource
+// would then delete exemptions that it did not create.
+func createExemptMetrics(ctx context.Context, sfx *signalfx.Client, names []string) (map[string]string, error) {
+	ids := make(map[string]string, len(names))
+	if len(names) == 0 {
+		return ids, nil
+	}
+
+	existing, err := exemptMetricIDs(ctx, sfx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		exempt  []string
+		missing []automated_archival.ExemptMetric
+	)
+	for _, name := range names {
+		if _, ok := existing[name]; ok {
+			exempt = append(exempt, name)
+			continue
+		}
+		missing = append(missing, automated_archival.ExemptMetric{Name: name})
+	}
+	if len(exempt) > 0 {
+		slices.Sort(exempt)
+		return nil, fmt.Errorf("metrics %s are already exempt from automated archival, import the exempt metrics using the id %q to manage them", strings.Join(exempt, ", "), ResourceID)
+	}
+
+	for batch := range slices.Chunk(missing, batchSize) {
+		created, err := sfx.CreateExemptMetrics(ctx, &batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range *created {
+			if m.Id != nil {
+				ids[m.Name] = *m.Id
+			}
+		}
+	}
+	return ids, nil
+}
+
+// deleteExemptMetrics removes the exempt metrics by ID in batches.
+func deleteExemptMetrics(ctx context.Context, sfx *signalfx.Client, ids []string) error {
+	for batch := range slices.Chunk(ids, batchSize) {
+		if err := sfx.DeleteExemptMetrics(ctx, &automated_archival.ExemptMetricDeleteRequest{Ids: batch}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
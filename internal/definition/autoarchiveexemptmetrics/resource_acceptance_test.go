@@ -0,0 +1,55 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoarchiveexemptmetrics_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchiveexemptmetrics"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func TestResourceAcceptance(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{
+			name: "automated archival exempt metrics",
+			steps: []resource.TestStep{
+				{
+					Config: tftest.LoadConfig("testdata/resource_exempt_metrics.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("signalfx_automated_archival_exempt_metrics.exempt_metrics", "names.#", "2"),
+						resource.TestCheckTypeSetElemAttr("signalfx_automated_archival_exempt_metrics.exempt_metrics", "names.*", "exempt_metric_1"),
+					),
+				},
+				{
+					Config: tftest.LoadConfig("testdata/resource_exempt_metrics_updated.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("signalfx_automated_archival_exempt_metrics.exempt_metrics", "names.#", "2"),
+						resource.TestCheckTypeSetElemAttr("signalfx_automated_archival_exempt_metrics.exempt_metrics", "names.*", "exempt_metric_3"),
+					),
+				},
+				{
+					ResourceName:            "signalfx_automated_archival_exempt_metrics.exempt_metrics",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"names", "metric_ids"},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tftest.NewAcceptanceHandler(
+				tftest.WithAcceptanceResources(map[string]*schema.Resource{
+					autoarchiveexemptmetrics.ResourceName: autoarchiveexemptmetrics.NewResource(),
+				}),
+			).Test(t, tc.steps)
+		})
+	}
+}
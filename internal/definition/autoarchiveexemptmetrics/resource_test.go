@@ -0,0 +1,266 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoarchiveexemptmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	automated_archival "github.com/signalfx/signalfx-go/automated-archival"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+// testExemptList mocks the exempt list of an organization,
+// recording the size of each create and delete request.
+type testExemptList struct {
+	mu      sync.Mutex
+	next    int
+	ids     map[string]string
+	creates []int
+	deletes []int
+}
+
+func newTestExemptList(names ...string) *testExemptList {
+	l := &testExemptList{ids: make(map[string]string)}
+	for _, name := range names {
+		l.add(name)
+	}
+	return l
+}
+
+func (l *testExemptList) add(name string) string {
+	l.next++
+	l.ids[name] = fmt.Sprintf("id-%02d", l.next)
+	return l.ids[name]
+}
+
+func (l *testExemptList) names() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return slices.Sorted(maps.Keys(l.ids))
+}
+
+func (l *testExemptList) meta(tb testing.TB) any {
+	return tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/automated-archival/exempt-metrics": func(w http.ResponseWriter, _ *http.Request) {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+
+			metrics := []automated_archival.ExemptMetric{}
+			for name, id := range l.ids {
+				metrics = append(metrics, automated_archival.ExemptMetric{Name: name, Id: &id})
+			}
+			_ = json.NewEncoder(w).Encode(metrics)
+		},
+		"POST /v2/automated-archival/exempt-metrics": func(w http.ResponseWriter, r *http.Request) {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+
+			var metrics []automated_archival.ExemptMetric
+			if err := json.NewDecoder(r.Body).Decode(&metrics); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			l.creates = append(l.creates, len(metrics))
+			for i := range metrics {
+				id := l.add(metrics[i].Name)
+				metrics[i].Id = &id
+			}
+			_ = json.NewEncoder(w).Encode(metrics)
+		},
+		"DELETE /v2/automated-archival/exempt-metrics": func(w http.ResponseWriter, r *http.Request) {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+
+			var req automated_archival.ExemptMetricDeleteRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			l.deletes = append(l.deletes, len(req.Ids))
+			maps.DeleteFunc(l.ids, func(_, id string) bool {
+				return slices.Contains(req.Ids, id)
+			})
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})(tb)
+}
+
+func applyTestConfig(t *testing.T, state *terraform.InstanceState, names []any, meta any) *terraform.InstanceState {
+	t.Helper()
+
+	res := NewResource()
+	diff, err := res.Diff(t.Context(), state, terraform.NewResourceConfigRaw(map[string]any{"names": names}), meta)
+	require.NoError(t, err, "Must not error planning the changes")
+	require.NotNil(t, diff, "Must plan changes")
+
+	state, diags := res.Apply(t.Context(), state, diff, meta)
+	require.Empty(t, diags, "Must not error applying the changes")
+	return state
+}
+
+func TestNewResource(t *testing.T) {
+	t.Parallel()
+
+	assert.NotNil(t, NewResource(), "Must return a valid value")
+}
+
+func TestResourceLifecycle(t *testing.T) {
+	t.Parallel()
+
+	list := newTestExemptList("unmanaged")
+	meta := list.meta(t)
+
+	state := applyTestConfig(t, nil, []any{"metric-a", "metric-b", "metric-c"}, meta)
+	assert.Equal(t, ResourceID, state.ID, "Must use the exempt list ID")
+	assert.Equal(t, []string{"metric-a", "metric-b", "metric-c", "unmanaged"}, list.names(), "Must exempt the configured metrics")
+	assert.Equal(t, []int{3}, list.creates, "Must create the configured metrics")
+
+	state = applyTestConfig(t, state, []any{"metric-b", "metric-c", "metric-d"}, meta)
+	assert.Equal(t, []string{"metric-b", "metric-c", "metric-d", "unmanaged"}, list.names(), "Must apply the difference of the names")
+	assert.Equal(t, []int{3, 1}, list.creates, "Must only create the added metrics")
+	assert.Equal(t, []int{1}, list.deletes, "Must only delete the removed metrics")
+	assert.Equal(t, "3", state.Attributes["metric_ids.%"], "Must track the id of each exempt metric")
+
+	res := NewResource()
+	state, diags := res.RefreshWithoutUpgrade(t.Context(), state, meta)
+	require.Empty(t, diags, "Must not error reading the resource")
+	assert.Equal(t, "3", state.Attributes["names.#"], "Must not read exemptions owned outside of the resource")
+
+	diff := &terraform.InstanceDiff{Destroy: true}
+	_, diags = res.Apply(t.Context(), state, diff, meta)
+	require.Empty(t, diags, "Must not error deleting the resource")
+	assert.Equal(t, []string{"unmanaged"}, list.names(), "Must only delete the owned exemptions")
+}
+
+func TestResourceAlreadyExempt(t *testing.T) {
+	t.Parallel()
+
+	const errVal = `metrics metric-b, unmanaged are already exempt from automated archival, import the exempt metrics using the id "automated-archival-exempt-metrics" to manage them`
+
+	list := newTestExemptList("unmanaged", "metric-b")
+	meta := list.meta(t)
+	res := NewResource()
+
+	diff, err := res.Diff(t.Context(), nil, terraform.NewResourceConfigRaw(map[string]any{
+		"names": []any{"metric-a", "metric-b", "unmanaged"},
+	}), meta)
+	require.NoError(t, err, "Must not error planning the resource")
+
+	_, diags := res.Apply(t.Context(), nil, diff, meta)
+	assert.Equal(t, diag.Diagnostics{{Severity: diag.Error, Summary: errVal}}, diags, "Must not adopt exempt metrics on create")
+	assert.Empty(t, list.creates, "Must not create any exemptions")
+
+	state := applyTestConfig(t, nil, []any{"metric-a"}, meta)
+	diff, err = res.Diff(t.Context(), state, terraform.NewResourceConfigRaw(map[string]any{
+		"names": []any{"metric-b", "unmanaged"},
+	}), meta)
+	require.NoError(t, err, "Must not error planning the update")
+
+	_, diags = res.Apply(t.Context(), state, diff, meta)
+	assert.Equal(t, diag.Diagnostics{{Severity: diag.Error, Summary: errVal}}, diags, "Must not adopt exempt metrics on update")
+	assert.Equal(t, []string{"metric-a", "metric-b", "unmanaged"}, list.names(), "Must not delete the removed metrics when the update fails")
+}
+
+func TestResourceRead(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		meta   func(tb testing.TB) any
+		expect []string
+		issues diag.Diagnostics
+	}{
+		{
+			name: "no provider",
+			meta: func(_ testing.TB) any {
+				return nil
+			},
+			expect: []string{"metric-a", "metric-b"},
+			issues: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "expected to implement type Meta"},
+			},
+		},
+		{
+			name: "failed read",
+			meta: tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+				"GET /v2/automated-archival/exempt-metrics": func(w http.ResponseWriter, _ *http.Request) {
+					http.Error(w, "Bad request", http.StatusBadRequest)
+				},
+			}),
+			expect: []string{"metric-a", "metric-b"},
+			issues: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "route \"/v2/automated-archival/exempt-metrics\" had issues with status code 400"},
+			},
+		},
+		{
+			name:   "removed externally",
+			meta:   newTestExemptList("metric-b", "metric-c").meta,
+			expect: []string{"metric-b"},
+			issues: nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rd := NewResource().TestResourceData()
+			require.NoError(t, encodeTerraform(map[string]string{"metric-a": "id-a", "metric-b": "id-b"}, rd), "Must encode the state")
+			rd.SetId(ResourceID)
+
+			issues := resourceRead(t.Context(), rd, tc.meta(t))
+			assert.Equal(t, tc.issues, issues, "Must match the expected issues")
+
+			_, names := decodeTerraform(rd)
+			assert.Equal(t, tc.expect, names, "Must match the expected names")
+		})
+	}
+}
+
+func TestResourceImport(t *testing.T) {
+	t.Parallel()
+
+	list := newTestExemptList("metric-a", "metric-b")
+
+	rd := NewResource().TestResourceData()
+	rd.SetId("exempt")
+
+	results, err := resourceImport(t.Context(), rd, list.meta(t))
+	require.NoError(t, err, "Must not error importing the resource")
+	require.Len(t, results, 1, "Must import a single resource")
+
+	ids, names := decodeTerraform(results[0])
+	assert.Equal(t, ResourceID, results[0].Id(), "Must use the exempt list ID")
+	assert.Equal(t, []string{"metric-a", "metric-b"}, names, "Must import the whole exempt list")
+	assert.Equal(t, map[string]string{"metric-a": "id-01", "metric-b": "id-02"}, ids, "Must import the exempt metric ids")
+}
+
+func TestBatches(t *testing.T) {
+	t.Parallel()
+
+	list := newTestExemptList()
+	meta := list.meta(t)
+
+	names := make([]any, 250)
+	for i := range names {
+		names[i] = fmt.Sprintf("metric-%03d", i)
+	}
+
+	state := applyTestConfig(t, nil, names, meta)
+	assert.Equal(t, []int{100, 100, 50}, list.creates, "Must create the exemptions in batches")
+
+	_, diags := NewResource().Apply(t.Context(), state, &terraform.InstanceDiff{Destroy: true}, meta)
+	require.Empty(t, diags, "Must not error deleting the resource")
+	assert.Equal(t, []int{100, 100, 50}, list.deletes, "Must delete the exemptions in batches")
+	assert.Empty(t, list.names(), "Must remove all the exemptions")
+}
@@ -0,0 +1,60 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoarchiveexemptmetrics
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"go.uber.org/multierr"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+)
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"names": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Description: "Names of the metrics to be exempted from automated archival",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"metric_ids": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "IDs of the exempt metrics, keyed by the metric name",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+// decodeTerraform returns the exempt metric IDs stored in the state keyed by name,
+// and the names of the metrics to exempt.
+func decodeTerraform(data *schema.ResourceData) (map[string]string, []string) {
+	ids := make(map[string]string)
+	for name, id := range data.Get("metric_ids").(map[string]any) {
+		ids[name] = convert.ToString(id)
+	}
+	names := convert.SliceAll(data.Get("names").(*schema.Set).List(), convert.ToString)
+	slices.Sort(names)
+	return ids, names
+}
+
+// encodeTerraform stores the exempt metrics keyed by name,
+// with the names of the set being the keys of the map.
+func encodeTerraform(ids map[string]string, data *schema.ResourceData) error {
+	names := slices.Sorted(maps.Keys(ids))
+	return multierr.Combine(
+		data.Set("names", names),
+		data.Set("metric_ids", ids),
+	)
+}
@@ -0,0 +1,6 @@
+resource "signalfx_automated_archival_exempt_metrics" "exempt_metrics" {
+  names = [
+    "exempt_metric_2",
+    "exempt_metric_3",
+  ]
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/signalfx/signalfx-go/detector"
+	"go.uber.org/multierr"
 
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
@@ -38,7 +39,11 @@ func NewResource() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{Type: v0state().CoreConfigSchema().ImpliedType(), Upgrade: v0stateMigration, Version: 0},
 		},
-		CustomizeDiff: customdiff.If(resourceValidateCond, resourceValidateFunc),
+		CustomizeDiff: customdiff.All(
+			customdiff.If(resourceValidateCond, resourceValidateFunc),
+			pmeta.CustomizeDiffProviderTeams("teams", "teams_all"),
+			pmeta.CustomizeDiffProviderWriterTeams("authorized_writer_teams", "authorized_writer_users", "authorized_writer_teams_all"),
+		),
 	}
 }
 
@@ -56,14 +61,17 @@ func resourceCreate(ctx context.Context, data *schema.ResourceData, meta any) (i
 	tflog.Debug(ctx, "Creating new detector", tfext.NewLogFields().JSON("detector", dt))
 
 	resp, err := client.CreateDetector(ctx, &detector.CreateUpdateDetectorRequest{
-		Name:              dt.Name,
-		AuthorizedWriters: dt.AuthorizedWriters,
-		Description:       dt.Description,
-		TimeZone:          dt.TimeZone,
-		MaxDelay:          dt.MaxDelay,
-		MinDelay:          dt.MinDelay,
-		ProgramText:       dt.ProgramText,
-		Rules:             dt.Rules,
+		Name: dt.Name,
+		AuthorizedWriters: &detector.AuthorizedWriters{
+			Teams: pmeta.MergeProviderWriterTeams(ctx, meta, dt.AuthorizedWriters.Teams, dt.AuthorizedWriters.Users),
+			Users: dt.AuthorizedWriters.Users,
+		},
+		Description: dt.Description,
+		TimeZone:    dt.TimeZone,
+		MaxDelay:    dt.MaxDelay,
+		MinDelay:    dt.MinDelay,
+		ProgramText: dt.ProgramText,
+		Rules:       dt.Rules,
 		Tags: common.Unique(
 			pmeta.LoadProviderTags(ctx, meta),
 			dt.Tags,
@@ -121,7 +129,7 @@ func resourceRead(ctx context.Context, data *schema.ResourceData, meta any) (iss
 
 	return tfext.AppendDiagnostics(
 		issues,
-		tfext.AsErrorDiagnostics(encodeTerraform(dt, data))...,
+		tfext.AsErrorDiagnostics(encodeResource(ctx, meta, dt, data))...,
 	)
 }
 
@@ -140,14 +148,17 @@ func resourceUpdate(ctx context.Context, data *schema.ResourceData, meta any) (i
 	)
 
 	resp, err := client.UpdateDetector(ctx, data.Id(), &detector.CreateUpdateDetectorRequest{
-		Name:              dt.Name,
-		AuthorizedWriters: dt.AuthorizedWriters,
-		Description:       dt.Description,
-		TimeZone:          dt.TimeZone,
-		MaxDelay:          dt.MaxDelay,
-		MinDelay:          dt.MinDelay,
-		ProgramText:       dt.ProgramText,
-		Rules:             dt.Rules,
+		Name: dt.Name,
+		AuthorizedWriters: &detector.AuthorizedWriters{
+			Teams: pmeta.MergeProviderWriterTeams(ctx, meta, dt.AuthorizedWriters.Teams, dt.AuthorizedWriters.Users),
+			Users: dt.AuthorizedWriters.Users,
+		},
+		Description: dt.Description,
+		TimeZone:    dt.TimeZone,
+		MaxDelay:    dt.MaxDelay,
+		MinDelay:    dt.MinDelay,
+		ProgramText: dt.ProgramText,
+		Rules:       dt.Rules,
 		Tags: common.Unique(
 			pmeta.LoadProviderTags(ctx, meta),
 			dt.Tags,
@@ -169,7 +180,7 @@ func resourceUpdate(ctx context.Context, data *schema.ResourceData, meta any) (i
 
 	return tfext.AppendDiagnostics(
 		issues,
-		tfext.AsErrorDiagnostics(encodeTerraform(resp, data))...,
+		tfext.AsErrorDiagnostics(encodeResource(ctx, meta, resp, data))...,
 	)
 }
 
@@ -182,6 +193,40 @@ func resourceDelete(ctx context.Context, data *schema.ResourceData, meta any) di
 	return tfext.AsErrorDiagnostics(err)
 }
 
+// encodeResource stores the API response into the resource data,
+// keeping any teams set by the provider within the computed fields only
+// so that they are not reported as a diff against the configured values.
+func encodeResource(ctx context.Context, meta any, dt *detector.Detector, data *schema.ResourceData) error {
+	filtered := *dt
+	filtered.Teams = pmeta.RemoveProviderTeams(
+		ctx,
+		meta,
+		dt.Teams,
+		convert.SchemaListAll(data.Get("teams"), convert.ToString),
+	)
+
+	errs := data.Set("teams_all", tfext.NewSchemaSet(schema.HashString, dt.Teams))
+
+	// The writer teams are always set so that teams removed outside
+	// of terraform do not remain in authorized_writer_teams_all.
+	auth := dt.AuthorizedWriters
+	if auth == nil {
+		auth = &detector.AuthorizedWriters{}
+	}
+	filtered.AuthorizedWriters = &detector.AuthorizedWriters{
+		Teams: pmeta.RemoveProviderTeams(
+			ctx,
+			meta,
+			auth.Teams,
+			convert.SchemaListAll(data.Get("authorized_writer_teams"), convert.ToString),
+		),
+		Users: auth.Users,
+	}
+	errs = multierr.Append(errs, data.Set("authorized_writer_teams_all", tfext.NewSchemaSet(schema.HashString, auth.Teams)))
+
+	return multierr.Append(errs, encodeTerraform(&filtered, data))
+}
+
 func resourceValidateCond(ctx context.Context, diff *schema.ResourceDiff, _ any) (validate bool) {
 	tflog.Debug(ctx, "Checking if program text or rules needed to be updated")
 	if _, ok := diff.GetOkExists("rule"); ok {
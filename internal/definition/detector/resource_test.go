@@ -10,11 +10,15 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/signalfx/signalfx-go/detector"
 	"github.com/signalfx/signalfx-go/notification"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
 	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
 )
@@ -464,3 +468,76 @@ func TestResourceDelete(t *testing.T) {
 		tc.TestDelete(t)
 	}
 }
+
+func TestResourceCreateProviderTeams(t *testing.T) {
+	t.Parallel()
+
+	var sent *detector.CreateUpdateDetectorRequest
+	newMeta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"POST /v2/detector": func(w http.ResponseWriter, r *http.Request) {
+			sent = &detector.CreateUpdateDetectorRequest{}
+			if err := json.NewDecoder(r.Body).Decode(sent); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(&detector.Detector{Id: "id-01"})
+		},
+		"GET /v2/detector/id-01": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			_ = r.Body.Close()
+
+			_ = json.NewEncoder(w).Encode(&detector.Detector{
+				Id:                "id-01",
+				Name:              sent.Name,
+				AuthorizedWriters: sent.AuthorizedWriters,
+				Teams:             sent.Teams,
+			})
+		},
+	})
+
+	meta := newMeta(t).(*pmeta.Meta)
+	meta.Registry = feature.NewRegistry()
+	_ = meta.Registry.MustRegister(feature.PreviewProviderTeams, feature.WithPreviewGlobalAvailable())
+	meta.Teams = []string{"team-00"}
+
+	rd := NewResource().TestResourceData()
+	require.NoError(t, encodeTerraform(&detector.Detector{
+		Name:  "test detector",
+		Teams: []string{"team-01"},
+		AuthorizedWriters: &detector.AuthorizedWriters{
+			Users: []string{"user-01"},
+		},
+	}, rd), "Must not error encoding detector")
+
+	assert.Empty(t, resourceCreate(t.Context(), rd, meta), "Must not report any issues")
+	require.NotNil(t, sent, "Must have sent the create request")
+	assert.Equal(t, []string{"team-00", "team-01"}, sent.Teams, "Must include the provider teams")
+	assert.Equal(t, []string{"team-00"}, sent.AuthorizedWriters.Teams, "Must include provider teams as writers")
+
+	assert.ElementsMatch(t, []any{"team-01"}, rd.Get("teams").(*schema.Set).List(), "Must only keep the configured teams")
+	assert.ElementsMatch(t, []any{"team-00", "team-01"}, rd.Get("teams_all").(*schema.Set).List(), "Must store all the teams")
+	assert.Empty(t, rd.Get("authorized_writer_teams").(*schema.Set).List(), "Must not store provider writer teams")
+	assert.ElementsMatch(t, []any{"team-00"}, rd.Get("authorized_writer_teams_all").(*schema.Set).List(), "Must store all writer teams")
+}
+
+func TestEncodeResourceRemovedWriterTeams(t *testing.T) {
+	t.Parallel()
+
+	rd := NewResource().TestResourceData()
+	require.NoError(t, encodeResource(t.Context(), &pmeta.Meta{}, &detector.Detector{
+		Name: "test detector",
+		AuthorizedWriters: &detector.AuthorizedWriters{
+			Teams: []string{"team-01"},
+			Users: []string{"user-01"},
+		},
+	}, rd), "Must not error encoding detector")
+	assert.ElementsMatch(t, []any{"team-01"}, rd.Get("authorized_writer_teams_all").(*schema.Set).List(), "Must store all writer teams")
+
+	// The writer teams have been removed outside of terraform
+	require.NoError(t, encodeResource(t.Context(), &pmeta.Meta{}, &detector.Detector{
+		Name: "test detector",
+	}, rd), "Must not error encoding detector")
+	assert.Empty(t, rd.Get("authorized_writer_teams").(*schema.Set).List(), "Must remove writer teams removed outside of terraform")
+	assert.Empty(t, rd.Get("authorized_writer_teams_all").(*schema.Set).List(), "Must remove writer teams removed outside of terraform")
+	assert.Empty(t, rd.Get("authorized_writer_users").(*schema.Set).List(), "Must remove writer users removed outside of terraform")
+}
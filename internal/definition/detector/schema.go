@@ -104,6 +104,12 @@ func newSchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Description: "Team IDs to associate the detector to",
 		},
+		"teams_all": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Team IDs associated with the detector, including the teams set by the provider",
+		},
 		"rule": {
 			Type:        schema.TypeSet,
 			Required:    true,
@@ -119,6 +125,12 @@ func newSchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Description: "Team IDs that have write access to this dashboard",
 		},
+		"authorized_writer_teams_all": {
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Team IDs that have write access to this detector, including the teams set by the provider",
+		},
 		"authorized_writer_users": {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -0,0 +1,227 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package metricruleset contains the analysis shared by the metric ruleset
+// resource to find the content that is affected by its aggregation rules.
+package metricruleset
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/signalfx/signalfx-go/metric_ruleset"
+
+	detectordef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/detector"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+const (
+	// ChartAppPath is the application path used to link to a chart.
+	ChartAppPath = "/chart/"
+
+	// searchLimit is the page size used when listing charts and detectors.
+	searchLimit = 100
+
+	destinationArchived = "Archived"
+	destinationDrop     = "Drop"
+)
+
+var (
+	filterPattern  = regexp.MustCompile(`filter\(\s*['"]([^'"]+)['"]`)
+	byPattern      = regexp.MustCompile(`by\s*=\s*(\[[^\]]*\]|['"][^'"]+['"])`)
+	quotedPattern  = regexp.MustCompile(`['"]([^'"]+)['"]`)
+	dataArgPattern = `data\(\s*['"]%s['"]`
+)
+
+// Impact is a chart or detector whose program text references a metric of the ruleset
+// with a dimension that the aggregation rules drop.
+type Impact struct {
+	Kind       string
+	ID         string
+	Name       string
+	URL        string
+	Dimensions []string
+}
+
+func (i Impact) String() string {
+	return fmt.Sprintf("%s %q (%s) references dropped dimensions %s", i.Kind, i.Name, i.URL, strings.Join(i.Dimensions, ", "))
+}
+
+// FindImpacts lists the charts and detectors whose program text filters or groups
+// the ruleset's metric, or one of its aggregated metrics, by a dimension
+// that an enabled aggregation rule drops.
+// The ruleset's metric is only checked when it is routed to archive or dropped.
+//
+// Requires preview to be enabled in order to perform any lookups,
+// and returns no impacts when the ruleset does not drop any dimensions.
+func FindImpacts(ctx context.Context, meta any, ruleset *metric_ruleset.MetricRuleset) ([]Impact, error) {
+	if g, ok := pmeta.LoadPreviewRegistry(ctx, meta).Get(feature.PreviewMetricRulesetImpact); !ok || !g.Enabled() {
+		return nil, nil
+	}
+
+	client, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		tflog.Debug(ctx, "Provider is not configured, skipping metric ruleset impact analysis")
+		return nil, nil
+	}
+
+	metrics := droppedDimensionsByMetric(ruleset)
+	if len(metrics) == 0 {
+		return nil, nil
+	}
+
+	var impacts []Impact
+	for offset := 0; ; offset += searchLimit {
+		results, err := client.SearchCharts(ctx, searchLimit, "", offset, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range results.Results {
+			if c == nil {
+				continue
+			}
+			if dims := metrics.referenced(c.ProgramText); len(dims) > 0 {
+				impacts = append(impacts, Impact{
+					Kind:       "chart",
+					ID:         c.Id,
+					Name:       c.Name,
+					URL:        pmeta.LoadApplicationURL(ctx, meta, ChartAppPath, c.Id),
+					Dimensions: dims,
+				})
+			}
+		}
+		if len(results.Results) < searchLimit {
+			break
+		}
+	}
+
+	for offset := 0; ; offset += searchLimit {
+		results, err := client.SearchDetectors(ctx, searchLimit, "", offset, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range results.Results {
+			if dims := metrics.referenced(d.ProgramText); len(dims) > 0 {
+				impacts = append(impacts, Impact{
+					Kind:       "detector",
+					ID:         d.Id,
+					Name:       d.Name,
+					URL:        pmeta.LoadApplicationURL(ctx, meta, detectordef.AppPath, d.Id, "edit"),
+					Dimensions: dims,
+				})
+			}
+		}
+		if len(results.Results) < searchLimit {
+			break
+		}
+	}
+
+	tflog.Debug(ctx, "Completed metric ruleset impact analysis", tfext.NewLogFields().
+		Field("metric", ruleset.GetMetricName()).
+		Field("impacts", len(impacts)),
+	)
+
+	return impacts, nil
+}
+
+// droppedDimensions describes the dimensions removed by an aggregation rule,
+// which either lists the dimensions to drop or the dimensions to keep.
+type droppedDimensions struct {
+	dims map[string]bool
+	keep bool
+}
+
+func (dd droppedDimensions) dropped(dim string) bool {
+	return dd.dims[dim] != dd.keep
+}
+
+// droppedMetrics maps the metric names referenced by the ruleset
+// to the dimensions each enabled aggregation rule drops from them.
+type droppedMetrics map[string][]droppedDimensions
+
+func droppedDimensionsByMetric(ruleset *metric_ruleset.MetricRuleset) droppedMetrics {
+	metrics := make(droppedMetrics)
+	if ruleset == nil {
+		return metrics
+	}
+	routing := ruleset.GetRoutingRule()
+	removed := slices.Contains([]string{destinationArchived, destinationDrop}, routing.GetDestination())
+	for _, rule := range ruleset.AggregationRules {
+		if !rule.Enabled || rule.Aggregator.RollupAggregator == nil {
+			continue
+		}
+		agg := rule.Aggregator.RollupAggregator
+
+		dd := droppedDimensions{
+			dims: make(map[string]bool, len(agg.Dimensions)),
+			keep: !agg.GetDropDimensions(),
+		}
+		for _, dim := range agg.Dimensions {
+			dd.dims[dim] = true
+		}
+		if !dd.keep && len(dd.dims) == 0 {
+			continue
+		}
+
+		// Content that used the input metric is expected to move to the aggregated metric
+		// once the input metric is archived or dropped, and keeps working while it is still
+		// routed in real time. Content that already uses the aggregated metric can not use
+		// the dropped dimensions.
+		if name := ruleset.GetMetricName(); name != "" && removed {
+			metrics[name] = append(metrics[name], dd)
+		}
+		if agg.OutputName != "" {
+			metrics[agg.OutputName] = append(metrics[agg.OutputName], dd)
+		}
+	}
+	return metrics
+}
+
+// referenced returns the sorted dimensions that the program text filters or groups by
+// and that are dropped from a metric it reads.
+func (dm droppedMetrics) referenced(program string) []string {
+	var rules []droppedDimensions
+	for metric, dropped := range dm {
+		if regexp.MustCompile(fmt.Sprintf(dataArgPattern, regexp.QuoteMeta(metric))).MatchString(program) {
+			rules = append(rules, dropped...)
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var dims []string
+	for _, dim := range programDimensions(program) {
+		for _, dd := range rules {
+			if dd.dropped(dim) {
+				dims = append(dims, dim)
+				break
+			}
+		}
+	}
+	slices.Sort(dims)
+	return slices.Compact(dims)
+}
+
+// programDimensions returns the dimensions used by the filters and groupings of the program text.
+func programDimensions(program string) []string {
+	var dims []string
+	for _, match := range filterPattern.FindAllStringSubmatch(program, -1) {
+		dims = append(dims, match[1])
+	}
+	for _, match := range byPattern.FindAllStringSubmatch(program, -1) {
+		for _, quoted := range quotedPattern.FindAllStringSubmatch(match[1], -1) {
+			dims = append(dims, quoted[1])
+		}
+	}
+	return dims
+}
@@ -0,0 +1,240 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metricruleset
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/signalfx/signalfx-go/metric_ruleset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func newTestRuleset(drop bool, dims ...string) *metric_ruleset.MetricRuleset {
+	return &metric_ruleset.MetricRuleset{
+		MetricName: common.AsPointer("cpu.utilization"),
+		RoutingRule: &metric_ruleset.RoutingRule{
+			Destination: common.AsPointer("Archived"),
+		},
+		AggregationRules: []metric_ruleset.AggregationRule{
+			{
+				Name:    common.AsPointer("rollup"),
+				Enabled: true,
+				Aggregator: metric_ruleset.MetricAggregator{
+					RollupAggregator: &metric_ruleset.RollupAggregator{
+						Type:           "rollup",
+						OutputName:     "cpu.utilization.by_region",
+						Dimensions:     dims,
+						DropDimensions: common.AsPointer(drop),
+					},
+				},
+			},
+		},
+	}
+}
+
+func newTestImpactMeta(t *testing.T, enabled bool, requests *atomic.Int32) any {
+	t.Helper()
+
+	meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/chart": func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			_, _ = w.Write([]byte(`{"count":2,"results":[
+				{"id":"chart-01","name":"CPU by host","programText":"data('cpu.utilization', filter=filter('host', 'web-*')).mean(by=['region']).publish()"},
+				{"id":"chart-02","name":"Memory by host","programText":"data('memory.utilization').mean(by=['host']).publish()"}
+			]}`))
+		},
+		"GET /v2/detector": func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			_, _ = w.Write([]byte(`{"count":1,"results":[
+				{"id":"detector-01","name":"CPU by zone","programText":"detect(when(data(\"cpu.utilization.by_region\").mean(by='zone') > 90)).publish('CPU')"}
+			]}`))
+		},
+	})(t).(*pmeta.Meta)
+
+	r := feature.NewRegistry()
+	preview := r.MustRegister(feature.PreviewMetricRulesetImpact)
+	preview.SetEnabled(enabled)
+	meta.Registry = r
+
+	return meta
+}
+
+func TestFindImpacts(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		enabled  bool
+		ruleset  *metric_ruleset.MetricRuleset
+		impacts  []Impact
+		requests int32
+	}{
+		{
+			name:     "preview disabled",
+			enabled:  false,
+			ruleset:  newTestRuleset(true, "host"),
+			impacts:  nil,
+			requests: 0,
+		},
+		{
+			name:     "no dropped dimensions",
+			enabled:  true,
+			ruleset:  newTestRuleset(true),
+			impacts:  nil,
+			requests: 0,
+		},
+		{
+			name:    "dropped dimensions",
+			enabled: true,
+			ruleset: newTestRuleset(true, "host", "zone"),
+			impacts: []Impact{
+				{Kind: "chart", ID: "chart-01", Name: "CPU by host", Dimensions: []string{"host"}},
+				{Kind: "detector", ID: "detector-01", Name: "CPU by zone", Dimensions: []string{"zone"}},
+			},
+			requests: 2,
+		},
+		{
+			name:    "real time input metric",
+			enabled: true,
+			ruleset: func() *metric_ruleset.MetricRuleset {
+				rs := newTestRuleset(true, "host", "zone")
+				rs.RoutingRule.Destination = common.AsPointer("RealTime")
+				return rs
+			}(),
+			impacts: []Impact{
+				{Kind: "detector", ID: "detector-01", Name: "CPU by zone", Dimensions: []string{"zone"}},
+			},
+			requests: 2,
+		},
+		{
+			name:    "kept dimensions",
+			enabled: true,
+			ruleset: newTestRuleset(false, "region"),
+			impacts: []Impact{
+				{Kind: "chart", ID: "chart-01", Name: "CPU by host", Dimensions: []string{"host"}},
+				{Kind: "detector", ID: "detector-01", Name: "CPU by zone", Dimensions: []string{"zone"}},
+			},
+			requests: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			meta := newTestImpactMeta(t, tc.enabled, &requests)
+
+			impacts, err := FindImpacts(context.Background(), meta, tc.ruleset)
+			require.NoError(t, err, "Must not error finding impacts")
+			for i := range impacts {
+				assert.NotEmpty(t, impacts[i].URL, "Must link to the affected content")
+				impacts[i].URL = ""
+			}
+			assert.Equal(t, tc.impacts, impacts, "Must match the expected impacts")
+			assert.Equal(t, tc.requests, requests.Load(), "Must match the expected number of requests")
+		})
+	}
+}
+
+func TestFindImpactsURL(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	meta := newTestImpactMeta(t, true, &requests)
+
+	impacts, err := FindImpacts(context.Background(), meta, newTestRuleset(true, "host", "zone"))
+	require.NoError(t, err, "Must not error finding impacts")
+	require.Len(t, impacts, 2, "Must find the affected chart and detector")
+	assert.Equal(t, pmeta.LoadApplicationURL(context.Background(), meta, ChartAppPath, "chart-01"), impacts[0].URL, "Must link to the chart")
+	assert.Equal(t, pmeta.LoadApplicationURL(context.Background(), meta, "/detector/v2", "detector-01", "edit"), impacts[1].URL, "Must link to the detector")
+}
+
+func TestFindImpactsError(t *testing.T) {
+	t.Parallel()
+
+	meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/chart": func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, "Bad request", http.StatusBadRequest)
+		},
+	})(t).(*pmeta.Meta)
+	r := feature.NewRegistry()
+	r.MustRegister(feature.PreviewMetricRulesetImpact).SetEnabled(true)
+	meta.Registry = r
+
+	_, err := FindImpacts(context.Background(), meta, newTestRuleset(true, "host"))
+	assert.Error(t, err, "Must return the search error")
+}
+
+func TestProgramDimensions(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		program string
+		dims    []string
+	}{
+		{
+			name:    "no dimensions",
+			program: "data('cpu.utilization').publish()",
+			dims:    nil,
+		},
+		{
+			name:    "filters",
+			program: "data('cpu.utilization', filter=filter('host', 'a') and not filter(\"zone\", 'b')).publish()",
+			dims:    []string{"host", "zone"},
+		},
+		{
+			name:    "groupings",
+			program: "A = data('cpu.utilization').sum(by=['region', \"az\"]).publish()\nB = data('cpu.utilization').max(by = 'host').publish()",
+			dims:    []string{"region", "az", "host"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.dims, programDimensions(tc.program), "Must match the expected dimensions")
+		})
+	}
+}
+
+func TestDroppedDimensionsByMetric(t *testing.T) {
+	t.Parallel()
+
+	disabled := newTestRuleset(true, "host")
+	disabled.AggregationRules[0].Enabled = false
+	assert.Empty(t, droppedDimensionsByMetric(disabled), "Must ignore disabled rules")
+	assert.Empty(t, droppedDimensionsByMetric(nil), "Must handle a missing ruleset")
+
+	metrics := droppedDimensionsByMetric(newTestRuleset(false, "region"))
+	assert.Len(t, metrics, 2, "Must include the input and aggregated metrics")
+	assert.Equal(t, []string{"host"}, metrics.referenced("data('cpu.utilization').sum(by=['region', 'host'])"), "Must drop the dimensions that are not kept")
+	assert.Nil(t, metrics.referenced("data('cpu.utilization.total').sum(by=['host'])"), "Must not match a metric with a shared prefix")
+
+	for _, tc := range []struct {
+		destination string
+		metrics     []string
+	}{
+		{destination: "RealTime", metrics: []string{"cpu.utilization.by_region"}},
+		{destination: "Archived", metrics: []string{"cpu.utilization", "cpu.utilization.by_region"}},
+		{destination: "Drop", metrics: []string{"cpu.utilization", "cpu.utilization.by_region"}},
+	} {
+		rs := newTestRuleset(true, "host")
+		rs.RoutingRule.Destination = common.AsPointer(tc.destination)
+		metrics := droppedDimensionsByMetric(rs)
+
+		var names []string
+		for name := range metrics {
+			names = append(names, name)
+		}
+		assert.ElementsMatch(t, tc.metrics, names, "Must match the metrics checked when routed to %s", tc.destination)
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package notification does not export a resource or data source
+// since notifications are always part of another object.
+// It defines the structured `notification` block that can be used
+// instead of the comma delimited notification strings,
+// which is shared by detector rules, SLO alert rules and teams.
+package notification
@@ -0,0 +1,246 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/signalfx/signalfx-go/notification"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+)
+
+// DecodeTerraform converts the notification blocks into the API type.
+func DecodeTerraform(blocks []any) ([]*notification.Notification, error) {
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+	values := make([]*notification.Notification, 0, len(blocks))
+	for i, raw := range blocks {
+		block, _ := raw.(map[string]any)
+
+		var set []string
+		for _, name := range BlockNames() {
+			if v, ok := block[name].([]any); ok && len(v) > 0 {
+				set = append(set, name)
+			}
+		}
+		if len(set) != 1 {
+			return nil, fmt.Errorf("notification %d must set exactly one of: %s", i, strings.Join(BlockNames(), ", "))
+		}
+
+		fields, _ := block[set[0]].([]any)[0].(map[string]any)
+		n, err := decodeBlock(blockTypes[set[0]], fields)
+		if err != nil {
+			return nil, fmt.Errorf("notification %d: %w", i, err)
+		}
+		values = append(values, n)
+	}
+	return values, nil
+}
+
+func decodeBlock(typ string, fields map[string]any) (*notification.Notification, error) {
+	str := func(key string) string {
+		s, _ := fields[key].(string)
+		return s
+	}
+
+	var value any
+	switch typ {
+	case common.AmazonEventBrigeNotificationType:
+		value = &notification.AmazonEventBrigeNotification{Type: typ, CredentialId: str("credential_id")}
+	case common.BigPandaNotificationType:
+		value = &notification.BigPandaNotification{Type: typ, CredentialId: str("credential_id")}
+	case common.EmailNotificationType:
+		value = &notification.EmailNotification{
+			Type:  typ,
+			Email: str("email"),
+			Cc:    stringList(fields["cc"]),
+			Bcc:   stringList(fields["bcc"]),
+		}
+	case common.JiraNotificationType:
+		value = &notification.JiraNotification{Type: typ, CredentialId: str("credential_id")}
+	case common.Office365NotificationType:
+		value = &notification.Office365Notification{Type: typ, CredentialId: str("credential_id")}
+	case common.OpsgenieNotificationType:
+		value = &notification.OpsgenieNotification{
+			Type:          typ,
+			CredentialId:  str("credential_id"),
+			ResponderName: str("responder_name"),
+			ResponderId:   str("responder_id"),
+			ResponderType: str("responder_type"),
+		}
+	case common.PagerDutyNotificationType:
+		value = &notification.PagerDutyNotification{Type: typ, CredentialId: str("credential_id")}
+	case common.ServiceNowNotificationType:
+		value = &notification.ServiceNowNotification{Type: typ, CredentialId: str("credential_id")}
+	case common.SlackNotificationType:
+		value = &notification.SlackNotification{Type: typ, CredentialId: str("credential_id"), Channel: str("channel")}
+	case common.SplunkPlatformNotificationType:
+		value = &notification.SplunkPlatformNotification{Type: typ, CredentialId: str("credential_id")}
+	case common.TeamNotificationType:
+		value = &notification.TeamNotification{Type: typ, Team: str("team_id")}
+	case common.TeamEmailNotificationType:
+		value = &notification.TeamEmailNotification{Type: typ, Team: str("team_id")}
+	case common.VictorOpsNotificationType:
+		value = &notification.VictorOpsNotification{Type: typ, CredentialId: str("credential_id"), RoutingKey: str("routing_key")}
+	case common.WebhookNotificationType:
+		switch {
+		case str("credential_id") != "" && str("url") != "":
+			return nil, errors.New("webhook must set only one of credential_id or url")
+		case str("credential_id") != "":
+			// Do nothing, the credential is used to send the webhook
+		case str("url") != "":
+			if _, err := url.ParseRequestURI(str("url")); err != nil {
+				return nil, fmt.Errorf("invalid webhook url %q", str("url"))
+			}
+		default:
+			return nil, errors.New("webhook must set one of credential_id or url")
+		}
+		value = &notification.WebhookNotification{
+			Type:         typ,
+			CredentialId: str("credential_id"),
+			Secret:       str("secret"),
+			Url:          str("url"),
+		}
+	case common.XMattersNotificationType:
+		value = &notification.XMattersNotification{Type: typ, CredentialId: str("credential_id")}
+	default:
+		return nil, fmt.Errorf("invalid notification type %q", typ)
+	}
+	return &notification.Notification{Type: typ, Value: value}, nil
+}
+
+// EncodeTerraform converts the API notifications into notification blocks.
+func EncodeTerraform(items []*notification.Notification) ([]map[string]any, error) {
+	blocks := make([]map[string]any, 0, len(items))
+	for _, n := range items {
+		if n == nil {
+			return nil, errors.New("nil value provided")
+		}
+
+		var (
+			typ    = n.Type
+			fields map[string]any
+		)
+		switch v := n.Value.(type) {
+		case *notification.AmazonEventBrigeNotification:
+			fields = map[string]any{"credential_id": v.CredentialId}
+		case *notification.BigPandaNotification:
+			fields = map[string]any{"credential_id": v.CredentialId}
+		case *notification.EmailNotification:
+			fields = map[string]any{"email": v.Email, "cc": v.Cc, "bcc": v.Bcc}
+		case *notification.JiraNotification:
+			fields = map[string]any{"credential_id": v.CredentialId}
+		case *notification.Office365Notification:
+			fields = map[string]any{"credential_id": v.CredentialId}
+		case *notification.OpsgenieNotification:
+			fields = map[string]any{
+				"credential_id":  v.CredentialId,
+				"responder_name": v.ResponderName,
+				"responder_id":   v.ResponderId,
+				"responder_type": v.ResponderType,
+			}
+		case *notification.PagerDutyNotification:
+			fields = map[string]any{"credential_id": v.CredentialId}
+		case *notification.ServiceNowNotification:
+			fields = map[string]any{"credential_id": v.CredentialId}
+		case *notification.SlackNotification:
+			fields = map[string]any{"credential_id": v.CredentialId, "channel": v.Channel}
+		case *notification.SplunkPlatformNotification:
+			fields = map[string]any{"credential_id": v.CredentialId}
+		case *notification.TeamNotification:
+			fields = map[string]any{"team_id": v.Team}
+		case *notification.TeamEmailNotification:
+			fields = map[string]any{"team_id": v.Team}
+		case *notification.VictorOpsNotification:
+			fields = map[string]any{"credential_id": v.CredentialId, "routing_key": v.RoutingKey}
+		case *notification.WebhookNotification:
+			fields = map[string]any{"credential_id": v.CredentialId, "secret": v.Secret, "url": v.Url}
+		case *notification.XMattersNotification:
+			fields = map[string]any{"credential_id": v.CredentialId}
+		default:
+			return nil, fmt.Errorf("unknown type %T provided", n.Value)
+		}
+
+		name, ok := blockName(typ)
+		if !ok {
+			return nil, fmt.Errorf("invalid notification type %q", typ)
+		}
+		blocks = append(blocks, map[string]any{
+			name: []any{fields},
+		})
+	}
+	return blocks, nil
+}
+
+// EncodeTerraformPrior splits the API notifications between the string list and notification blocks
+// so that each notification keeps the form it was configured with.
+// Notifications that match a prior string remain strings, and the remaining notifications
+// are only returned as blocks when notification blocks were used previously.
+func EncodeTerraformPrior(items []*notification.Notification, priorStrings, priorBlocks []any) ([]string, []map[string]any, error) {
+	strs := make([]string, 0, len(items))
+	var blocks []*notification.Notification
+	for _, n := range items {
+		s, err := common.NewNotificationStringFromAPI(n)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(priorBlocks) > 0 && !slices.Contains(priorStrings, any(s)) {
+			blocks = append(blocks, n)
+			continue
+		}
+		strs = append(strs, s)
+	}
+
+	encoded, err := EncodeTerraform(blocks)
+	if err != nil {
+		return nil, nil, err
+	}
+	return strs, encoded, nil
+}
+
+// Strings returns the notification string of each valid block, which is used
+// when comparing notifications regardless of how they were configured.
+func Strings(blocks []any) []string {
+	var values []string
+	for _, block := range blocks {
+		items, err := DecodeTerraform([]any{block})
+		if err != nil {
+			continue
+		}
+		if s, err := common.NewNotificationStringFromAPI(items[0]); err == nil {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+func blockName(typ string) (string, bool) {
+	for name, t := range blockTypes {
+		if t == typ {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func stringList(v any) []string {
+	var values []string
+	switch v := v.(type) {
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+	case []string:
+		values = append(values, v...)
+	}
+	return values
+}
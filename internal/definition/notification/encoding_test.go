@@ -0,0 +1,133 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notification
+
+import (
+	"testing"
+
+	"github.com/signalfx/signalfx-go/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+)
+
+func TestDecodeTerraform(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		block  string
+		fields map[string]any
+		str    string
+	}{
+		{block: "amazon_eventbridge", fields: map[string]any{"credential_id": "cred"}, str: "AmazonEventBridge,cred"},
+		{block: "bigpanda", fields: map[string]any{"credential_id": "cred"}, str: "BigPanda,cred"},
+		{block: "email", fields: map[string]any{"email": "oncall@example.com"}, str: "Email,oncall@example.com"},
+		{
+			block:  "email",
+			fields: map[string]any{"email": "oncall@example.com", "cc": []any{"lead@example.com"}, "bcc": []any{"audit@example.com"}},
+			str:    "Email,oncall@example.com,lead@example.com,audit@example.com",
+		},
+		{block: "jira", fields: map[string]any{"credential_id": "cred"}, str: "Jira,cred"},
+		{block: "office365", fields: map[string]any{"credential_id": "cred"}, str: "Office365,cred"},
+		{
+			block:  "opsgenie",
+			fields: map[string]any{"credential_id": "cred", "responder_name": "ops", "responder_id": "id", "responder_type": "Team"},
+			str:    "Opsgenie,cred,ops,id,Team",
+		},
+		{block: "pagerduty", fields: map[string]any{"credential_id": "cred"}, str: "PagerDuty,cred"},
+		{block: "servicenow", fields: map[string]any{"credential_id": "cred"}, str: "ServiceNow,cred"},
+		{block: "slack", fields: map[string]any{"credential_id": "cred", "channel": "alerts"}, str: "Slack,cred,alerts"},
+		{block: "splunk_platform", fields: map[string]any{"credential_id": "cred"}, str: "SplunkPlatform,cred"},
+		{block: "team", fields: map[string]any{"team_id": "team-01"}, str: "Team,team-01"},
+		{block: "team_email", fields: map[string]any{"team_id": "team-01"}, str: "TeamEmail,team-01"},
+		{block: "victorops", fields: map[string]any{"credential_id": "cred", "routing_key": "key"}, str: "VictorOps,cred,key"},
+		{block: "webhook", fields: map[string]any{"credential_id": "cred"}, str: "Webhook,cred,,"},
+		{block: "webhook", fields: map[string]any{"secret": "s3cret", "url": "https://example.com/hook"}, str: "Webhook,,s3cret,https://example.com/hook"},
+		{block: "xmatters", fields: map[string]any{"credential_id": "cred"}, str: "XMatters,cred"},
+	} {
+		t.Run(tc.str, func(t *testing.T) {
+			t.Parallel()
+
+			block := map[string]any{tc.block: []any{tc.fields}}
+			actual, err := DecodeTerraform([]any{block})
+			require.NoError(t, err, "Must not error decoding the block")
+
+			expect, err := common.NewNotificationFromString(tc.str)
+			require.NoError(t, err, "Must not error parsing the string")
+			assert.Equal(t, []*notification.Notification{expect}, actual, "Must match the notification string")
+
+			encoded, err := EncodeTerraform(actual)
+			require.NoError(t, err, "Must not error encoding the notification")
+			roundtrip, err := DecodeTerraform([]any{map[string]any{tc.block: encoded[0][tc.block]}})
+			require.NoError(t, err, "Must not error decoding the encoded block")
+			assert.Equal(t, actual, roundtrip, "Must round trip the notification")
+
+			assert.Equal(t, []string{tc.str}, Strings([]any{block}), "Must convert the block to its string")
+		})
+	}
+}
+
+func TestDecodeTerraformErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		blocks []any
+		errVal string
+	}{
+		{
+			name:   "no type set",
+			blocks: []any{map[string]any{}},
+			errVal: "notification 0 must set exactly one of: amazon_eventbridge, bigpanda, email, jira, office365, opsgenie, pagerduty, servicenow, slack, splunk_platform, team, team_email, victorops, webhook, xmatters",
+		},
+		{
+			name: "multiple types set",
+			blocks: []any{map[string]any{
+				"jira":      []any{map[string]any{"credential_id": "cred"}},
+				"pagerduty": []any{map[string]any{"credential_id": "cred"}},
+			}},
+			errVal: "notification 0 must set exactly one of: amazon_eventbridge, bigpanda, email, jira, office365, opsgenie, pagerduty, servicenow, slack, splunk_platform, team, team_email, victorops, webhook, xmatters",
+		},
+		{
+			name: "webhook with credential and url",
+			blocks: []any{
+				map[string]any{"xmatters": []any{map[string]any{"credential_id": "cred"}}},
+				map[string]any{"webhook": []any{map[string]any{"credential_id": "cred", "url": "https://example.com"}}},
+			},
+			errVal: "notification 1: webhook must set only one of credential_id or url",
+		},
+		{
+			name:   "webhook without destination",
+			blocks: []any{map[string]any{"webhook": []any{map[string]any{"secret": "s3cret"}}}},
+			errVal: "notification 0: webhook must set one of credential_id or url",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := DecodeTerraform(tc.blocks)
+			assert.EqualError(t, err, tc.errVal, "Must match the expected error")
+		})
+	}
+}
+
+func TestEncodeTerraformPrior(t *testing.T) {
+	t.Parallel()
+
+	items, err := common.NewNotificationList([]any{"Email,oncall@example.com", "Slack,cred,alerts"})
+	require.NoError(t, err, "Must not error parsing notifications")
+
+	strs, blocks, err := EncodeTerraformPrior(items, []any{"Slack,cred,alerts"}, nil)
+	require.NoError(t, err, "Must not error encoding notifications")
+	assert.Equal(t, []string{"Email,oncall@example.com", "Slack,cred,alerts"}, strs, "Must use strings without prior blocks")
+	assert.Empty(t, blocks, "Must not use blocks without prior blocks")
+
+	strs, blocks, err = EncodeTerraformPrior(items, []any{"Slack,cred,alerts"}, []any{map[string]any{}})
+	require.NoError(t, err, "Must not error encoding notifications")
+	assert.Equal(t, []string{"Slack,cred,alerts"}, strs, "Must keep the prior strings")
+	assert.Equal(t, []map[string]any{
+		{"email": []any{map[string]any{"email": "oncall@example.com", "cc": []string(nil), "bcc": []string(nil)}}},
+	}, blocks, "Must use blocks for the remaining notifications")
+}
@@ -0,0 +1,230 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notification
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/check"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+)
+
+// blockTypes maps the name of each block to the notification type it configures.
+var blockTypes = map[string]string{
+	"amazon_eventbridge": common.AmazonEventBrigeNotificationType,
+	"bigpanda":           common.BigPandaNotificationType,
+	"email":              common.EmailNotificationType,
+	"jira":               common.JiraNotificationType,
+	"office365":          common.Office365NotificationType,
+	"opsgenie":           common.OpsgenieNotificationType,
+	"pagerduty":          common.PagerDutyNotificationType,
+	"servicenow":         common.ServiceNowNotificationType,
+	"slack":              common.SlackNotificationType,
+	"splunk_platform":    common.SplunkPlatformNotificationType,
+	"team":               common.TeamNotificationType,
+	"team_email":         common.TeamEmailNotificationType,
+	"victorops":          common.VictorOpsNotificationType,
+	"webhook":            common.WebhookNotificationType,
+	"xmatters":           common.XMattersNotificationType,
+}
+
+// BlockNames returns the names of the blocks that can be set within a notification.
+func BlockNames() []string {
+	return slices.Sorted(maps.Keys(blockTypes))
+}
+
+// NewSchema returns the schema for a list of structured notifications,
+// each notification must set exactly one of the blocks returned by [BlockNames].
+func NewSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			SchemaFunc: newBlockSchema,
+		},
+	}
+}
+
+// ValidateBlock checks the fields of a single block with the same validators used by the schema,
+// so that notifications built outside of a configuration are held to the same rules.
+// Fields set to an empty value are treated as not being set.
+func ValidateBlock(name string, fields map[string]any) error {
+	block, ok := newBlockSchema()[name]
+	if !ok {
+		return fmt.Errorf("unknown notification block %q", name)
+	}
+
+	raw := make(map[string]any, len(fields))
+	for k, v := range fields {
+		switch v := v.(type) {
+		case string:
+			if v != "" {
+				raw[k] = v
+			}
+		case []any:
+			if len(v) > 0 {
+				raw[k] = v
+			}
+		}
+	}
+
+	res := &schema.Resource{Schema: block.Elem.(*schema.Resource).Schema}
+	var issues []string
+	for _, d := range res.Validate(terraform.NewResourceConfigRaw(raw)) {
+		if d.Severity != diag.Error {
+			continue
+		}
+		var path []string
+		for _, step := range d.AttributePath {
+			switch step := step.(type) {
+			case cty.GetAttrStep:
+				path = append(path, step.Name)
+			case cty.IndexStep:
+				i, _ := step.Key.AsBigFloat().Int64()
+				path = append(path, strconv.FormatInt(i, 10))
+			}
+		}
+		field := strings.Join(path, ".")
+		switch {
+		case d.Summary == "Missing required argument":
+			issues = append(issues, field+" must not be empty")
+		case strings.Contains(d.Summary, field):
+			issues = append(issues, d.Summary)
+		default:
+			issues = append(issues, field+": "+d.Summary)
+		}
+	}
+
+	// The validators are run in no particular order.
+	slices.Sort(issues)
+	errs := make([]error, 0, len(issues))
+	for _, issue := range issues {
+		errs = append(errs, errors.New(issue))
+	}
+	return errors.Join(errs...)
+}
+
+func newBlockSchema() map[string]*schema.Schema {
+	blocks := make(map[string]*schema.Schema, len(blockTypes))
+	for name, typ := range blockTypes {
+		fields := map[string]*schema.Schema{
+			"credential_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The ID of the " + typ + " integration credential.",
+			},
+		}
+
+		switch typ {
+		case common.EmailNotificationType:
+			fields = map[string]*schema.Schema{
+				"email": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: check.Email,
+					Description:      "The email address to notify.",
+				},
+				"cc": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: check.Email},
+					Description: "Email addresses to copy on the notification.",
+				},
+				"bcc": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: check.Email},
+					Description: "Email addresses to blind copy on the notification.",
+				},
+			}
+		case common.OpsgenieNotificationType:
+			fields["responder_name"] = &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Opsgenie responder.",
+			}
+			fields["responder_id"] = &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Opsgenie responder.",
+			}
+			fields["responder_type"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Team", "User", "Escalation", "Schedule"}, false),
+				Description:  "The type of the Opsgenie responder, must be one of: Team, User, Escalation, Schedule.",
+			}
+		case common.SlackNotificationType:
+			fields["channel"] = &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringIsNotEmpty,
+					validation.StringDoesNotContainAny("#"),
+				),
+				Description: "The Slack channel to notify, excluding the leading `#`.",
+			}
+		case common.TeamNotificationType, common.TeamEmailNotificationType:
+			fields = map[string]*schema.Schema{
+				"team_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the team to notify.",
+				},
+			}
+		case common.VictorOpsNotificationType:
+			fields["routing_key"] = &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The Splunk On-Call routing key.",
+			}
+		case common.WebhookNotificationType:
+			fields = map[string]*schema.Schema{
+				"credential_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The ID of the webhook integration credential, conflicts with `url`.",
+				},
+				"secret": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The secret sent with the webhook when using `url`.",
+				},
+				"url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description:  "The URL of the webhook, conflicts with `credential_id`.",
+				},
+			}
+		}
+
+		blocks[name] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Sends the notification using " + typ + ".",
+			Elem: &schema.Resource{
+				Schema: fields,
+			},
+		}
+	}
+	return blocks
+}
@@ -0,0 +1,88 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notification
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSchema(t *testing.T) {
+	t.Parallel()
+
+	s := NewSchema("notifications")
+	require.NoError(t, schema.InternalMap(map[string]*schema.Schema{"notification": s}).InternalValidate(nil), "Must be a valid schema")
+
+	blocks := s.Elem.(*schema.Resource).SchemaMap()
+	assert.Len(t, blocks, len(blockTypes), "Must define a block for each notification type")
+	for _, name := range BlockNames() {
+		assert.Equal(t, 1, blocks[name].MaxItems, "Must only allow one %s block", name)
+	}
+}
+
+func TestNewSchemaValidation(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		block  string
+		fields map[string]any
+		valid  bool
+	}{
+		{name: "valid email", block: "email", fields: map[string]any{"email": "oncall@example.com", "cc": []any{"lead@example.com"}}, valid: true},
+		{name: "invalid email", block: "email", fields: map[string]any{"email": "oncall"}, valid: false},
+		{name: "invalid cc", block: "email", fields: map[string]any{"email": "oncall@example.com", "cc": []any{"lead"}}, valid: false},
+		{name: "valid slack", block: "slack", fields: map[string]any{"credential_id": "cred", "channel": "alerts"}, valid: true},
+		{name: "slack channel with hash", block: "slack", fields: map[string]any{"credential_id": "cred", "channel": "#alerts"}, valid: false},
+		{name: "missing credential", block: "pagerduty", fields: map[string]any{}, valid: false},
+		{name: "valid opsgenie", block: "opsgenie", fields: map[string]any{"credential_id": "cred", "responder_name": "ops", "responder_id": "id", "responder_type": "Team"}, valid: true},
+		{name: "invalid opsgenie responder", block: "opsgenie", fields: map[string]any{"credential_id": "cred", "responder_name": "ops", "responder_id": "id", "responder_type": "Group"}, valid: false},
+		{name: "invalid webhook url", block: "webhook", fields: map[string]any{"url": "not a url"}, valid: false},
+		{name: "valid xmatters", block: "xmatters", fields: map[string]any{"credential_id": "cred"}, valid: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &schema.Resource{Schema: map[string]*schema.Schema{"notification": NewSchema("notifications")}}
+			diags := res.Validate(terraform.NewResourceConfigRaw(map[string]any{
+				"notification": []any{map[string]any{tc.block: []any{tc.fields}}},
+			}))
+			assert.Equal(t, tc.valid, !diags.HasError(), "Must match the expected validation result: %v", diags)
+		})
+	}
+}
+
+func TestValidateBlock(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		block  string
+		fields map[string]any
+		errVal string
+	}{
+		{name: "valid slack", block: "slack", fields: map[string]any{"credential_id": "cred", "channel": "alerts"}},
+		{name: "empty credential", block: "slack", fields: map[string]any{"credential_id": "", "channel": "alerts"}, errVal: "credential_id must not be empty"},
+		{name: "slack channel with hash", block: "slack", fields: map[string]any{"credential_id": "cred", "channel": "#alerts"}, errVal: `expected value of channel to not contain any of "#", got #alerts`},
+		{name: "empty responder", block: "opsgenie", fields: map[string]any{"credential_id": "cred", "responder_name": "", "responder_id": "id", "responder_type": "Team"}, errVal: "responder_name must not be empty"},
+		{name: "invalid bcc", block: "email", fields: map[string]any{"email": "oncall@example.com", "bcc": []any{"audit"}}, errVal: "bcc.0: mail: missing '@' or angle-addr"},
+		{name: "webhook without url", block: "webhook", fields: map[string]any{"credential_id": "cred", "secret": "", "url": ""}},
+		{name: "unknown block", block: "pager", fields: map[string]any{}, errVal: `unknown notification block "pager"`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateBlock(tc.block, tc.fields)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must match the expected error")
+			} else {
+				assert.NoError(t, err, "Must not error validating the block")
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"go.uber.org/multierr"
 
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
 	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
@@ -37,6 +38,7 @@ func datasourceRead(ctx context.Context, rd *schema.ResourceData, meta any) diag
 	var (
 		hasher = fnv.New64()
 		users  []any
+		emails = make(map[string]any)
 		limit  = 1000
 	)
 
@@ -51,6 +53,7 @@ func datasourceRead(ctx context.Context, rd *schema.ResourceData, meta any) diag
 			for _, u := range results.Results {
 				tflog.Debug(ctx, "Retrieved user details", tfext.NewLogFields().JSON("user", u))
 				users = append(users, u.Id)
+				emails[u.Id] = u.Email
 			}
 
 			if offset >= int(results.Count) {
@@ -60,5 +63,8 @@ func datasourceRead(ctx context.Context, rd *schema.ResourceData, meta any) diag
 	}
 	rd.SetId(strconv.FormatUint(hasher.Sum64(), 36))
 
-	return tfext.AsErrorDiagnostics(rd.Set("users", users))
+	return tfext.AsErrorDiagnostics(multierr.Combine(
+		rd.Set("users", users),
+		rd.Set("user_emails", emails),
+	))
 }
@@ -29,6 +29,7 @@ func TestDataSourceRead(t *testing.T) {
 		name   string
 		meta   func(tb testing.TB) any
 		values []any
+		emails map[string]any
 		diags  diag.Diagnostics
 	}{
 		{
@@ -37,6 +38,7 @@ func TestDataSourceRead(t *testing.T) {
 				return nil
 			},
 			values: nil,
+			emails: map[string]any{},
 			diags: diag.Diagnostics{
 				{Severity: diag.Error, Summary: "expected to implement type Meta"},
 			},
@@ -54,6 +56,7 @@ func TestDataSourceRead(t *testing.T) {
 			values: []any{
 				"user-01@example.com",
 			},
+			emails: map[string]any{},
 			diags: diag.Diagnostics{
 				{Severity: diag.Error, Summary: "route \"/v2/organization/member\" had issues with status code 401"},
 			},
@@ -73,7 +76,8 @@ func TestDataSourceRead(t *testing.T) {
 			values: []any{
 				"user-01@example.com",
 			},
-			diags: nil,
+			emails: map[string]any{},
+			diags:  nil,
 		},
 		{
 			name: "no emails",
@@ -93,7 +97,8 @@ func TestDataSourceRead(t *testing.T) {
 			values: []any{
 				"user-01@example.com",
 			},
-			diags: nil,
+			emails: map[string]any{"AAAAAAAA": "user-01@example.com"},
+			diags:  nil,
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
@@ -106,6 +111,7 @@ func TestDataSourceRead(t *testing.T) {
 
 			actual := data.ReadContext(t.Context(), rd, tc.meta(t))
 			assert.Equal(t, tc.diags, actual, "Must match the expected results")
+			assert.Equal(t, tc.emails, rd.Get("user_emails"), "Must match the expected user emails")
 		})
 	}
 }
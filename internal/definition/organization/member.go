@@ -0,0 +1,31 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/signalfx/signalfx-go"
+)
+
+// memberSearchLimit is the number of members requested when resolving an email,
+// which only needs to cover the members whose email contains the searched email.
+const memberSearchLimit = 100
+
+// LookupMemberID returns the ID of the organization member with the email,
+// ignoring any members whose email only partially matches.
+func LookupMemberID(ctx context.Context, client *signalfx.Client, email string) (string, error) {
+	results, err := client.GetOrganizationMembers(ctx, memberSearchLimit, fmt.Sprintf("email:%s", email), 0, "-sf_timestamp")
+	if err != nil {
+		return "", err
+	}
+	for _, m := range results.Results {
+		if m != nil && strings.EqualFold(m.Email, email) {
+			return m.Id, nil
+		}
+	}
+	return "", fmt.Errorf("no organization member found with email %q", email)
+}
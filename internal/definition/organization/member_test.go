@@ -0,0 +1,58 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organization
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/signalfx/signalfx-go/organization"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func TestLookupMemberID(t *testing.T) {
+	t.Parallel()
+
+	meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/organization/member": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("query") == "email:error@example.com" {
+				http.Error(w, "Bad request", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(&organization.MemberSearchResults{
+				Count: 2,
+				Results: []*organization.Member{
+					{Id: "AAAAAAAA", Email: "other.user-01@example.com"},
+					{Id: "BBBBBBBB", Email: "User-01@example.com"},
+				},
+			})
+		},
+	})(t)
+
+	client, err := pmeta.LoadClient(t.Context(), meta)
+	require.NoError(t, err, "Must load the client")
+
+	for _, tc := range []struct {
+		email  string
+		expect string
+		err    string
+	}{
+		{email: "user-01@example.com", expect: "BBBBBBBB"},
+		{email: "user-02@example.com", err: "no organization member found with email \"user-02@example.com\""},
+		{email: "error@example.com", err: "route \"/v2/organization/member\" had issues with status code 400"},
+	} {
+		id, err := LookupMemberID(t.Context(), client, tc.email)
+		if tc.err != "" {
+			assert.EqualError(t, err, tc.err, "Must match the expected error")
+			continue
+		}
+		assert.NoError(t, err, "Must not error looking up the member")
+		assert.Equal(t, tc.expect, id, "Must match the member with the exact email")
+	}
+}
@@ -26,5 +26,13 @@ func newSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"user_emails": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "Email of each user, keyed by the user ID",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
 	}
 }
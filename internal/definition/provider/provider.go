@@ -19,12 +19,15 @@ import (
 	"github.com/signalfx/signalfx-go"
 
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchivecandidates"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchiveexemptmetric"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchiveexemptmetrics"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchivesettings"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/detector"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/dimension"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/organization"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/team"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/teammembership"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
 	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
 	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
@@ -33,7 +36,7 @@ import (
 )
 
 func New() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"auth_token": {
 				Type:          schema.TypeString,
@@ -96,6 +99,11 @@ func New() *schema.Provider {
 				ConflictsWith: []string{"auth_token"},
 				Description:   "Required if the user is configured to be part of multiple organizations",
 			},
+			"realm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The realm of your Splunk Observability Cloud org, used as part of the resource identity when it can not be determined from the API URL",
+			},
 			"feature_preview": {
 				Type: schema.TypeMap,
 				Elem: &schema.Schema{
@@ -124,17 +132,26 @@ func New() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			team.ResourceName:                    team.NewResource(),
-			detector.ResourceName:                detector.NewResource(),
-			autoarchivesettings.ResourceName:     autoarchivesettings.NewResource(),
-			autoarchiveexemptmetric.ResourceName: autoarchiveexemptmetric.NewResource(),
+			team.ResourceName:                     team.NewResource(),
+			teammembership.ResourceName:           teammembership.NewResource(),
+			detector.ResourceName:                 detector.NewResource(),
+			autoarchivesettings.ResourceName:      autoarchivesettings.NewResource(),
+			autoarchiveexemptmetric.ResourceName:  autoarchiveexemptmetric.NewResource(),
+			autoarchiveexemptmetrics.ResourceName: autoarchiveexemptmetrics.NewResource(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			dimension.DataSourceName:    dimension.NewDataSource(),
-			organization.DataSourceName: organization.NewDataSource(),
+			dimension.DataSourceName:             dimension.NewDataSource(),
+			organization.DataSourceName:          organization.NewDataSource(),
+			autoarchivecandidates.DataSourceName: autoarchivecandidates.NewDataSource(),
 		},
 		ConfigureContextFunc: configureProvider,
 	}
+
+	for _, res := range p.ResourcesMap {
+		pmeta.ResourceIdentityDecorator(res)
+	}
+
+	return p
 }
 
 func configureProvider(ctx context.Context, data *schema.ResourceData) (any, diag.Diagnostics) {
@@ -142,6 +159,7 @@ func configureProvider(ctx context.Context, data *schema.ResourceData) (any, dia
 		Email:          data.Get("email").(string),
 		Password:       data.Get("password").(string),
 		OrganizationID: data.Get("organization_id").(string),
+		Realm:          data.Get("realm").(string),
 		Tags:           convert.SliceAll(data.Get("tags").([]any), convert.ToString),
 		Teams:          convert.SliceAll(data.Get("teams").([]any), convert.ToString),
 	}
@@ -31,9 +31,11 @@ func TestProviderHasResource(t *testing.T) {
 
 	expected := []string{
 		"signalfx_team",
+		"signalfx_team_membership",
 		"signalfx_detector",
 		"signalfx_automated_archival_settings",
 		"signalfx_automated_archival_exempt_metric",
+		"signalfx_automated_archival_exempt_metrics",
 	}
 
 	for name := range p.ResourcesMap {
@@ -0,0 +1,99 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/signalfx/signalfx-go/detector"
+)
+
+// EscalationReminderType is the reminder type used for escalation policies.
+const EscalationReminderType = "TIMEOUT"
+
+// EscalationStep is a set of notifications that are sent once the alert
+// has been active for the step's delay.
+type EscalationStep struct {
+	AfterMinutes  int
+	Notifications []string
+}
+
+// SortEscalationSteps orders the steps by their delay.
+func SortEscalationSteps(steps []EscalationStep) {
+	slices.SortStableFunc(steps, func(a, b EscalationStep) int {
+		return a.AfterMinutes - b.AfterMinutes
+	})
+}
+
+// CompileEscalation converts the escalation steps, ordered by their delay, into the rule's notifications and reminder.
+// The steps are only another way of writing a reminder notification: the API sends the rule's notifications
+// when the alert triggers and can then only repeat those same notifications at a fixed interval,
+// so the steps after the first must notify the same recipients at an even interval.
+// Escalating to different recipients can not be expressed by a rule and is returned as an error.
+func CompileEscalation(steps []EscalationStep) ([]string, *detector.ReminderNotification, error) {
+	if len(steps) == 0 {
+		return nil, nil, errors.New("escalation requires at least one step")
+	}
+
+	initial := steps[0]
+	if initial.AfterMinutes != 0 {
+		return nil, nil, fmt.Errorf("the first escalation step notifies after %d minutes, the API can only delay notifications that have already been sent so it must use after_minutes = 0", initial.AfterMinutes)
+	}
+
+	if len(steps) == 1 {
+		return initial.Notifications, nil, nil
+	}
+
+	interval := steps[1].AfterMinutes
+	for i, step := range steps[1:] {
+		if step.AfterMinutes == steps[i].AfterMinutes {
+			return nil, nil, fmt.Errorf("more than one escalation step notifies after %d minutes", step.AfterMinutes)
+		}
+		if !SameNotifications(step.Notifications, initial.Notifications) {
+			return nil, nil, fmt.Errorf(
+				"the escalation step after %d minutes notifies %s, the API can only repeat the initial notifications %s so escalating to different recipients is not supported",
+				step.AfterMinutes,
+				strings.Join(step.Notifications, ", "),
+				strings.Join(initial.Notifications, ", "),
+			)
+		}
+		if step.AfterMinutes != interval*(i+1) {
+			return nil, nil, fmt.Errorf(
+				"the escalation step after %d minutes must notify after %d minutes, the API repeats notifications at a fixed interval so steps must be evenly spaced",
+				step.AfterMinutes,
+				interval*(i+1),
+			)
+		}
+	}
+
+	return initial.Notifications, &detector.ReminderNotification{
+		IntervalMs: (time.Duration(interval) * time.Minute).Milliseconds(),
+		TimeoutMs:  (time.Duration(interval*(len(steps)-1)) * time.Minute).Milliseconds(),
+		Type:       EscalationReminderType,
+	}, nil
+}
+
+// EscalationMatches reports if the rule read from the API is what the escalation steps compile to.
+func EscalationMatches(steps []EscalationStep, notifications []string, reminder *detector.ReminderNotification) bool {
+	expected, expectedReminder, err := CompileEscalation(steps)
+	if err != nil || !SameNotifications(expected, notifications) {
+		return false
+	}
+	if expectedReminder == nil || reminder == nil {
+		return expectedReminder == nil && reminder == nil
+	}
+	return *expectedReminder == *reminder
+}
+
+// SameNotifications reports if both lists contain the same notifications in any order.
+func SameNotifications(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
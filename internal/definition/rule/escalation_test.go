@@ -0,0 +1,74 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rule
+
+import (
+	"testing"
+
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortEscalationSteps(t *testing.T) {
+	t.Parallel()
+
+	steps := []EscalationStep{
+		{AfterMinutes: 30, Notifications: []string{"a"}},
+		{AfterMinutes: 0, Notifications: []string{"b"}},
+		{AfterMinutes: 15, Notifications: []string{"c"}},
+	}
+	SortEscalationSteps(steps)
+	assert.Equal(t, []EscalationStep{
+		{AfterMinutes: 0, Notifications: []string{"b"}},
+		{AfterMinutes: 15, Notifications: []string{"c"}},
+		{AfterMinutes: 30, Notifications: []string{"a"}},
+	}, steps, "Must order the steps by their delay")
+}
+
+func TestCompileEscalation(t *testing.T) {
+	t.Parallel()
+
+	notifications, reminder, err := CompileEscalation([]EscalationStep{
+		{AfterMinutes: 0, Notifications: []string{"Email,oncall@example.com"}},
+		{AfterMinutes: 10, Notifications: []string{"Email,oncall@example.com"}},
+	})
+	assert.NoError(t, err, "Must not error compiling the escalation")
+	assert.Equal(t, []string{"Email,oncall@example.com"}, notifications, "Must return the initial notifications")
+	assert.Equal(t, &detector.ReminderNotification{
+		IntervalMs: 10 * 60 * 1000,
+		TimeoutMs:  10 * 60 * 1000,
+		Type:       EscalationReminderType,
+	}, reminder, "Must return the reminder")
+
+	_, _, err = CompileEscalation(nil)
+	assert.EqualError(t, err, "escalation requires at least one step", "Must error without any steps")
+}
+
+func TestEscalationMatches(t *testing.T) {
+	t.Parallel()
+
+	steps := []EscalationStep{
+		{AfterMinutes: 0, Notifications: []string{"Email,a@example.com", "Email,b@example.com"}},
+		{AfterMinutes: 5, Notifications: []string{"Email,b@example.com", "Email,a@example.com"}},
+	}
+	reminder := &detector.ReminderNotification{IntervalMs: 300000, TimeoutMs: 300000, Type: EscalationReminderType}
+
+	for _, tc := range []struct {
+		name          string
+		notifications []string
+		reminder      *detector.ReminderNotification
+		expect        bool
+	}{
+		{name: "matching", notifications: []string{"Email,b@example.com", "Email,a@example.com"}, reminder: reminder, expect: true},
+		{name: "missing reminder", notifications: []string{"Email,a@example.com", "Email,b@example.com"}, expect: false},
+		{name: "different notifications", notifications: []string{"Email,a@example.com"}, reminder: reminder, expect: false},
+		{name: "different reminder", notifications: []string{"Email,a@example.com", "Email,b@example.com"}, reminder: &detector.ReminderNotification{IntervalMs: 60000, TimeoutMs: 300000, Type: EscalationReminderType}, expect: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, EscalationMatches(steps, tc.notifications, tc.reminder), "Must match the expected result")
+		})
+	}
+}
@@ -4,12 +4,16 @@
 package team
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/signalfx/signalfx-go/notification"
 	"github.com/signalfx/signalfx-go/team"
 
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/check"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	notificationdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/notification"
 )
 
 func newSchema() map[string]*schema.Schema {
@@ -27,8 +31,9 @@ func newSchema() map[string]*schema.Schema {
 		"members": {
 			Type:        schema.TypeSet,
 			Optional:    true,
+			Computed:    true,
 			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "Members of team",
+			Description: "Members of team, the members are left unmanaged when not set so they can be added with `signalfx_team_membership`",
 		},
 		"notifications_critical": {
 			Type:     schema.TypeList,
@@ -84,6 +89,12 @@ func newSchema() map[string]*schema.Schema {
 			},
 			Description: "List of notification destinations to use for the warning alerts category.",
 		},
+		"notification_critical": notificationdef.NewSchema("Structured notification destinations to use for the critical alerts category, as an alternative to `notifications_critical`."),
+		"notification_default":  notificationdef.NewSchema("Structured notification destinations to use for the default alerts category, as an alternative to `notifications_default`."),
+		"notification_info":     notificationdef.NewSchema("Structured notification destinations to use for the info alerts category, as an alternative to `notifications_info`."),
+		"notification_major":    notificationdef.NewSchema("Structured notification destinations to use for the major alerts category, as an alternative to `notifications_major`."),
+		"notification_minor":    notificationdef.NewSchema("Structured notification destinations to use for the minor alerts category, as an alternative to `notifications_minor`."),
+		"notification_warning":  notificationdef.NewSchema("Structured notification destinations to use for the warning alerts category, as an alternative to `notifications_warning`."),
 		"url": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -117,6 +128,13 @@ func decodeTerraform(rd *schema.ResourceData) (*team.Team, error) {
 				return nil, err
 			}
 		}
+		if val, ok := rd.Get(notificationBlockName(name)).([]any); ok && len(val) > 0 {
+			blocks, err := notificationdef.DecodeTerraform(val)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", notificationBlockName(name), err)
+			}
+			(*field) = append((*field), blocks...)
+		}
 	}
 	return t, nil
 }
@@ -151,18 +169,26 @@ func encodeTerraform(tm *team.Team, rd *schema.ResourceData) error {
 		if len(values) == 0 {
 			continue
 		}
-		items := make([]string, len(values))
-		for i, n := range values {
-			s, err := common.NewNotificationStringFromAPI(n)
-			if err != nil {
-				return err
-			}
-			items[i] = s
+
+		priorStrings, _ := rd.Get(name).([]any)
+		priorBlocks, _ := rd.Get(notificationBlockName(name)).([]any)
+		items, blocks, err := notificationdef.EncodeTerraformPrior(values, priorStrings, priorBlocks)
+		if err != nil {
+			return err
 		}
 
 		if err := rd.Set(name, items); err != nil {
 			return err
 		}
+		if err := rd.Set(notificationBlockName(name), blocks); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// notificationBlockName returns the name of the notification blocks
+// that are used as an alternative to the notification strings.
+func notificationBlockName(name string) string {
+	return strings.Replace(name, "notifications_", "notification_", 1)
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/signalfx/signalfx-go/notification"
 	"github.com/signalfx/signalfx-go/team"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/notification"
 )
 
 func TestNewSchema(t *testing.T) {
@@ -173,3 +176,26 @@ func TestEncodeTerraform(t *testing.T) {
 		})
 	}
 }
+
+func TestNotificationBlocks(t *testing.T) {
+	t.Parallel()
+
+	rd := schema.TestResourceDataRaw(t, newSchema(), map[string]any{
+		"name":                   "team",
+		"notifications_critical": []any{"Email,oncall@example.com"},
+		"notification_critical": []any{
+			map[string]any{"slack": []any{map[string]any{"credential_id": "cred", "channel": "alerts"}}},
+		},
+	})
+
+	tm, err := decodeTerraform(rd)
+	require.NoError(t, err, "Must not error decoding the team")
+	assert.Equal(t, []*notification.Notification{
+		{Type: "Email", Value: &notification.EmailNotification{Type: "Email", Email: "oncall@example.com"}},
+		{Type: "Slack", Value: &notification.SlackNotification{Type: "Slack", CredentialId: "cred", Channel: "alerts"}},
+	}, tm.NotificationLists.Critical, "Must combine the strings and blocks")
+
+	require.NoError(t, encodeTerraform(tm, rd), "Must not error encoding the team")
+	assert.Equal(t, []any{"Email,oncall@example.com"}, rd.Get("notifications_critical"), "Must keep the strings")
+	assert.Equal(t, []string{"Slack,cred,alerts"}, notificationdef.Strings(rd.Get("notification_critical").([]any)), "Must keep the blocks")
+}
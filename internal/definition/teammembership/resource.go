@@ -0,0 +1,192 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembership
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/team"
+	"go.uber.org/multierr"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/organization"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+const (
+	ResourceName = "signalfx_team_membership"
+)
+
+// teamLocks holds a lock for each team so that memberships of the same team
+// are not added or removed concurrently, since each change rewrites the team's members.
+var teamLocks sync.Map
+
+func lockTeam(teamID string) func() {
+	mu, _ := teamLocks.LoadOrStore(teamID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+func NewResource() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manages the membership of a single user in a team, without changing the other members of the team.",
+		SchemaFunc:    newSchema,
+		ReadContext:   resourceRead,
+		CreateContext: resourceCreate,
+		DeleteContext: resourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceCreate(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	var (
+		teamID = data.Get("team_id").(string)
+		userID = data.Get("user_id").(string)
+	)
+	if userID == "" {
+		userID, err = organization.LookupMemberID(ctx, sfx, data.Get("email").(string))
+		if err != nil {
+			return tfext.AsErrorDiagnostics(err)
+		}
+	}
+
+	// An existing member is not adopted, since destroying the resource
+	// would then remove a member that it did not add.
+	var exists bool
+	err = updateMembers(ctx, sfx, teamID, func(members []string) []string {
+		if exists = slices.Contains(members, userID); exists {
+			return nil
+		}
+		return append(members, userID)
+	})
+	if err == nil && exists {
+		err = fmt.Errorf("user %q is already a member of team %q, import the membership using the id %q to manage it", userID, teamID, newID(teamID, userID))
+	}
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+	data.SetId(newID(teamID, userID))
+
+	return resourceRead(ctx, data, meta)
+}
+
+func resourceRead(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	teamID, userID, err := parseID(data.Id())
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	tm, err := sfx.GetTeam(ctx, teamID)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(common.HandleError(ctx, err, data))
+	}
+	if !slices.Contains(tm.Members, userID) {
+		tflog.Info(ctx, "User has been removed from the team externally, removing from state", tfext.NewLogFields().
+			Field("team", teamID).
+			Field("user", userID),
+		)
+		data.SetId("")
+		return nil
+	}
+
+	email := data.Get("email").(string)
+	if email == "" {
+		member, err := sfx.GetMember(ctx, userID)
+		if err != nil {
+			return tfext.AsErrorDiagnostics(err)
+		}
+		email = member.Email
+	}
+
+	return tfext.AsErrorDiagnostics(multierr.Combine(
+		data.Set("team_id", teamID),
+		data.Set("user_id", userID),
+		data.Set("email", email),
+	))
+}
+
+func resourceDelete(ctx context.Context, data *schema.ResourceData, meta any) diag.Diagnostics {
+	sfx, err := pmeta.LoadClient(ctx, meta)
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	teamID, userID, err := parseID(data.Id())
+	if err != nil {
+		return tfext.AsErrorDiagnostics(err)
+	}
+
+	err = updateMembers(ctx, sfx, teamID, func(members []string) []string {
+		if !slices.Contains(members, userID) {
+			return nil
+		}
+		return slices.DeleteFunc(members, func(m string) bool { return m == userID })
+	})
+	if re, ok := signalfx.AsResponseError(err); ok && re.Code() == http.StatusNotFound {
+		// The membership was removed along with the team.
+		err = nil
+	}
+	if err == nil {
+		data.SetId("")
+	}
+	return tfext.AsErrorDiagnostics(common.HandleError(ctx, err, data))
+}
+
+// updateMembers replaces the members of the team with the result of the update,
+// keeping the other details of the team as they are.
+// The team is not changed when update returns nil.
+func updateMembers(ctx context.Context, sfx *signalfx.Client, teamID string, update func(members []string) []string) error {
+	unlock := lockTeam(teamID)
+	defer unlock()
+
+	tm, err := sfx.GetTeam(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
+	members := update(slices.Clone(tm.Members))
+	if members == nil {
+		return nil
+	}
+
+	updated, err := sfx.UpdateTeam(ctx, teamID, &team.CreateUpdateTeamRequest{
+		Name:              tm.Name,
+		Description:       tm.Description,
+		Members:           members,
+		NotificationLists: tm.NotificationLists,
+		DashboardGroups:   tm.DashboardGroups,
+		Detectors:         tm.Detectors,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The request omits the members when there are none,
+	// which leaves the last member of the team in place.
+	if len(members) == 0 && len(updated.Members) > 0 {
+		return fmt.Errorf("unable to remove the last member of team %q", teamID)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembership
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/organization"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/team"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func TestAcceptance(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		steps []resource.TestStep
+	}{
+		{
+			name: "membership lifecycle",
+			steps: []resource.TestStep{
+				{
+					Config: tftest.LoadConfig("testdata/resource_team_membership.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttrPair("signalfx_team_membership.example_test", "team_id", "signalfx_team.example_test", "id"),
+						resource.TestCheckResourceAttr("signalfx_team_membership.example_test", "email", "user1@example.com"),
+					),
+				},
+				{
+					ResourceName:      "signalfx_team_membership.example_test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tftest.NewAcceptanceHandler(
+				tftest.WithAcceptanceResources(map[string]*schema.Resource{
+					team.ResourceName: team.NewResource(),
+					ResourceName:      NewResource(),
+				}),
+				tftest.WithAcceptanceDataSources(map[string]*schema.Resource{
+					organization.DataSourceName: organization.NewDataSource(),
+				}),
+			).Test(t, tc.steps)
+		})
+	}
+}
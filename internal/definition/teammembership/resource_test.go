@@ -0,0 +1,323 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembership
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/signalfx/signalfx-go/organization"
+	"github.com/signalfx/signalfx-go/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+// testTeam mocks a team and the organization members that can join it.
+type testTeam struct {
+	mu      sync.Mutex
+	team    team.Team
+	emails  map[string]string
+	updates int
+}
+
+func newTestTeam(members ...string) *testTeam {
+	return &testTeam{
+		team: team.Team{
+			Id:        "team-01",
+			Name:      "Platform",
+			Members:   members,
+			Detectors: []string{"detector-01"},
+		},
+		emails: map[string]string{
+			"user-01": "user-01@example.com",
+			"user-02": "user-02@example.com",
+			"user-03": "user-03@example.com",
+		},
+	}
+}
+
+func (tt *testTeam) members() []string {
+	tt.mu.Lock()
+	defer tt.mu.Unlock()
+	return slices.Sorted(slices.Values(tt.team.Members))
+}
+
+func (tt *testTeam) meta(tb testing.TB) any {
+	return tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/team/team-01": func(w http.ResponseWriter, _ *http.Request) {
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			_ = json.NewEncoder(w).Encode(tt.team)
+		},
+		"PUT /v2/team/team-01": func(w http.ResponseWriter, r *http.Request) {
+			var req team.CreateUpdateTeamRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			tt.mu.Lock()
+			defer tt.mu.Unlock()
+			if req.Name != tt.team.Name || !slices.Equal(req.Detectors, tt.team.Detectors) {
+				http.Error(w, "team details were not preserved", http.StatusBadRequest)
+				return
+			}
+			tt.updates++
+			if req.Members != nil {
+				tt.team.Members = req.Members
+			}
+			_ = json.NewEncoder(w).Encode(tt.team)
+		},
+		"GET /v2/organization/member": func(w http.ResponseWriter, r *http.Request) {
+			results := &organization.MemberSearchResults{}
+			for id, email := range tt.emails {
+				if "email:"+email == r.URL.Query().Get("query") {
+					results.Results = append(results.Results, &organization.Member{Id: id, Email: email})
+				}
+			}
+			results.Count = int32(len(results.Results))
+			_ = json.NewEncoder(w).Encode(results)
+		},
+		"GET /v2/organization/member/{id}": func(w http.ResponseWriter, r *http.Request) {
+			email, ok := tt.emails[r.PathValue("id")]
+			if !ok {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(&organization.Member{Id: r.PathValue("id"), Email: email})
+		},
+	})(tb)
+}
+
+func createTestMembership(t *testing.T, config map[string]any, meta any) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+
+	res := NewResource()
+	diff, err := res.Diff(t.Context(), nil, terraform.NewResourceConfigRaw(config), meta)
+	require.NoError(t, err, "Must not error planning the changes")
+
+	return res.Apply(t.Context(), nil, diff, meta)
+}
+
+func TestNewResource(t *testing.T) {
+	t.Parallel()
+
+	assert.NotNil(t, NewResource(), "Must return a valid value")
+}
+
+func TestResourceLifecycle(t *testing.T) {
+	t.Parallel()
+
+	tt := newTestTeam("user-01")
+	meta := tt.meta(t)
+
+	state, diags := createTestMembership(t, map[string]any{"team_id": "team-01", "email": "user-02@example.com"}, meta)
+	require.Empty(t, diags, "Must not error creating the membership")
+	assert.Equal(t, "team-01:user-02", state.ID, "Must use the team and user IDs")
+	assert.Equal(t, "user-02", state.Attributes["user_id"], "Must resolve the user ID from the email")
+	assert.Equal(t, []string{"user-01", "user-02"}, tt.members(), "Must keep the existing members")
+
+	res := NewResource()
+	state, diags = res.RefreshWithoutUpgrade(t.Context(), state, meta)
+	require.Empty(t, diags, "Must not error reading the membership")
+	require.NotNil(t, state, "Must keep the membership")
+
+	_, diags = res.Apply(t.Context(), state, &terraform.InstanceDiff{Destroy: true}, meta)
+	require.Empty(t, diags, "Must not error deleting the membership")
+	assert.Equal(t, []string{"user-01"}, tt.members(), "Must only remove the user")
+}
+
+func TestResourceCreate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		members []string
+		config  map[string]any
+		expect  []string
+		email   string
+		updates int
+		issues  diag.Diagnostics
+	}{
+		{
+			name:    "by user id",
+			config:  map[string]any{"team_id": "team-01", "user_id": "user-03"},
+			expect:  []string{"user-03"},
+			email:   "user-03@example.com",
+			updates: 1,
+		},
+		{
+			name:    "already a member",
+			members: []string{"user-01"},
+			config:  map[string]any{"team_id": "team-01", "email": "user-01@example.com"},
+			expect:  []string{"user-01"},
+			updates: 0,
+			issues: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "user \"user-01\" is already a member of team \"team-01\", import the membership using the id \"team-01:user-01\" to manage it"},
+			},
+		},
+		{
+			name:   "unknown email",
+			config: map[string]any{"team_id": "team-01", "email": "user-04@example.com"},
+			expect: nil,
+			issues: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "no organization member found with email \"user-04@example.com\""},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt := newTestTeam(tc.members...)
+			state, diags := createTestMembership(t, tc.config, tt.meta(t))
+			assert.Equal(t, tc.issues, diags, "Must match the expected issues")
+			assert.Equal(t, tc.expect, tt.members(), "Must match the expected members")
+			assert.Equal(t, tc.updates, tt.updates, "Must match the expected number of updates")
+			if len(tc.issues) == 0 {
+				assert.Equal(t, tc.email, state.Attributes["email"], "Must set the email of the user")
+			}
+		})
+	}
+}
+
+func TestResourceConcurrentCreate(t *testing.T) {
+	t.Parallel()
+
+	tt := newTestTeam()
+	meta := tt.meta(t)
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"user-01", "user-02", "user-03"} {
+		wg.Go(func() {
+			_, diags := createTestMembership(t, map[string]any{"team_id": "team-01", "user_id": id}, meta)
+			assert.Empty(t, diags, "Must not error creating the membership")
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, []string{"user-01", "user-02", "user-03"}, tt.members(), "Must not lose any concurrently added members")
+}
+
+func TestResourceRead(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		id     string
+		meta   func(tb testing.TB) any
+		expect string
+		issues diag.Diagnostics
+	}{
+		{
+			name:   "no provider",
+			id:     "team-01:user-01",
+			meta:   func(_ testing.TB) any { return nil },
+			expect: "team-01:user-01",
+			issues: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "expected to implement type Meta"},
+			},
+		},
+		{
+			name:   "invalid id",
+			id:     "team-01",
+			meta:   newTestTeam("user-01").meta,
+			expect: "team-01",
+			issues: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "invalid team membership id \"team-01\", expected <team_id>:<user_id>"},
+			},
+		},
+		{
+			name:   "member",
+			id:     "team-01:user-01",
+			meta:   newTestTeam("user-01").meta,
+			expect: "team-01:user-01",
+		},
+		{
+			name:   "removed externally",
+			id:     "team-01:user-01",
+			meta:   newTestTeam("user-02").meta,
+			expect: "",
+		},
+		{
+			name:   "team removed",
+			id:     "team-02:user-01",
+			meta:   newTestTeam("user-01").meta,
+			expect: "",
+			issues: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "route \"/v2/team/team-02\" had issues with status code 404"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rd := NewResource().TestResourceData()
+			rd.SetId(tc.id)
+
+			issues := resourceRead(t.Context(), rd, tc.meta(t))
+			assert.Equal(t, tc.issues, issues, "Must match the expected issues")
+			assert.Equal(t, tc.expect, rd.Id(), "Must match the expected id")
+		})
+	}
+}
+
+func TestResourceDelete(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		id      string
+		members []string
+		expect  []string
+		issues  diag.Diagnostics
+	}{
+		{
+			name:    "member",
+			id:      "team-01:user-01",
+			members: []string{"user-01", "user-02"},
+			expect:  []string{"user-02"},
+		},
+		{
+			name:    "not a member",
+			id:      "team-01:user-01",
+			members: []string{"user-02"},
+			expect:  []string{"user-02"},
+		},
+		{
+			name:    "team removed",
+			id:      "team-02:user-01",
+			members: []string{"user-01"},
+			expect:  []string{"user-01"},
+		},
+		{
+			name:    "last member",
+			id:      "team-01:user-01",
+			members: []string{"user-01"},
+			expect:  []string{"user-01"},
+			issues: diag.Diagnostics{
+				{Severity: diag.Error, Summary: fmt.Sprintf("unable to remove the last member of team %q", "team-01")},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt := newTestTeam(tc.members...)
+
+			rd := NewResource().TestResourceData()
+			rd.SetId(tc.id)
+
+			issues := resourceDelete(t.Context(), rd, tt.meta(t))
+			assert.Equal(t, tc.issues, issues, "Must match the expected issues")
+			assert.Equal(t, tc.expect, tt.members(), "Must match the expected members")
+		})
+	}
+}
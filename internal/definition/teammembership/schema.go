@@ -0,0 +1,58 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembership
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/check"
+)
+
+func newSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"team_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "ID of the team",
+		},
+		"user_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"user_id", "email"},
+			Description:  "ID of the user to add to the team",
+		},
+		"email": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: check.Email,
+			ExactlyOneOf:     []string{"user_id", "email"},
+			Description:      "Email of the user to add to the team, which is resolved to the user ID",
+		},
+	}
+}
+
+// newID returns the resource ID of the user's membership of the team.
+func newID(teamID, userID string) string {
+	return teamID + ":" + userID
+}
+
+// parseID returns the team and user IDs from the resource ID.
+func parseID(id string) (teamID, userID string, err error) {
+	teamID, userID, ok := strings.Cut(id, ":")
+	if !ok || teamID == "" || userID == "" {
+		return "", "", fmt.Errorf("invalid team membership id %q, expected <team_id>:<user_id>", id)
+	}
+	return teamID, userID, nil
+}
@@ -0,0 +1,30 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembership
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	assert.NotNil(t, newSchema(), "Must have a valid schema")
+}
+
+func TestParseID(t *testing.T) {
+	t.Parallel()
+
+	teamID, userID, err := parseID(newID("team-01", "user-01"))
+	assert.NoError(t, err, "Must parse a valid id")
+	assert.Equal(t, "team-01", teamID, "Must match the team id")
+	assert.Equal(t, "user-01", userID, "Must match the user id")
+
+	for _, id := range []string{"", "team-01", ":user-01", "team-01:"} {
+		_, _, err := parseID(id)
+		assert.Error(t, err, "Must error for id %q", id)
+	}
+}
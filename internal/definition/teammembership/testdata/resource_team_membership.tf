@@ -0,0 +1,13 @@
+resource "signalfx_team" "example_test" {
+  name        = "my team"
+  description = "An example of team membership"
+}
+
+data "signalfx_organization_members" "example_test" {
+  emails = ["user1@example.com"]
+}
+
+resource "signalfx_team_membership" "example_test" {
+  team_id = signalfx_team.example_test.id
+  user_id = data.signalfx_organization_members.example_test.users[0]
+}
@@ -21,6 +21,10 @@ const (
 	PreviewProviderTeams    = "provider.teams"
 	PreviewProviderTags     = "provider.tags"
 	PreviewProviderTracking = "provider.track"
+
+	PreviewNotificationValidation = "notifications.validate"
+
+	PreviewMetricRulesetImpact = "metric_ruleset.impact"
 )
 
 var (
@@ -40,4 +44,16 @@ var (
 		WithPreviewDescription("Allows for the project's VCS information to be added to the global tags to provide additional context for resources created"),
 		WithPreviewAddInVersion("v9.14.0"),
 	)
+
+	_ = GetGlobalRegistry().MustRegister(
+		PreviewNotificationValidation,
+		WithPreviewDescription("Checks that the integration credentials and teams referenced by detector and SLO notifications exist when planning"),
+		WithPreviewAddInVersion("v9.24.0"),
+	)
+
+	_ = GetGlobalRegistry().MustRegister(
+		PreviewMetricRulesetImpact,
+		WithPreviewDescription("Warns about charts and detectors that filter or group by dimensions that metric ruleset aggregation rules drop"),
+		WithPreviewAddInVersion("v9.24.0"),
+	)
 )
@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/detector"
 
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
 	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
 	fwshared "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/shared"
 	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
@@ -57,26 +59,20 @@ func (dd *AutoDetectorDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	var (
-		pageSize = 100
-		results  = make(map[string]string)
-	)
-
-	for offset := 0; ; offset += pageSize {
-		result, err := client.SearchDetectors(ctx, pageSize, "", offset, "")
+	results := make(map[string]string)
+	for r, err := range common.SearchAll(ctx, common.DefaultSearchPageSize, func(ctx context.Context, limit, offset int) ([]detector.Detector, error) {
+		result, err := client.SearchDetectors(ctx, limit, "", offset, "")
+		if err != nil {
+			return nil, err
+		}
+		return result.Results, nil
+	}) {
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to fetch auto detectors", err.Error())
 			return
 		}
-
-		for _, r := range result.Results {
-			if r.DetectorOrigin == "AutoDetect" {
-				results[fwshared.NewCompatibleIdentifer(r.Name)] = r.Id
-			}
-		}
-
-		if len(result.Results) < pageSize {
-			break
+		if r.DetectorOrigin == "AutoDetect" {
+			results[fwshared.NewCompatibleIdentifer(r.Name)] = r.Id
 		}
 	}
 
@@ -0,0 +1,147 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwchart
+
+import (
+	"slices"
+)
+
+const (
+	TypeTime        = "time"
+	TypeList        = "list"
+	TypeSingleValue = "single_value"
+	TypeHeatmap     = "heatmap"
+	TypeTable       = "table"
+	TypeEventFeed   = "event_feed"
+	TypeText        = "text"
+	TypeSLO         = "slo"
+)
+
+// chartType describes how each of the chart types are represented within the API
+// and which of the resource attributes are applicable to the chart type.
+type chartType struct {
+	// api is the value used for `options.type` within the API.
+	api string
+	// legacy is the name of the resource that previously managed the chart type.
+	legacy string
+	// required are the attributes that must be set for the chart type.
+	required []string
+	// optional are the attributes that can be set for the chart type.
+	optional []string
+}
+
+// commonAttributes can be set for all chart types.
+var commonAttributes = []string{"id", "url", "type", "name", "description", "tags"}
+
+var chartTypes = map[string]chartType{
+	TypeTime: {
+		api:      "TimeSeriesChart",
+		legacy:   "signalfx_time_chart",
+		required: []string{"program_text"},
+		optional: []string{
+			"unit_prefix", "color_by", "plot_type", "timezone", "time_range",
+			"max_delay", "minimum_resolution", "disable_sampling", "viz_options", "time_options",
+		},
+	},
+	TypeList: {
+		api:      "List",
+		legacy:   "signalfx_list_chart",
+		required: []string{"program_text"},
+		optional: []string{
+			"unit_prefix", "color_by", "timezone", "time_range", "max_delay", "disable_sampling",
+			"refresh_interval", "max_precision", "viz_options", "color_scale", "list_options",
+		},
+	},
+	TypeSingleValue: {
+		api:      "SingleValue",
+		legacy:   "signalfx_single_value_chart",
+		required: []string{"program_text"},
+		optional: []string{
+			"unit_prefix", "color_by", "timezone", "max_delay",
+			"refresh_interval", "max_precision", "viz_options", "color_scale", "single_value_options",
+		},
+	},
+	TypeHeatmap: {
+		api:      "Heatmap",
+		legacy:   "signalfx_heatmap_chart",
+		required: []string{"program_text"},
+		optional: []string{
+			"unit_prefix", "timezone", "max_delay", "minimum_resolution", "disable_sampling",
+			"refresh_interval", "color_scale", "heatmap_options",
+		},
+	},
+	TypeTable: {
+		api:      "TableChart",
+		legacy:   "signalfx_table_chart",
+		required: []string{"program_text"},
+		optional: []string{
+			"unit_prefix", "timezone", "max_delay", "minimum_resolution", "disable_sampling",
+			"refresh_interval", "viz_options", "table_options",
+		},
+	},
+	TypeEventFeed: {
+		api:      "Event",
+		legacy:   "signalfx_event_feed_chart",
+		required: []string{"program_text"},
+		optional: []string{"time_range"},
+	},
+	TypeText: {
+		api:      "Text",
+		legacy:   "signalfx_text_chart",
+		required: []string{"markdown"},
+	},
+	TypeSLO: {
+		// SLO charts are created with a dedicated endpoint and are identified by having an SLO ID set.
+		api:      "SloChart",
+		legacy:   "signalfx_slo_chart",
+		required: []string{"slo_id"},
+	},
+}
+
+// ChartTypes returns the names of all the supported chart types.
+func ChartTypes() []string {
+	names := make([]string, 0, len(chartTypes))
+	for name := range chartTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// APITypes returns the API chart types of all the supported chart types.
+func APITypes() []string {
+	types := make([]string, 0, len(chartTypes))
+	for _, ct := range chartTypes {
+		types = append(types, ct.api)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// chartTypeFromAPI returns the chart type name for the API chart type.
+func chartTypeFromAPI(api string) (string, bool) {
+	for name, ct := range chartTypes {
+		if ct.api == api {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// chartTypeFromLegacy returns the chart type name for the legacy resource name.
+func chartTypeFromLegacy(resource string) (string, bool) {
+	for name, ct := range chartTypes {
+		if ct.legacy == resource {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// supports returns true if the attribute can be set for the chart type.
+func (ct chartType) supports(attribute string) bool {
+	return slices.Contains(commonAttributes, attribute) ||
+		slices.Contains(ct.required, attribute) ||
+		slices.Contains(ct.optional, attribute)
+}
@@ -0,0 +1,93 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwchart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChartTypes(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{
+		TypeEventFeed,
+		TypeHeatmap,
+		TypeList,
+		TypeSingleValue,
+		TypeSLO,
+		TypeTable,
+		TypeText,
+		TypeTime,
+	}, ChartTypes(), "Must return all chart types sorted")
+}
+
+func TestAPITypes(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{
+		"Event",
+		"Heatmap",
+		"List",
+		"SingleValue",
+		"SloChart",
+		"TableChart",
+		"Text",
+		"TimeSeriesChart",
+	}, APITypes(), "Must return all API chart types sorted")
+}
+
+func TestChartTypeFromAPI(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		api    string
+		expect string
+		ok     bool
+	}{
+		{api: "TimeSeriesChart", expect: TypeTime, ok: true},
+		{api: "List", expect: TypeList, ok: true},
+		{api: "SingleValue", expect: TypeSingleValue, ok: true},
+		{api: "Heatmap", expect: TypeHeatmap, ok: true},
+		{api: "TableChart", expect: TypeTable, ok: true},
+		{api: "Event", expect: TypeEventFeed, ok: true},
+		{api: "Text", expect: TypeText, ok: true},
+		{api: "SloChart", expect: TypeSLO, ok: true},
+		{api: "", expect: "", ok: false},
+		{api: "Unknown", expect: "", ok: false},
+	} {
+		t.Run(tc.api, func(t *testing.T) {
+			t.Parallel()
+
+			name, ok := chartTypeFromAPI(tc.api)
+			assert.Equal(t, tc.expect, name, "Must match the expected chart type")
+			assert.Equal(t, tc.ok, ok, "Must match the expected result")
+		})
+	}
+}
+
+func TestChartTypeFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	name, ok := chartTypeFromLegacy("signalfx_slo_chart")
+	assert.True(t, ok, "Must find the legacy chart resource")
+	assert.Equal(t, TypeSLO, name, "Must match the expected chart type")
+
+	_, ok = chartTypeFromLegacy("signalfx_detector")
+	assert.False(t, ok, "Must not match a non chart resource")
+}
+
+func TestChartTypeSupports(t *testing.T) {
+	t.Parallel()
+
+	ct := chartTypes[TypeText]
+	assert.True(t, ct.supports("name"), "Must support common attributes")
+	assert.True(t, ct.supports("markdown"), "Must support required attributes")
+	assert.False(t, ct.supports("program_text"), "Must not support attributes of other chart types")
+
+	ct = chartTypes[TypeTime]
+	assert.True(t, ct.supports("time_options"), "Must support optional attributes")
+	assert.False(t, ct.supports("list_options"), "Must not support options of other chart types")
+}
@@ -0,0 +1,436 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwchart
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/chart"
+
+	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
+	fwshared "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/shared"
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/visual"
+)
+
+// AppPath is the application path used to link to the chart.
+const AppPath = "/chart/"
+
+type ResourceChart struct {
+	fwembed.ResourceCRUD[resourceChartModel, chartRequest, chart.Chart]
+	fwembed.ResourceIDImporter
+}
+
+// chartRequest allows for the SLO charts to be sent to the dedicated endpoint
+// while the remaining chart types share the same request.
+type chartRequest struct {
+	Chart *chart.CreateUpdateChartRequest
+	SLO   *chart.CreateUpdateSloChartRequest
+}
+
+var (
+	_ resource.Resource                   = (*ResourceChart)(nil)
+	_ resource.ResourceWithConfigure      = (*ResourceChart)(nil)
+	_ resource.ResourceWithImportState    = (*ResourceChart)(nil)
+	_ resource.ResourceWithIdentity       = (*ResourceChart)(nil)
+	_ resource.ResourceWithValidateConfig = (*ResourceChart)(nil)
+	_ resource.ResourceWithMoveState      = (*ResourceChart)(nil)
+)
+
+func NewResourceChart() resource.Resource {
+	rc := &ResourceChart{}
+	rc.AppPath = AppPath
+	rc.Encode = rc.encode
+	rc.Decode = rc.decode
+	rc.Merge = rc.merge
+	rc.CreateFunc = func(ctx context.Context, client *signalfx.Client, req *chartRequest) (*chart.Chart, error) {
+		if req.SLO != nil {
+			return client.CreateSloChart(ctx, req.SLO)
+		}
+		return client.CreateChart(ctx, req.Chart)
+	}
+	rc.ReadFunc = func(ctx context.Context, client *signalfx.Client, id string) (*chart.Chart, error) {
+		return client.GetChart(ctx, id)
+	}
+	rc.UpdateFunc = func(ctx context.Context, client *signalfx.Client, id string, req *chartRequest) (*chart.Chart, error) {
+		if req.SLO != nil {
+			return client.UpdateSloChart(ctx, id, req.SLO)
+		}
+		return client.UpdateChart(ctx, id, req.Chart)
+	}
+	rc.DeleteFunc = func(ctx context.Context, client *signalfx.Client, id string) error {
+		return client.DeleteChart(ctx, id)
+	}
+	return rc
+}
+
+func (rc *ResourceChart) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chart"
+}
+
+func (rc *ResourceChart) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a chart of any type, the chart type can be changed without needing to replace the resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": fwshared.ResourceIDAttribute(),
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the chart.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of chart to display, the type determines which of the other attributes can be set.",
+				Validators: []validator.String{
+					fwshared.StringOneOf(ChartTypes()...),
+				},
+				PlanModifiers: []planmodifier.String{
+					// SLO charts are managed using a different API,
+					// so changing to or from an SLO chart requires the chart to be replaced.
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = (req.StateValue.ValueString() == TypeSLO) != (req.PlanValue.ValueString() == TypeSLO)
+						},
+						"Changing to or from an SLO chart requires the chart to be replaced.",
+						"Changing to or from an SLO chart requires the chart to be replaced.",
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the chart, required for all chart types except `slo`.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the chart.",
+			},
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags associated with the chart.",
+			},
+			"program_text": schema.StringAttribute{
+				CustomType:  fwtypes.SignalFlowType{},
+				Optional:    true,
+				Description: "SignalFlow program used to populate the chart, required for all chart types except `text` and `slo`.",
+			},
+			"markdown": schema.StringAttribute{
+				Optional:    true,
+				Description: "Markdown text to display, only applicable to `text` charts.",
+			},
+			"slo_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the SLO to display, only applicable to `slo` charts.",
+			},
+			"unit_prefix": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Must be `Metric` or `Binary`.",
+				Validators: []validator.String{
+					fwshared.StringOneOf("Metric", "Binary"),
+				},
+			},
+			"color_by": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Must be `Dimension`, `Metric` or `Scale`. `Scale` is required when `color_scale` is set.",
+				Validators: []validator.String{
+					fwshared.StringOneOf("Dimension", "Metric", "Scale"),
+				},
+			},
+			"plot_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The default plot display style for the visualization, only applicable to `time` charts.",
+				Validators: []validator.String{
+					fwshared.StringOneOf("LineChart", "AreaChart", "ColumnChart", "Histogram"),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:    true,
+				Description: "The property value is a string that denotes the geographic region associated with the time zone.",
+			},
+			"time_range": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How many seconds ago from which to display data.",
+				Validators: []validator.Int64{
+					fwshared.Int64Between(1, 90*24*60*60),
+				},
+			},
+			"max_delay": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How long (in seconds) to wait for late datapoints.",
+				Validators: []validator.Int64{
+					fwshared.Int64Between(1, 900),
+				},
+			},
+			"minimum_resolution": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The minimum resolution (in seconds) to use for computing the underlying program.",
+				Validators: []validator.Int64{
+					fwshared.Int64Between(1, 24*60*60),
+				},
+			},
+			"disable_sampling": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "If `false`, samples a subset of the output MTS, which improves UI performance.",
+			},
+			"refresh_interval": schema.Int64Attribute{
+				Optional:    true,
+				Description: "How often (in seconds) to refresh the values.",
+				Validators: []validator.Int64{
+					fwshared.Int64Between(1, 24*60*60),
+				},
+			},
+			"max_precision": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of digits to display when rounding values up or down.",
+				Validators: []validator.Int64{
+					fwshared.Int64Between(1, 100),
+				},
+			},
+			"viz_options": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Plot-level customization options, associated with a publish statement.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"label": schema.StringAttribute{
+							Required:    true,
+							Description: "The label used in the publish statement that displays the plot to customize.",
+						},
+						"display_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies an alternate value for the Plot Name column of the Data Table associated with the chart.",
+						},
+						"color": schema.StringAttribute{
+							Optional:    true,
+							Description: "Color to use.",
+							Validators: []validator.String{
+								fwshared.StringOneOf(visual.NewColorPalette().Names()...),
+							},
+						},
+						"axis": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("left"),
+							Description: "The Y-axis associated with values for this plot, only applicable to `time` charts. Must be either `left` or `right`, defaults to `left`.",
+							Validators: []validator.String{
+								fwshared.StringOneOf("left", "right"),
+							},
+						},
+						"plot_type": schema.StringAttribute{
+							Optional:    true,
+							Description: "The visualization style to use, only applicable to `time` charts.",
+							Validators: []validator.String{
+								fwshared.StringOneOf("LineChart", "AreaChart", "ColumnChart", "Histogram"),
+							},
+						},
+						"value_unit": schema.StringAttribute{
+							Optional:    true,
+							Description: "A unit to attach to this plot. Units support automatic scaling.",
+						},
+						"value_prefix": schema.StringAttribute{
+							Optional:    true,
+							Description: "An arbitrary prefix to display with the value of this plot.",
+						},
+						"value_suffix": schema.StringAttribute{
+							Optional:    true,
+							Description: "An arbitrary suffix to display with the value of this plot.",
+						},
+					},
+				},
+			},
+			"color_scale": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Values and color for the color scale, requires `color_by` to be `Scale`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"gt": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Indicates the lower threshold non-inclusive value for this range.",
+						},
+						"gte": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Indicates the lower threshold inclusive value for this range.",
+						},
+						"lt": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Indicates the upper threshold non-inclusive value for this range.",
+						},
+						"lte": schema.Float64Attribute{
+							Optional:    true,
+							Description: "Indicates the upper threshold inclusive value for this range.",
+						},
+						"color": schema.StringAttribute{
+							Required:    true,
+							Description: "The color to use.",
+							Validators: []validator.String{
+								fwshared.StringOneOf(visual.NewColorScalePalette().Names()...),
+							},
+						},
+					},
+				},
+			},
+			"time_options": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Options only applicable to `time` charts.",
+				Attributes: map[string]schema.Attribute{
+					"stacked":           newOptionalBool("Whether area and bar charts in the visualization should be stacked."),
+					"show_event_lines":  newOptionalBool("Whether vertical highlight lines should be drawn in the visualizations at times when events occurred."),
+					"show_data_markers": newOptionalBool("Show markers (circles) for each datapoint used to draw line or area charts."),
+					"axes_include_zero": newOptionalBool("Force the chart to display zero on the y-axes."),
+					"axes_precision": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Specifies the digits SignalFx displays for values plotted on the chart.",
+						Validators: []validator.Int64{
+							fwshared.Int64Between(1, 100),
+						},
+					},
+				},
+			},
+			"list_options": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Options only applicable to `list` charts.",
+				Attributes: map[string]schema.Attribute{
+					"sort_by":                 newSortByAttribute(),
+					"hide_missing_values":     newOptionalBool("Determines whether to hide missing data points in the chart."),
+					"secondary_visualization": newSecondaryVisualizationAttribute(),
+				},
+			},
+			"single_value_options": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Options only applicable to `single_value` charts.",
+				Attributes: map[string]schema.Attribute{
+					"secondary_visualization": newSecondaryVisualizationAttribute(),
+					"show_spark_line":         newOptionalBool("Whether to show a trend line below the current value."),
+					"hide_timestamp":          newOptionalBool("Whether to hide the timestamp in the chart."),
+				},
+			},
+			"heatmap_options": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Options only applicable to `heatmap` charts.",
+				Attributes: map[string]schema.Attribute{
+					"group_by": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Properties to group by in the heatmap (in nesting order).",
+					},
+					"sort_by":        newSortByAttribute(),
+					"hide_timestamp": newOptionalBool("Whether to hide the timestamp in the chart."),
+				},
+			},
+			"table_options": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Options only applicable to `table` charts.",
+				Attributes: map[string]schema.Attribute{
+					"group_by": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Dimension to group by.",
+					},
+					"hide_timestamp": newOptionalBool("Whether to hide the timestamp in the chart."),
+				},
+			},
+		},
+	}
+}
+
+func newOptionalBool(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     booldefault.StaticBool(false),
+		Description: description,
+	}
+}
+
+func newSortByAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The property to use when sorting the elements. Must be prepended with `+` for ascending or `-` for descending.",
+	}
+}
+
+func newSecondaryVisualizationAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The type of secondary visualization. Can be `None`, `Radial`, `Linear`, or `Sparkline`.",
+		Validators: []validator.String{
+			fwshared.StringOneOf("None", "Radial", "Linear", "Sparkline"),
+		},
+	}
+}
+
+// ValidateConfig ensures that only the attributes applicable to the chart type are set.
+func (rc *ResourceChart) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var values map[string]tftypes.Value
+	if err := req.Config.Raw.As(&values); err != nil {
+		resp.Diagnostics.AddError("Unable to read configuration", err.Error())
+		return
+	}
+
+	var name string
+	if v := values["type"]; !v.IsKnown() || v.IsNull() || v.As(&name) != nil {
+		return
+	}
+
+	ct, ok := chartTypes[name]
+	if !ok {
+		// The type attribute validator reports the invalid value.
+		return
+	}
+
+	for attribute, v := range values {
+		if !v.IsNull() && !ct.supports(attribute) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unsupported Chart Attribute",
+				fmt.Sprintf("The attribute %q can not be set for charts of type %q.", attribute, name),
+			)
+		}
+	}
+
+	required := slices.Clone(ct.required)
+	if name != TypeSLO {
+		required = append(required, "name")
+	}
+	for _, attribute := range required {
+		if values[attribute].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Chart Attribute",
+				fmt.Sprintf("The attribute %q must be set for charts of type %q.", attribute, name),
+			)
+		}
+	}
+
+	var colorBy string
+	if v := values["color_by"]; !values["color_scale"].IsNull() && v.IsKnown() && !v.IsNull() && v.As(&colorBy) == nil && colorBy != "Scale" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("color_by"),
+			"Invalid Chart Attribute",
+			"The attribute \"color_by\" must be set to \"Scale\" when \"color_scale\" is set.",
+		)
+	}
+}
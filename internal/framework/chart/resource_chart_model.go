@@ -0,0 +1,482 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwchart
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/chart"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/visual"
+)
+
+type resourceChartModel struct {
+	Id                 types.String             `tfsdk:"id"`
+	URL                types.String             `tfsdk:"url"`
+	Type               types.String             `tfsdk:"type"`
+	Name               types.String             `tfsdk:"name"`
+	Description        types.String             `tfsdk:"description"`
+	Tags               []types.String           `tfsdk:"tags"`
+	ProgramText        fwtypes.SignalFlow       `tfsdk:"program_text"`
+	Markdown           types.String             `tfsdk:"markdown"`
+	SloID              types.String             `tfsdk:"slo_id"`
+	UnitPrefix         types.String             `tfsdk:"unit_prefix"`
+	ColorBy            types.String             `tfsdk:"color_by"`
+	PlotType           types.String             `tfsdk:"plot_type"`
+	Timezone           types.String             `tfsdk:"timezone"`
+	TimeRange          types.Int64              `tfsdk:"time_range"`
+	MaxDelay           types.Int64              `tfsdk:"max_delay"`
+	MinimumResolution  types.Int64              `tfsdk:"minimum_resolution"`
+	DisableSampling    types.Bool               `tfsdk:"disable_sampling"`
+	RefreshInterval    types.Int64              `tfsdk:"refresh_interval"`
+	MaxPrecision       types.Int64              `tfsdk:"max_precision"`
+	VizOptions         []vizOptionsModel        `tfsdk:"viz_options"`
+	ColorScale         []colorScaleModel        `tfsdk:"color_scale"`
+	TimeOptions        *timeOptionsModel        `tfsdk:"time_options"`
+	ListOptions        *listOptionsModel        `tfsdk:"list_options"`
+	SingleValueOptions *singleValueOptionsModel `tfsdk:"single_value_options"`
+	HeatmapOptions     *heatmapOptionsModel     `tfsdk:"heatmap_options"`
+	TableOptions       *tableOptionsModel       `tfsdk:"table_options"`
+}
+
+type vizOptionsModel struct {
+	Label       types.String `tfsdk:"label"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Color       types.String `tfsdk:"color"`
+	Axis        types.String `tfsdk:"axis"`
+	PlotType    types.String `tfsdk:"plot_type"`
+	ValueUnit   types.String `tfsdk:"value_unit"`
+	ValuePrefix types.String `tfsdk:"value_prefix"`
+	ValueSuffix types.String `tfsdk:"value_suffix"`
+}
+
+type colorScaleModel struct {
+	Gt    types.Float64 `tfsdk:"gt"`
+	Gte   types.Float64 `tfsdk:"gte"`
+	Lt    types.Float64 `tfsdk:"lt"`
+	Lte   types.Float64 `tfsdk:"lte"`
+	Color types.String  `tfsdk:"color"`
+}
+
+type timeOptionsModel struct {
+	Stacked         types.Bool  `tfsdk:"stacked"`
+	ShowEventLines  types.Bool  `tfsdk:"show_event_lines"`
+	ShowDataMarkers types.Bool  `tfsdk:"show_data_markers"`
+	AxesIncludeZero types.Bool  `tfsdk:"axes_include_zero"`
+	AxesPrecision   types.Int64 `tfsdk:"axes_precision"`
+}
+
+type listOptionsModel struct {
+	SortBy                 types.String `tfsdk:"sort_by"`
+	HideMissingValues      types.Bool   `tfsdk:"hide_missing_values"`
+	SecondaryVisualization types.String `tfsdk:"secondary_visualization"`
+}
+
+type singleValueOptionsModel struct {
+	SecondaryVisualization types.String `tfsdk:"secondary_visualization"`
+	ShowSparkLine          types.Bool   `tfsdk:"show_spark_line"`
+	HideTimestamp          types.Bool   `tfsdk:"hide_timestamp"`
+}
+
+type heatmapOptionsModel struct {
+	GroupBy       []types.String `tfsdk:"group_by"`
+	SortBy        types.String   `tfsdk:"sort_by"`
+	HideTimestamp types.Bool     `tfsdk:"hide_timestamp"`
+}
+
+type tableOptionsModel struct {
+	GroupBy       []types.String `tfsdk:"group_by"`
+	HideTimestamp types.Bool     `tfsdk:"hide_timestamp"`
+}
+
+func (rc *ResourceChart) encode(_ context.Context, model *resourceChartModel) (*chartRequest, diag.Diagnostics) {
+	name := model.Type.ValueString()
+	ct, ok := chartTypes[name]
+	if !ok {
+		var diags diag.Diagnostics
+		diags.AddAttributeError(path.Root("type"), "Invalid Chart Type", fmt.Sprintf("The chart type %q is not supported.", name))
+		return nil, diags
+	}
+
+	if name == TypeSLO {
+		return &chartRequest{
+			SLO: &chart.CreateUpdateSloChartRequest{SloId: model.SloID.ValueString()},
+		}, nil
+	}
+
+	options := &chart.Options{
+		Type:       ct.api,
+		UnitPrefix: model.UnitPrefix.ValueString(),
+		ColorBy:    model.ColorBy.ValueString(),
+		Markdown:   model.Markdown.ValueString(),
+	}
+
+	if !model.TimeRange.IsNull() {
+		options.Time = &chart.TimeDisplayOptions{
+			Range: pointer(model.TimeRange.ValueInt64() * 1000),
+			Type:  "relative",
+		}
+	}
+
+	if !model.MaxDelay.IsNull() || !model.MinimumResolution.IsNull() || !model.Timezone.IsNull() || model.DisableSampling.ValueBool() {
+		options.ProgramOptions = &chart.GeneralOptions{
+			DisableSampling: model.DisableSampling.ValueBool(),
+			Timezone:        model.Timezone.ValueString(),
+		}
+		if !model.MaxDelay.IsNull() {
+			options.ProgramOptions.MaxDelay = pointer(int32(model.MaxDelay.ValueInt64() * 1000))
+		}
+		if !model.MinimumResolution.IsNull() {
+			options.ProgramOptions.MinimumResolution = pointer(int32(model.MinimumResolution.ValueInt64() * 1000))
+		}
+	}
+
+	if !model.RefreshInterval.IsNull() {
+		options.RefreshInterval = pointer(int32(model.RefreshInterval.ValueInt64() * 1000))
+	}
+	if !model.MaxPrecision.IsNull() {
+		options.MaximumPrecision = pointer(int32(model.MaxPrecision.ValueInt64()))
+	}
+
+	palette := visual.NewColorPalette()
+	for _, viz := range model.VizOptions {
+		opt := &chart.PublishLabelOptions{
+			Label:       viz.Label.ValueString(),
+			DisplayName: viz.DisplayName.ValueString(),
+			PlotType:    viz.PlotType.ValueString(),
+			ValueUnit:   viz.ValueUnit.ValueString(),
+			ValuePrefix: viz.ValuePrefix.ValueString(),
+			ValueSuffix: viz.ValueSuffix.ValueString(),
+		}
+		if idx, ok := palette.ColorIndex(viz.Color.ValueString()); ok {
+			opt.PaletteIndex = pointer(idx)
+		}
+		if viz.Axis.ValueString() == "right" {
+			opt.YAxis = 1
+		}
+		options.PublishLabelOptions = append(options.PublishLabelOptions, opt)
+	}
+
+	scale := visual.NewColorScalePalette()
+	for _, cs := range model.ColorScale {
+		opt := &chart.SecondaryVisualization{
+			Gt:  model2float(cs.Gt),
+			Gte: model2float(cs.Gte),
+			Lt:  model2float(cs.Lt),
+			Lte: model2float(cs.Lte),
+		}
+		if idx, ok := scale.ColorIndex(cs.Color.ValueString()); ok {
+			opt.PaletteIndex = pointer(idx)
+		}
+		options.ColorScale2 = append(options.ColorScale2, opt)
+	}
+	if len(options.ColorScale2) > 0 {
+		options.ColorBy = "Scale"
+	}
+
+	if name == TypeTime {
+		options.DefaultPlotType = model.PlotType.ValueString()
+		if opts := model.TimeOptions; opts != nil {
+			options.Stacked = opts.Stacked.ValueBool()
+			options.ShowEventLines = opts.ShowEventLines.ValueBool()
+			options.IncludeZero = opts.AxesIncludeZero.ValueBool()
+			if !opts.AxesPrecision.IsNull() {
+				options.AxisPrecision = pointer(int32(opts.AxesPrecision.ValueInt64()))
+			}
+			if opts.ShowDataMarkers.ValueBool() {
+				if options.DefaultPlotType == "AreaChart" {
+					options.AreaChartOptions = &chart.AreaChartOptions{ShowDataMarkers: true}
+				} else {
+					options.LineChartOptions = &chart.LineChartOptions{ShowDataMarkers: true}
+				}
+			}
+		}
+	}
+
+	if opts := model.ListOptions; opts != nil {
+		options.SortBy = opts.SortBy.ValueString()
+		options.HideMissingValues = opts.HideMissingValues.ValueBool()
+		options.SecondaryVisualization = opts.SecondaryVisualization.ValueString()
+	}
+	if opts := model.SingleValueOptions; opts != nil {
+		options.SecondaryVisualization = opts.SecondaryVisualization.ValueString()
+		options.ShowSparkLine = opts.ShowSparkLine.ValueBool()
+		options.TimestampHidden = opts.HideTimestamp.ValueBool()
+	}
+	if opts := model.HeatmapOptions; opts != nil {
+		options.GroupBy = valueStrings(opts.GroupBy)
+		options.SortBy = opts.SortBy.ValueString()
+		options.TimestampHidden = opts.HideTimestamp.ValueBool()
+	}
+	if opts := model.TableOptions; opts != nil {
+		options.GroupBy = valueStrings(opts.GroupBy)
+		options.TimestampHidden = opts.HideTimestamp.ValueBool()
+	}
+
+	return &chartRequest{
+		Chart: &chart.CreateUpdateChartRequest{
+			Name:        model.Name.ValueString(),
+			Description: model.Description.ValueString(),
+			ProgramText: model.ProgramText.ValueString(),
+			Tags:        valueStrings(model.Tags),
+			Options:     options,
+		},
+	}, nil
+}
+
+// merge includes the provider tags with the configured tags.
+func (rc *ResourceChart) merge(ctx context.Context, meta *pmeta.Meta, req *chartRequest) {
+	if req.Chart == nil {
+		return
+	}
+	if tags := pmeta.LoadProviderTags(ctx, meta); len(tags) > 0 {
+		req.Chart.Tags = common.Unique(tags, req.Chart.Tags)
+	}
+}
+
+func (rc *ResourceChart) decode(ctx context.Context, details *chart.Chart, model *resourceChartModel) (diags diag.Diagnostics) {
+	model.Id = types.StringValue(details.Id)
+	model.Name = types.StringValue(details.Name)
+	model.Description = stringValue(details.Description)
+	model.ProgramText = signalflowValue(details.ProgramText)
+	model.SloID = stringValue(details.SloId)
+
+	// Tags that are added by the provider are only kept when they are configured,
+	// otherwise it would cause a permanent difference.
+	provider := pmeta.LoadProviderTags(ctx, rc.Details())
+	configured := valueStrings(model.Tags)
+	model.Tags = nil
+	for _, tag := range details.Tags {
+		if slices.Contains(provider, tag) && !slices.Contains(configured, tag) {
+			continue
+		}
+		model.Tags = append(model.Tags, types.StringValue(tag))
+	}
+
+	options := details.Options
+	if options == nil {
+		options = &chart.Options{}
+	}
+
+	name, ok := chartTypeFromAPI(options.Type)
+	switch {
+	case details.SloId != "":
+		name = TypeSLO
+	case !ok:
+		diags.AddError("Unsupported Chart Type", fmt.Sprintf("The chart %q has the unsupported type %q.", details.Id, options.Type))
+		return diags
+	}
+
+	prior := *model
+	*model = resourceChartModel{
+		Id:          model.Id,
+		URL:         model.URL,
+		Type:        types.StringValue(name),
+		Name:        model.Name,
+		Description: model.Description,
+		Tags:        model.Tags,
+		ProgramText: model.ProgramText,
+		SloID:       model.SloID,
+	}
+	if name == TypeSLO {
+		// SLO charts are entirely managed by the API, so only the SLO ID is read.
+		model.UnitPrefix = types.StringNull()
+		model.ColorBy = types.StringNull()
+		model.PlotType = types.StringNull()
+		model.DisableSampling = types.BoolValue(false)
+		return diags
+	}
+
+	model.Markdown = stringValue(options.Markdown)
+	model.UnitPrefix = stringValue(options.UnitPrefix)
+	model.ColorBy = stringValue(options.ColorBy)
+	model.PlotType = stringValue(options.DefaultPlotType)
+	model.DisableSampling = types.BoolValue(false)
+
+	if options.Time != nil && options.Time.Range != nil {
+		model.TimeRange = types.Int64Value(*options.Time.Range / 1000)
+	}
+	if po := options.ProgramOptions; po != nil {
+		model.Timezone = stringValue(po.Timezone)
+		model.DisableSampling = types.BoolValue(po.DisableSampling)
+		if po.MaxDelay != nil {
+			model.MaxDelay = types.Int64Value(int64(*po.MaxDelay / 1000))
+		}
+		if po.MinimumResolution != nil {
+			model.MinimumResolution = types.Int64Value(int64(*po.MinimumResolution / 1000))
+		}
+	}
+	if options.RefreshInterval != nil {
+		model.RefreshInterval = types.Int64Value(int64(*options.RefreshInterval / 1000))
+	}
+	if options.MaximumPrecision != nil {
+		model.MaxPrecision = types.Int64Value(int64(*options.MaximumPrecision))
+	}
+
+	palette := visual.NewColorPalette()
+	for _, opt := range options.PublishLabelOptions {
+		if opt == nil {
+			continue
+		}
+		viz := vizOptionsModel{
+			Label:       types.StringValue(opt.Label),
+			DisplayName: stringValue(opt.DisplayName),
+			PlotType:    stringValue(opt.PlotType),
+			ValueUnit:   stringValue(opt.ValueUnit),
+			ValuePrefix: stringValue(opt.ValuePrefix),
+			ValueSuffix: stringValue(opt.ValueSuffix),
+		}
+		if opt.PaletteIndex != nil {
+			if color, ok := palette.IndexColorName(*opt.PaletteIndex); ok {
+				viz.Color = types.StringValue(color)
+			}
+		}
+		// The API always returns the axis, using 0 for the left axis.
+		viz.Axis = types.StringValue("left")
+		if opt.YAxis == 1 {
+			viz.Axis = types.StringValue("right")
+		}
+		model.VizOptions = append(model.VizOptions, viz)
+	}
+
+	scale := visual.NewColorScalePalette()
+	for _, opt := range options.ColorScale2 {
+		if opt == nil {
+			continue
+		}
+		cs := colorScaleModel{
+			Gt:  float2model(opt.Gt),
+			Gte: float2model(opt.Gte),
+			Lt:  float2model(opt.Lt),
+			Lte: float2model(opt.Lte),
+		}
+		if opt.PaletteIndex != nil {
+			if color, ok := scale.IndexColorName(*opt.PaletteIndex); ok {
+				cs.Color = types.StringValue(color)
+			}
+		}
+		model.ColorScale = append(model.ColorScale, cs)
+	}
+
+	// The type specific options are only set when they were previously configured,
+	// or when the API has non default values set to avoid showing a difference for unset options.
+	switch name {
+	case TypeTime:
+		opts := &timeOptionsModel{
+			Stacked:         types.BoolValue(options.Stacked),
+			ShowEventLines:  types.BoolValue(options.ShowEventLines),
+			ShowDataMarkers: types.BoolValue((options.LineChartOptions != nil && options.LineChartOptions.ShowDataMarkers) || (options.AreaChartOptions != nil && options.AreaChartOptions.ShowDataMarkers)),
+			AxesIncludeZero: types.BoolValue(options.IncludeZero),
+		}
+		if options.AxisPrecision != nil {
+			opts.AxesPrecision = types.Int64Value(int64(*options.AxisPrecision))
+		}
+		if prior.TimeOptions != nil || options.Stacked || options.ShowEventLines || opts.ShowDataMarkers.ValueBool() || options.IncludeZero || options.AxisPrecision != nil {
+			model.TimeOptions = opts
+		}
+	case TypeList:
+		opts := &listOptionsModel{
+			SortBy:                 types.StringValue(options.SortBy),
+			HideMissingValues:      types.BoolValue(options.HideMissingValues),
+			SecondaryVisualization: types.StringValue(options.SecondaryVisualization),
+		}
+		if prior.ListOptions != nil || options.SortBy != "" || options.HideMissingValues || !isDefaultVisualization(options.SecondaryVisualization) {
+			model.ListOptions = opts
+		}
+	case TypeSingleValue:
+		opts := &singleValueOptionsModel{
+			SecondaryVisualization: types.StringValue(options.SecondaryVisualization),
+			ShowSparkLine:          types.BoolValue(options.ShowSparkLine),
+			HideTimestamp:          types.BoolValue(options.TimestampHidden),
+		}
+		if prior.SingleValueOptions != nil || options.ShowSparkLine || options.TimestampHidden || !isDefaultVisualization(options.SecondaryVisualization) {
+			model.SingleValueOptions = opts
+		}
+	case TypeHeatmap:
+		opts := &heatmapOptionsModel{
+			GroupBy:       stringValues(options.GroupBy),
+			SortBy:        types.StringValue(options.SortBy),
+			HideTimestamp: types.BoolValue(options.TimestampHidden),
+		}
+		if prior.HeatmapOptions != nil || len(options.GroupBy) > 0 || options.SortBy != "" || options.TimestampHidden {
+			model.HeatmapOptions = opts
+		}
+	case TypeTable:
+		opts := &tableOptionsModel{
+			GroupBy:       stringValues(options.GroupBy),
+			HideTimestamp: types.BoolValue(options.TimestampHidden),
+		}
+		if prior.TableOptions != nil || len(options.GroupBy) > 0 || options.TimestampHidden {
+			model.TableOptions = opts
+		}
+	}
+
+	return diags
+}
+
+func isDefaultVisualization(v string) bool {
+	return v == "" || v == "None"
+}
+
+func pointer[T any](v T) *T {
+	return &v
+}
+
+func stringValue(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func signalflowValue(v string) fwtypes.SignalFlow {
+	if v == "" {
+		return fwtypes.SignalFlow{StringValue: types.StringNull()}
+	}
+	return fwtypes.NewSignalFlowValue(v)
+}
+
+func valueStrings(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, v.ValueString())
+	}
+	return out
+}
+
+func stringValues(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]types.String, 0, len(values))
+	for _, v := range values {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
+
+func model2float(v types.Float64) *float64 {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return pointer(v.ValueFloat64())
+}
+
+func float2model(v *float64) types.Float64 {
+	if v == nil {
+		return types.Float64Null()
+	}
+	return types.Float64Value(*v)
+}
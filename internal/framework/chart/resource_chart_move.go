@@ -0,0 +1,145 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwchart
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
+)
+
+// unsupportedLegacyAttributes are the attributes of the chart resources
+// that have no equivalent within `signalfx_chart`, moving a chart that sets them
+// would remove those settings with the next update.
+var unsupportedLegacyAttributes = []string{
+	"axis_left",
+	"axis_right",
+	"color_range",
+	"end_time",
+	"event_options",
+	"histogram_options",
+	"legend_fields_to_hide",
+	"legend_options_fields",
+	"on_chart_legend_dimension",
+	"start_time",
+}
+
+// MoveState allows for the existing chart resources to be moved into `signalfx_chart`
+// using a `moved` block without needing to recreate the chart.
+// Only the shared attributes are copied over, the following refresh reads the remaining values from the API.
+func (rc *ResourceChart) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{StateMover: rc.moveLegacyChart},
+	}
+}
+
+func (rc *ResourceChart) moveLegacyChart(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !strings.HasSuffix(req.SourceProviderAddress, "/signalfx") || req.SourceRawState == nil {
+		return
+	}
+
+	name, ok := chartTypeFromLegacy(req.SourceTypeName)
+	if !ok {
+		return
+	}
+
+	var source map[string]any
+	if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+		resp.Diagnostics.AddError("Unable to read source state", err.Error())
+		return
+	}
+
+	var unsupported []string
+	for _, attribute := range unsupportedLegacyAttributes {
+		if isSourceValueSet(source[attribute]) {
+			unsupported = append(unsupported, attribute)
+		}
+	}
+	if len(unsupported) > 0 {
+		resp.Diagnostics.AddError(
+			"Unsupported Chart Attributes",
+			fmt.Sprintf(
+				"The %s chart %q sets %s which can not be set with signalfx_chart and would be removed by the next update. "+
+					"Keep using %s to manage the chart, or remove the attributes before moving it.",
+				req.SourceTypeName, sourceString(source, "id").ValueString(), strings.Join(unsupported, ", "), req.SourceTypeName,
+			),
+		)
+		return
+	}
+
+	model := resourceChartModel{
+		Id:                sourceString(source, "id"),
+		Type:              types.StringValue(name),
+		Name:              sourceString(source, "name"),
+		Description:       sourceString(source, "description"),
+		ProgramText:       signalflowValue(sourceString(source, "program_text").ValueString()),
+		Markdown:          sourceString(source, "markdown"),
+		SloID:             sourceString(source, "slo_id"),
+		UnitPrefix:        sourceString(source, "unit_prefix"),
+		ColorBy:           sourceString(source, "color_by"),
+		PlotType:          sourceString(source, "plot_type"),
+		Timezone:          sourceString(source, "timezone"),
+		TimeRange:         sourceInt64(source, "time_range"),
+		MaxDelay:          sourceInt64(source, "max_delay"),
+		MinimumResolution: sourceInt64(source, "minimum_resolution"),
+		RefreshInterval:   sourceInt64(source, "refresh_interval"),
+		MaxPrecision:      sourceInt64(source, "max_precision"),
+		DisableSampling:   types.BoolValue(false),
+		URL:               sourceString(source, "url"),
+	}
+	if v, ok := source["disable_sampling"].(bool); ok {
+		model.DisableSampling = types.BoolValue(v)
+	}
+	if tags, ok := source["tags"].([]any); ok {
+		for _, tag := range tags {
+			if s, ok := tag.(string); ok {
+				model.Tags = append(model.Tags, types.StringValue(s))
+			}
+		}
+	}
+
+	if resp.Diagnostics.Append(resp.TargetState.Set(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(fwembed.SetResourceIdentity(ctx, rc.Details(), resp.TargetIdentity, model.Id.ValueString())...)
+}
+
+// isSourceValueSet reports if the value read from the source state is not empty.
+func isSourceValueSet(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case float64:
+		return v != 0
+	case bool:
+		return v
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	}
+	return true
+}
+
+func sourceString(source map[string]any, key string) types.String {
+	if v, ok := source[key].(string); ok && v != "" {
+		return types.StringValue(v)
+	}
+	return types.StringNull()
+}
+
+func sourceInt64(source map[string]any, key string) types.Int64 {
+	if v, ok := source[key].(float64); ok && v != 0 {
+		return types.Int64Value(int64(v))
+	}
+	return types.Int64Null()
+}
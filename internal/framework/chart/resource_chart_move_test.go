@@ -0,0 +1,182 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwchart
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+)
+
+func TestResourceChartMoveState(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		source   string
+		address  string
+		state    string
+		expect   *resourceChartModel
+		errors   int
+		detail   string
+		identity string
+	}{
+		{
+			name:    "unrelated resource",
+			source:  "signalfx_detector",
+			address: "registry.terraform.io/splunk-terraform/signalfx",
+			state:   `{"id":"detector-01"}`,
+		},
+		{
+			name:    "different provider",
+			source:  "signalfx_time_chart",
+			address: "registry.terraform.io/example/other",
+			state:   `{"id":"chart-01"}`,
+		},
+		{
+			name:    "invalid state",
+			source:  "signalfx_time_chart",
+			address: "registry.terraform.io/splunk-terraform/signalfx",
+			state:   `{`,
+			errors:  1,
+		},
+		{
+			name:    "time chart",
+			source:  "signalfx_time_chart",
+			address: "registry.terraform.io/splunk-terraform/signalfx",
+			state: `{
+				"id": "chart-01",
+				"name": "CPU",
+				"description": "",
+				"program_text": "A = data('cpu.utilization').publish('A')",
+				"tags": ["team-a"],
+				"plot_type": "LineChart",
+				"time_range": 3600,
+				"max_delay": 0,
+				"disable_sampling": true,
+				"url": "https://app.signalfx.com/#/chart/chart-01/edit"
+			}`,
+			expect: &resourceChartModel{
+				Id:              types.StringValue("chart-01"),
+				URL:             types.StringValue("https://app.signalfx.com/#/chart/chart-01/edit"),
+				Type:            types.StringValue(TypeTime),
+				Name:            types.StringValue("CPU"),
+				ProgramText:     fwtypes.NewSignalFlowValue("A = data('cpu.utilization').publish('A')"),
+				Tags:            []types.String{types.StringValue("team-a")},
+				PlotType:        types.StringValue("LineChart"),
+				TimeRange:       types.Int64Value(3600),
+				DisableSampling: types.BoolValue(true),
+			},
+			identity: "chart-01",
+		},
+		{
+			name:    "unsupported attributes",
+			source:  "signalfx_time_chart",
+			address: "registry.terraform.io/splunk-terraform/signalfx",
+			state: `{
+				"id": "chart-01",
+				"name": "CPU",
+				"program_text": "A = data('cpu.utilization').publish('A')",
+				"axis_left": [{"label": "cpu", "min_value": 0, "max_value": 100}],
+				"axis_right": [],
+				"legend_options_fields": [{"property": "host", "enabled": true}],
+				"on_chart_legend_dimension": "",
+				"start_time": 0
+			}`,
+			errors: 1,
+			detail: `The signalfx_time_chart chart "chart-01" sets axis_left, legend_options_fields which can not be set with signalfx_chart`,
+		},
+		{
+			name:    "unset unsupported attributes",
+			source:  "signalfx_list_chart",
+			address: "registry.terraform.io/splunk-terraform/signalfx",
+			state: `{
+				"id": "chart-03",
+				"name": "Hosts",
+				"program_text": "A = data('cpu.utilization').publish('A')",
+				"legend_options_fields": [],
+				"legend_fields_to_hide": null,
+				"start_time": 0,
+				"end_time": 0
+			}`,
+			expect: &resourceChartModel{
+				Id:              types.StringValue("chart-03"),
+				Type:            types.StringValue(TypeList),
+				Name:            types.StringValue("Hosts"),
+				ProgramText:     fwtypes.NewSignalFlowValue("A = data('cpu.utilization').publish('A')"),
+				DisableSampling: types.BoolValue(false),
+			},
+			identity: "chart-03",
+		},
+		{
+			name:    "slo chart",
+			source:  "signalfx_slo_chart",
+			address: "registry.terraform.io/splunk-terraform/signalfx",
+			state:   `{"id": "chart-02", "slo_id": "slo-01"}`,
+			expect: &resourceChartModel{
+				Id:              types.StringValue("chart-02"),
+				Type:            types.StringValue(TypeSLO),
+				SloID:           types.StringValue("slo-01"),
+				DisableSampling: types.BoolValue(false),
+			},
+			identity: "chart-02",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rc := newTestResourceChart(t)
+
+			schemaResp := &resource.SchemaResponse{}
+			rc.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+			identitySchema := fwembed.NewResourceIdentitySchema()
+
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+				},
+				TargetIdentity: &tfsdk.ResourceIdentity{
+					Schema: identitySchema,
+					Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(context.Background()), nil),
+				},
+			}
+
+			movers := rc.MoveState(context.Background())
+			require.Len(t, movers, 1, "Must have a single state mover")
+			movers[0].StateMover(context.Background(), resource.MoveStateRequest{
+				SourceTypeName:        tc.source,
+				SourceProviderAddress: tc.address,
+				SourceRawState:        &tfprotov6.RawState{JSON: []byte(tc.state)},
+			}, resp)
+			require.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors: %v", resp.Diagnostics)
+			if tc.detail != "" {
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.detail, "Must report the unsupported attributes")
+			}
+
+			if tc.expect == nil {
+				assert.True(t, resp.TargetState.Raw.IsNull(), "Must not set the target state")
+				return
+			}
+
+			var actual resourceChartModel
+			require.False(t, resp.TargetState.Get(context.Background(), &actual).HasError(), "Must read the target state")
+			assert.Equal(t, *tc.expect, actual, "Must match the expected state")
+
+			var identity fwembed.ResourceIdentityModel
+			require.False(t, resp.TargetIdentity.Get(context.Background(), &identity).HasError(), "Must read the target identity")
+			assert.Equal(t, tc.identity, identity.Id.ValueString(), "Must set the identity")
+		})
+	}
+}
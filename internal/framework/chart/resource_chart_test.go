@@ -0,0 +1,351 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwchart
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/fwtest"
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+func newTestResourceChart(t *testing.T, tags ...string) *ResourceChart {
+	rc := NewResourceChart().(*ResourceChart)
+
+	registry := feature.NewRegistry()
+	registry.MustRegister(feature.PreviewProviderTags, feature.WithPreviewGlobalAvailable())
+
+	resp := &resource.ConfigureResponse{}
+	rc.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &pmeta.Meta{
+			Registry: registry,
+			Tags:     tags,
+		},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Must not error configuring resource")
+
+	return rc
+}
+
+func newTestChartConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	resp := &resource.SchemaResponse{}
+	NewResourceChart().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Must not error loading schema")
+
+	typ := resp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, at := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(at, nil)
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		}
+	}
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(typ, attrs)}
+}
+
+func TestResourceChartMetadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &resource.MetadataResponse{}
+	NewResourceChart().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "signalfx"}, resp)
+
+	assert.Equal(t, "signalfx_chart", resp.TypeName, "Must match the expected type name")
+}
+
+func TestResourceChartSchema(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, fwtest.ResourceSchemaValidate(NewResourceChart(), resourceChartModel{}))
+}
+
+func TestResourceChartValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	colorScale := tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"gt":    tftypes.Number,
+		"gte":   tftypes.Number,
+		"lt":    tftypes.Number,
+		"lte":   tftypes.Number,
+		"color": tftypes.String,
+	}}}
+
+	for _, tc := range []struct {
+		name   string
+		values map[string]tftypes.Value
+		errors int
+	}{
+		{
+			name: "unknown type",
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+		{
+			name: "invalid type",
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, "pie"),
+			},
+		},
+		{
+			name: "valid time chart",
+			values: map[string]tftypes.Value{
+				"type":         tftypes.NewValue(tftypes.String, TypeTime),
+				"name":         tftypes.NewValue(tftypes.String, "example"),
+				"program_text": tftypes.NewValue(tftypes.String, "A = data('cpu.utilization').publish('A')"),
+				"plot_type":    tftypes.NewValue(tftypes.String, "LineChart"),
+			},
+		},
+		{
+			name: "missing program text",
+			values: map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, TypeTime),
+				"name": tftypes.NewValue(tftypes.String, "example"),
+			},
+			errors: 1,
+		},
+		{
+			name: "text chart with program text",
+			values: map[string]tftypes.Value{
+				"type":         tftypes.NewValue(tftypes.String, TypeText),
+				"name":         tftypes.NewValue(tftypes.String, "example"),
+				"program_text": tftypes.NewValue(tftypes.String, "A = data('cpu.utilization').publish('A')"),
+			},
+			errors: 2,
+		},
+		{
+			name: "slo chart without name",
+			values: map[string]tftypes.Value{
+				"type":   tftypes.NewValue(tftypes.String, TypeSLO),
+				"slo_id": tftypes.NewValue(tftypes.String, "slo-01"),
+			},
+		},
+		{
+			name: "color scale without scale color by",
+			values: map[string]tftypes.Value{
+				"type":         tftypes.NewValue(tftypes.String, TypeList),
+				"name":         tftypes.NewValue(tftypes.String, "example"),
+				"program_text": tftypes.NewValue(tftypes.String, "A = data('cpu.utilization').publish('A')"),
+				"color_by":     tftypes.NewValue(tftypes.String, "Dimension"),
+				"color_scale": tftypes.NewValue(colorScale, []tftypes.Value{
+					tftypes.NewValue(colorScale.ElementType, map[string]tftypes.Value{
+						"gt":    tftypes.NewValue(tftypes.Number, 10),
+						"gte":   tftypes.NewValue(tftypes.Number, nil),
+						"lt":    tftypes.NewValue(tftypes.Number, nil),
+						"lte":   tftypes.NewValue(tftypes.Number, nil),
+						"color": tftypes.NewValue(tftypes.String, "red"),
+					}),
+				}),
+			},
+			errors: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.ValidateConfigResponse{}
+			NewResourceChart().(*ResourceChart).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: newTestChartConfig(t, tc.values),
+			}, resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors: %v", resp.Diagnostics)
+		})
+	}
+}
+
+// toChart mimics the API response for the provided request.
+func toChart(id string, req *chartRequest) *chart.Chart {
+	if req.SLO != nil {
+		return &chart.Chart{Id: id, Name: "SLO Chart", SloId: req.SLO.SloId}
+	}
+	return &chart.Chart{
+		Id:          id,
+		Name:        req.Chart.Name,
+		Description: req.Chart.Description,
+		ProgramText: req.Chart.ProgramText,
+		Tags:        req.Chart.Tags,
+		Options:     req.Chart.Options,
+	}
+}
+
+func TestResourceChartEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name  string
+		model resourceChartModel
+	}{
+		{
+			name: "time chart",
+			model: resourceChartModel{
+				Type:              types.StringValue(TypeTime),
+				Name:              types.StringValue("CPU"),
+				Description:       types.StringValue("CPU utilization"),
+				Tags:              []types.String{types.StringValue("team-a")},
+				ProgramText:       fwtypes.NewSignalFlowValue("A = data('cpu.utilization').publish('A')"),
+				UnitPrefix:        types.StringValue("Metric"),
+				ColorBy:           types.StringValue("Dimension"),
+				PlotType:          types.StringValue("AreaChart"),
+				Timezone:          types.StringValue("Europe/Paris"),
+				TimeRange:         types.Int64Value(3600),
+				MaxDelay:          types.Int64Value(60),
+				MinimumResolution: types.Int64Value(10),
+				DisableSampling:   types.BoolValue(true),
+				VizOptions: []vizOptionsModel{
+					{
+						Label:       types.StringValue("A"),
+						DisplayName: types.StringValue("CPU"),
+						Color:       types.StringValue("blue"),
+						Axis:        types.StringValue("right"),
+						ValueSuffix: types.StringValue("%"),
+					},
+					{
+						Label:    types.StringValue("B"),
+						Axis:     types.StringValue("left"),
+						PlotType: types.StringValue("LineChart"),
+					},
+				},
+				TimeOptions: &timeOptionsModel{
+					Stacked:         types.BoolValue(true),
+					ShowEventLines:  types.BoolValue(false),
+					ShowDataMarkers: types.BoolValue(true),
+					AxesIncludeZero: types.BoolValue(false),
+					AxesPrecision:   types.Int64Value(3),
+				},
+			},
+		},
+		{
+			name: "list chart",
+			model: resourceChartModel{
+				Type:            types.StringValue(TypeList),
+				Name:            types.StringValue("Hosts"),
+				ProgramText:     fwtypes.NewSignalFlowValue("A = data('cpu.utilization').publish('A')"),
+				UnitPrefix:      types.StringValue("Binary"),
+				ColorBy:         types.StringValue("Scale"),
+				DisableSampling: types.BoolValue(false),
+				RefreshInterval: types.Int64Value(30),
+				MaxPrecision:    types.Int64Value(2),
+				ColorScale: []colorScaleModel{
+					{Gt: types.Float64Value(80), Gte: types.Float64Null(), Lt: types.Float64Null(), Lte: types.Float64Null(), Color: types.StringValue("red")},
+					{Gt: types.Float64Null(), Gte: types.Float64Null(), Lt: types.Float64Null(), Lte: types.Float64Value(80), Color: types.StringValue("green")},
+				},
+				ListOptions: &listOptionsModel{
+					SortBy:                 types.StringValue("-value"),
+					HideMissingValues:      types.BoolValue(true),
+					SecondaryVisualization: types.StringValue("Sparkline"),
+				},
+			},
+		},
+		{
+			name: "heatmap chart",
+			model: resourceChartModel{
+				Type:            types.StringValue(TypeHeatmap),
+				Name:            types.StringValue("Heatmap"),
+				ProgramText:     fwtypes.NewSignalFlowValue("A = data('cpu.utilization').publish('A')"),
+				UnitPrefix:      types.StringValue("Metric"),
+				DisableSampling: types.BoolValue(false),
+				HeatmapOptions: &heatmapOptionsModel{
+					GroupBy:       []types.String{types.StringValue("host")},
+					SortBy:        types.StringValue("+host"),
+					HideTimestamp: types.BoolValue(false),
+				},
+			},
+		},
+		{
+			name: "text chart",
+			model: resourceChartModel{
+				Type:            types.StringValue(TypeText),
+				Name:            types.StringValue("Notes"),
+				Markdown:        types.StringValue("# Notes"),
+				DisableSampling: types.BoolValue(false),
+			},
+		},
+		{
+			name: "slo chart",
+			model: resourceChartModel{
+				Type:            types.StringValue(TypeSLO),
+				Name:            types.StringValue("SLO Chart"),
+				SloID:           types.StringValue("slo-01"),
+				DisableSampling: types.BoolValue(false),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rc := newTestResourceChart(t)
+
+			req, diags := rc.encode(context.Background(), &tc.model)
+			require.False(t, diags.HasError(), "Must not error encoding model: %v", diags)
+
+			var actual resourceChartModel
+			diags = rc.decode(context.Background(), toChart("chart-01", req), &actual)
+			require.False(t, diags.HasError(), "Must not error decoding chart: %v", diags)
+
+			expect := tc.model
+			expect.Id = types.StringValue("chart-01")
+			assert.Equal(t, expect, actual, "Must match the original model")
+		})
+	}
+}
+
+func TestResourceChartDecodeOptions(t *testing.T) {
+	t.Parallel()
+
+	rc := newTestResourceChart(t)
+	details := &chart.Chart{
+		Id:          "chart-01",
+		Name:        "CPU",
+		ProgramText: "A = data('cpu.utilization').publish('A')",
+		Options: &chart.Options{
+			Type:                   "SingleValue",
+			SecondaryVisualization: "None",
+		},
+	}
+
+	var model resourceChartModel
+	require.False(t, rc.decode(context.Background(), details, &model).HasError(), "Must not error decoding chart")
+	assert.Nil(t, model.SingleValueOptions, "Must not set default options when not configured")
+
+	model.SingleValueOptions = &singleValueOptionsModel{}
+	require.False(t, rc.decode(context.Background(), details, &model).HasError(), "Must not error decoding chart")
+	assert.Equal(t, &singleValueOptionsModel{
+		SecondaryVisualization: types.StringValue("None"),
+		ShowSparkLine:          types.BoolValue(false),
+		HideTimestamp:          types.BoolValue(false),
+	}, model.SingleValueOptions, "Must set options when previously configured")
+
+	details.Options.Type = "Unknown"
+	assert.True(t, rc.decode(context.Background(), details, &model).HasError(), "Must error on unsupported chart types")
+}
+
+func TestResourceChartProviderTags(t *testing.T) {
+	t.Parallel()
+
+	rc := newTestResourceChart(t, "managed-by:terraform")
+	model := resourceChartModel{
+		Type:        types.StringValue(TypeText),
+		Name:        types.StringValue("Notes"),
+		Markdown:    types.StringValue("# Notes"),
+		Tags:        []types.String{types.StringValue("team-a")},
+		ProgramText: fwtypes.SignalFlow{StringValue: types.StringNull()},
+	}
+
+	req, diags := rc.encode(context.Background(), &model)
+	require.False(t, diags.HasError(), "Must not error encoding model")
+	rc.merge(context.Background(), rc.Details(), req)
+	assert.ElementsMatch(t, []string{"managed-by:terraform", "team-a"}, req.Chart.Tags, "Must include provider tags")
+
+	require.False(t, rc.decode(context.Background(), toChart("chart-01", req), &model).HasError(), "Must not error decoding chart")
+	assert.Equal(t, []types.String{types.StringValue("team-a")}, model.Tags, "Must not include provider tags in state")
+}
@@ -0,0 +1,181 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwembed
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/signalfx/signalfx-go"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/fwerr"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+// ResourceCRUD is an embeddable generic engine that implements the Create, Read, Update and Delete
+// methods of [resource.Resource] so that a resource only needs to define its schema and the mapping
+// between the terraform model M, the API request Req and the API response Resp.
+//
+// The model is expected to have an `id` attribute which is used to reference the object within the API,
+// and if AppPath is set, a `url` attribute that is populated with the link to the object within the application.
+type ResourceCRUD[M, Req, Resp any] struct {
+	ResourceData
+
+	// AppPath is the application path used to build the `url` attribute,
+	// it is left unset when the resource does not have a `url` attribute.
+	AppPath string
+
+	// Encode converts the terraform model into the API request.
+	Encode func(ctx context.Context, model *M) (*Req, diag.Diagnostics)
+	// Decode updates the terraform model with the API response,
+	// this must include setting the `id` attribute on the model.
+	Decode func(ctx context.Context, resp *Resp, model *M) diag.Diagnostics
+	// Merge is an optional hook that is called before the request is sent
+	// to allow for provider defined values, such as tags or teams, to be merged into the request.
+	Merge func(ctx context.Context, meta *pmeta.Meta, req *Req)
+
+	CreateFunc func(ctx context.Context, client *signalfx.Client, req *Req) (*Resp, error)
+	ReadFunc   func(ctx context.Context, client *signalfx.Client, id string) (*Resp, error)
+	UpdateFunc func(ctx context.Context, client *signalfx.Client, id string, req *Req) (*Resp, error)
+	DeleteFunc func(ctx context.Context, client *signalfx.Client, id string) error
+}
+
+func (crud *ResourceCRUD[M, Req, Resp]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := crud.encode(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating new resource", tfext.NewLogFields().JSON("payload", payload))
+
+	details, err := crud.CreateFunc(ctx, crud.Details().Client, payload)
+	if resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, &resp.State, err)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	crud.setState(ctx, details, &model, &resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (crud *ResourceCRUD[M, Req, Resp]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model M
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := crud.loadID(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identity is validated before reading to ensure that
+	// the resource is not read from a different organization.
+	if resp.Diagnostics.Append(ValidateResourceIdentity(ctx, crud.Details(), req.Identity)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	details, err := crud.ReadFunc(ctx, crud.Details().Client, id)
+	if resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, &resp.State, err)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	crud.setState(ctx, details, &model, &resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (crud *ResourceCRUD[M, Req, Resp]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := crud.loadID(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := crud.encode(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating resource", tfext.NewLogFields().
+		JSON("payload", payload).
+		Field("id", id),
+	)
+
+	details, err := crud.UpdateFunc(ctx, crud.Details().Client, id, payload)
+	if resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, &resp.State, err)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	crud.setState(ctx, details, &model, &resp.State, resp.Identity, &resp.Diagnostics)
+}
+
+func (crud *ResourceCRUD[M, Req, Resp]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	id := crud.loadID(ctx, req.State, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := crud.DeleteFunc(ctx, crud.Details().Client, id)
+	resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, &resp.State, err)...)
+}
+
+func (crud *ResourceCRUD[M, Req, Resp]) encode(ctx context.Context, model *M, diags *diag.Diagnostics) *Req {
+	payload, issues := crud.Encode(ctx, model)
+	if diags.Append(issues...); diags.HasError() {
+		return nil
+	}
+	if crud.Merge != nil {
+		crud.Merge(ctx, crud.Details(), payload)
+	}
+	return payload
+}
+
+func (crud *ResourceCRUD[M, Req, Resp]) loadID(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics) string {
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	return id.ValueString()
+}
+
+// setState stores the API response into the state and identity, when the response is empty
+// then the resource is considered to be removed and is cleared from the state.
+func (crud *ResourceCRUD[M, Req, Resp]) setState(ctx context.Context, details *Resp, model *M, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	if details == nil {
+		state.RemoveResource(ctx)
+		return
+	}
+
+	if diags.Append(crud.Decode(ctx, details, model)...); diags.HasError() {
+		return
+	}
+
+	if diags.Append(state.Set(ctx, model)...); diags.HasError() {
+		return
+	}
+
+	id := crud.loadID(ctx, *state, diags)
+	if diags.Append(SetResourceIdentity(ctx, crud.Details(), identity, id)...); diags.HasError() || crud.AppPath == "" {
+		return
+	}
+
+	diags.Append(state.SetAttribute(
+		ctx,
+		path.Root("url"),
+		pmeta.LoadApplicationURL(ctx, crud.Details(), crud.AppPath, id, "edit"),
+	)...)
+}
@@ -0,0 +1,177 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwembed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/signalfx/signalfx-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+type testCRUDModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	URL  types.String `tfsdk:"url"`
+}
+
+type testCRUDObject struct {
+	Id   string
+	Name string
+	Tags []string
+}
+
+var testCRUDSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Computed: true},
+		"name": schema.StringAttribute{Required: true},
+		"url":  schema.StringAttribute{Computed: true},
+	},
+}
+
+func newTestCRUD(t *testing.T, objects map[string]*testCRUDObject) *ResourceCRUD[testCRUDModel, testCRUDObject, testCRUDObject] {
+	crud := &ResourceCRUD[testCRUDModel, testCRUDObject, testCRUDObject]{
+		AppPath: "/example",
+		Encode: func(_ context.Context, model *testCRUDModel) (*testCRUDObject, diag.Diagnostics) {
+			return &testCRUDObject{Name: model.Name.ValueString()}, nil
+		},
+		Decode: func(_ context.Context, obj *testCRUDObject, model *testCRUDModel) diag.Diagnostics {
+			model.Id = types.StringValue(obj.Id)
+			model.Name = types.StringValue(obj.Name)
+			return nil
+		},
+		Merge: func(_ context.Context, meta *pmeta.Meta, req *testCRUDObject) {
+			req.Tags = append(req.Tags, meta.Tags...)
+		},
+		CreateFunc: func(_ context.Context, _ *signalfx.Client, req *testCRUDObject) (*testCRUDObject, error) {
+			req.Id = "id-01"
+			objects[req.Id] = req
+			return req, nil
+		},
+		ReadFunc: func(_ context.Context, _ *signalfx.Client, id string) (*testCRUDObject, error) {
+			return objects[id], nil
+		},
+		UpdateFunc: func(_ context.Context, _ *signalfx.Client, id string, req *testCRUDObject) (*testCRUDObject, error) {
+			if _, ok := objects[id]; !ok {
+				return nil, errors.New("not found")
+			}
+			req.Id = id
+			objects[id] = req
+			return req, nil
+		},
+		DeleteFunc: func(_ context.Context, _ *signalfx.Client, id string) error {
+			delete(objects, id)
+			return nil
+		},
+	}
+
+	resp := &resource.ConfigureResponse{}
+	crud.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &pmeta.Meta{
+			CustomAppURL: "http://example.com",
+			Tags:         []string{"provider-tag"},
+		},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Must not error configuring resource")
+
+	return crud
+}
+
+func newTestCRUDValue(id, name string) tftypes.Value {
+	values := map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name": tftypes.NewValue(tftypes.String, name),
+		"url":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	}
+	if id != "" {
+		values["id"] = tftypes.NewValue(tftypes.String, id)
+		values["url"] = tftypes.NewValue(tftypes.String, "http://example.com/#/example/"+id+"/edit")
+	}
+	return tftypes.NewValue(testCRUDSchema.Type().TerraformType(context.Background()), values)
+}
+
+func TestResourceCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objects := map[string]*testCRUDObject{}
+	crud := newTestCRUD(t, objects)
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: testCRUDSchema}}
+	crud.Create(ctx, resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: testCRUDSchema, Raw: newTestCRUDValue("", "example")},
+	}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), "Must not error creating resource: %v", createResp.Diagnostics)
+
+	var model testCRUDModel
+	require.False(t, createResp.State.Get(ctx, &model).HasError(), "Must be able to read state")
+	assert.Equal(t, testCRUDModel{
+		Id:   types.StringValue("id-01"),
+		Name: types.StringValue("example"),
+		URL:  types.StringValue("http://example.com/#/example/id-01/edit"),
+	}, model, "Must match the expected state")
+	assert.Equal(t, []string{"provider-tag"}, objects["id-01"].Tags, "Must have merged provider values")
+
+	updateResp := &resource.UpdateResponse{State: createResp.State}
+	crud.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: testCRUDSchema, Raw: newTestCRUDValue("id-01", "updated")},
+		State: createResp.State,
+	}, updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), "Must not error updating resource: %v", updateResp.Diagnostics)
+	assert.Equal(t, "updated", objects["id-01"].Name, "Must have updated the object")
+
+	readResp := &resource.ReadResponse{State: updateResp.State}
+	crud.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "Must not error reading resource: %v", readResp.Diagnostics)
+	require.False(t, readResp.State.Get(ctx, &model).HasError(), "Must be able to read state")
+	assert.Equal(t, "updated", model.Name.ValueString(), "Must match the updated name")
+
+	deleteResp := &resource.DeleteResponse{State: readResp.State}
+	crud.Delete(ctx, resource.DeleteRequest{State: readResp.State}, deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), "Must not error deleting resource: %v", deleteResp.Diagnostics)
+	assert.Empty(t, objects, "Must have removed the object")
+}
+
+func TestResourceCRUDReadRemoved(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	crud := newTestCRUD(t, map[string]*testCRUDObject{})
+
+	state := tfsdk.State{Schema: testCRUDSchema, Raw: newTestCRUDValue("id-01", "example")}
+	resp := &resource.ReadResponse{State: state}
+	crud.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "Must not error when the resource is removed")
+	assert.True(t, resp.State.Raw.IsNull(), "Must have removed the resource from state")
+}
+
+func TestResourceCRUDUpdateFailed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	crud := newTestCRUD(t, map[string]*testCRUDObject{})
+
+	state := tfsdk.State{Schema: testCRUDSchema, Raw: newTestCRUDValue("id-01", "example")}
+	resp := &resource.UpdateResponse{State: state}
+	crud.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: testCRUDSchema, Raw: newTestCRUDValue("id-01", "updated")},
+		State: state,
+	}, resp)
+
+	assert.Equal(t, diag.Diagnostics{
+		diag.NewErrorDiagnostic("Issue handling request", "not found"),
+	}, resp.Diagnostics, "Must report the update issue")
+}
@@ -11,10 +11,21 @@ import (
 )
 
 // ResourceIDImporter is an embedable type that will
-// enable the resource to be imported by using the provided ID to fetch from the API.
-// It implements the additional method required by [resource.ResourceWithImportState].
+// enable the resource to be imported by using the provided ID or identity to fetch from the API.
+// It implements the additional methods required by [resource.ResourceWithImportState]
+// and [resource.ResourceWithIdentity], so the resource must set the identity as part of its operations.
 type ResourceIDImporter struct{}
 
 func (ResourceIDImporter) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The identity is only provided by Terraform 1.12+,
+	// so older versions must be imported using the ID only.
+	if req.Identity == nil {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (ResourceIDImporter) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = NewResourceIdentitySchema()
 }
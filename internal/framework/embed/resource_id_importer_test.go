@@ -19,6 +19,8 @@ import (
 func TestResourceIDImporter_ImportState(t *testing.T) {
 	t.Parallel()
 
+	identity := NewResourceIdentitySchema()
+
 	for _, tc := range []struct {
 		name   string
 		req    resource.ImportStateRequest
@@ -46,6 +48,29 @@ func TestResourceIDImporter_ImportState(t *testing.T) {
 				},
 			),
 		},
+		{
+			name: "request set identity",
+			req: resource.ImportStateRequest{
+				Identity: &tfsdk.ResourceIdentity{
+					Schema: identity,
+					Raw: tftypes.NewValue(identity.Type().TerraformType(context.TODO()), map[string]tftypes.Value{
+						"id":              tftypes.NewValue(tftypes.String, "test-id"),
+						"realm":           tftypes.NewValue(tftypes.String, "us0"),
+						"organization_id": tftypes.NewValue(tftypes.String, nil),
+					}),
+				},
+			},
+			data: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+		},
 		{
 			name: "No data set",
 			req: resource.ImportStateRequest{
@@ -0,0 +1,86 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwembed
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+// ResourceIdentityModel is the identity shared by all resources,
+// it matches the identity used by the SDKv2 resources so that both can be imported the same way.
+type ResourceIdentityModel struct {
+	Id             types.String `tfsdk:"id"`
+	Realm          types.String `tfsdk:"realm"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+// NewResourceIdentitySchema returns the identity schema shared by all resources.
+func NewResourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			pmeta.IdentityFieldID: identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the resource",
+			},
+			pmeta.IdentityFieldRealm: identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The realm the resource belongs to",
+			},
+			pmeta.IdentityFieldOrganizationID: identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The organization ID the resource belongs to",
+			},
+		},
+	}
+}
+
+// ValidateResourceIdentity ensures the stored identity belongs to the configured provider.
+func ValidateResourceIdentity(ctx context.Context, meta *pmeta.Meta, identity *tfsdk.ResourceIdentity) (diags diag.Diagnostics) {
+	if identity == nil || identity.Raw.IsNull() {
+		return nil
+	}
+
+	var model ResourceIdentityModel
+	if diags.Append(identity.Get(ctx, &model)...); diags.HasError() {
+		return diags
+	}
+
+	if err := pmeta.ValidateIdentity(ctx, meta, model.Realm.ValueString(), model.OrganizationID.ValueString()); err != nil {
+		diags.AddError("Invalid resource identity", err.Error())
+	}
+	return diags
+}
+
+// SetResourceIdentity stores the identity for the provided resource id.
+// The stored realm is kept when it can not be determined from the provider
+// so that changing the form of the API URL does not change the identity.
+func SetResourceIdentity(ctx context.Context, meta *pmeta.Meta, identity *tfsdk.ResourceIdentity, id string) (diags diag.Diagnostics) {
+	if identity == nil || id == "" {
+		return nil
+	}
+
+	realm := types.StringNull()
+	if v := pmeta.LoadRealm(ctx, meta); v != "" {
+		realm = types.StringValue(v)
+	} else if !identity.Raw.IsNull() {
+		var prior ResourceIdentityModel
+		if diags.Append(identity.Get(ctx, &prior)...); diags.HasError() {
+			return diags
+		}
+		realm = prior.Realm
+	}
+
+	return append(diags, identity.Set(ctx, &ResourceIdentityModel{
+		Id:             types.StringValue(id),
+		Realm:          realm,
+		OrganizationID: types.StringValue(pmeta.LoadOrganizationID(ctx, meta)),
+	})...)
+}
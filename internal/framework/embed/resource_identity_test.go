@@ -0,0 +1,127 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwembed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+func newTestResourceIdentity(id, realm, orgID any) *tfsdk.ResourceIdentity {
+	s := NewResourceIdentitySchema()
+	return &tfsdk.ResourceIdentity{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"id":              tftypes.NewValue(tftypes.String, id),
+			"realm":           tftypes.NewValue(tftypes.String, realm),
+			"organization_id": tftypes.NewValue(tftypes.String, orgID),
+		}),
+	}
+}
+
+func TestValidateResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	meta := &pmeta.Meta{APIURL: "https://api.us1.signalfx.com", OrganizationID: "org-01"}
+
+	for _, tc := range []struct {
+		name     string
+		identity *tfsdk.ResourceIdentity
+		expect   diag.Diagnostics
+	}{
+		{
+			name:     "no identity",
+			identity: nil,
+		},
+		{
+			name:     "matching identity",
+			identity: newTestResourceIdentity("id-01", "us1", "org-01"),
+		},
+		{
+			name:     "partial identity",
+			identity: newTestResourceIdentity("id-01", nil, nil),
+		},
+		{
+			name:     "different realm",
+			identity: newTestResourceIdentity("id-01", "eu0", "org-01"),
+			expect: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid resource identity", `resource identity realm "eu0" does not match the provider realm "us1"`),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, ValidateResourceIdentity(context.Background(), meta, tc.identity), "Must match the expected diagnostics")
+		})
+	}
+}
+
+func TestSetResourceIdentity(t *testing.T) {
+	t.Parallel()
+
+	meta := &pmeta.Meta{APIURL: "https://api.us1.signalfx.com", OrganizationID: "org-01"}
+	identity := newTestResourceIdentity(nil, nil, nil)
+
+	require.Empty(t, SetResourceIdentity(context.Background(), meta, identity, "id-01"), "Must not error setting identity")
+
+	var model ResourceIdentityModel
+	require.Empty(t, identity.Get(context.Background(), &model), "Must not error reading identity")
+	assert.Equal(t, ResourceIdentityModel{
+		Id:             types.StringValue("id-01"),
+		Realm:          types.StringValue("us1"),
+		OrganizationID: types.StringValue("org-01"),
+	}, model, "Must match the expected identity")
+
+	assert.Empty(t, SetResourceIdentity(context.Background(), meta, nil, "id-01"), "Must ignore resources without identity")
+}
+
+func TestSetResourceIdentityUnknownRealm(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		meta     *pmeta.Meta
+		identity *tfsdk.ResourceIdentity
+		expect   types.String
+	}{
+		{
+			name:     "new resource",
+			meta:     &pmeta.Meta{APIURL: "http://localhost:8080"},
+			identity: newTestResourceIdentity(nil, nil, nil),
+			expect:   types.StringNull(),
+		},
+		{
+			name:     "keeps stored realm",
+			meta:     &pmeta.Meta{APIURL: "http://localhost:8080"},
+			identity: newTestResourceIdentity("id-01", "us1", nil),
+			expect:   types.StringValue("us1"),
+		},
+		{
+			name:     "configured realm",
+			meta:     &pmeta.Meta{APIURL: "http://localhost:8080", Realm: "eu0"},
+			identity: newTestResourceIdentity(nil, nil, nil),
+			expect:   types.StringValue("eu0"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Empty(t, SetResourceIdentity(context.Background(), tc.meta, tc.identity, "id-01"), "Must not error setting identity")
+
+			var model ResourceIdentityModel
+			require.Empty(t, tc.identity.Get(context.Background(), &model), "Must not error reading identity")
+			assert.Equal(t, tc.expect, model.Realm, "Must match the expected realm")
+		})
+	}
+}
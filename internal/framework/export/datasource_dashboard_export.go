@@ -0,0 +1,91 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwexport
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DashboardExportDataSource struct {
+	decode Decoder
+}
+
+type dashboardExportDataSourceModel struct {
+	Path      types.String  `tfsdk:"path"`
+	Dashboard types.Dynamic `tfsdk:"dashboard"`
+	Charts    types.Dynamic `tfsdk:"charts"`
+}
+
+var _ datasource.DataSource = (*DashboardExportDataSource)(nil)
+
+// NewDashboardExportDataSource returns the data source that reads the dashboard JSON exported from the UI,
+// using the decoder to convert it into the resource arguments.
+func NewDashboardExportDataSource(decode Decoder) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &DashboardExportDataSource{decode: decode}
+	}
+}
+
+func (de *DashboardExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_export"
+}
+
+func (de *DashboardExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads a dashboard exported as JSON from the UI and converts it into the arguments of the `signalfx_dashboard` and chart resources.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Description: "Path to the exported dashboard JSON file.",
+				Required:    true,
+			},
+			"dashboard": schema.DynamicAttribute{
+				Description: "Arguments of the `signalfx_dashboard` resource. The charts are referred to by their exported ID.",
+				Computed:    true,
+			},
+			"charts": schema.DynamicAttribute{
+				Description: "Charts keyed by their exported ID. Each chart has the `resource` type that manages it, and the `arguments` of that resource.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (de *DashboardExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dashboardExportDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	data, err := os.ReadFile(model.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Unable to Read Dashboard Export", err.Error())
+		return
+	}
+
+	values, err := de.decode(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Unable to Decode Dashboard Export", err.Error())
+		return
+	}
+
+	dash, _ := values["dashboard"].(map[string]any)
+	if model.Dashboard, err = NewDynamicValue(ctx, dash); err != nil {
+		resp.Diagnostics.AddError("Unable to Convert Dashboard", err.Error())
+		return
+	}
+
+	charts, _ := values["charts"].(map[string]any)
+	if model.Charts, err = NewDynamicValue(ctx, charts); err != nil {
+		resp.Diagnostics.AddError("Unable to Convert Charts", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
@@ -0,0 +1,101 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwexport
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDashboardExportDataSourceMetadata(t *testing.T) {
+	t.Parallel()
+
+	var resp datasource.MetadataResponse
+	NewDashboardExportDataSource(nil)().Metadata(t.Context(), datasource.MetadataRequest{ProviderTypeName: "signalfx"}, &resp)
+
+	assert.Equal(t, "signalfx_dashboard_export", resp.TypeName, "Must match the expected name")
+}
+
+func TestDashboardExportDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "export.json"), []byte(`{"name": "Hosts"}`), 0o600))
+
+	decoder := func(data []byte) (map[string]any, error) {
+		if string(data) != `{"name": "Hosts"}` {
+			return nil, errors.New("invalid dashboard export")
+		}
+		return map[string]any{
+			"dashboard": map[string]any{"name": "Hosts"},
+			"charts": map[string]any{
+				"chart-01": map[string]any{"resource": "signalfx_text_chart"},
+			},
+		}, nil
+	}
+
+	for _, tc := range []struct {
+		name   string
+		path   string
+		errors int
+	}{
+		{name: "missing file", path: filepath.Join(dir, "missing.json"), errors: 1},
+		{name: "valid export", path: filepath.Join(dir, "export.json")},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ds := NewDashboardExportDataSource(decoder)()
+
+			var schemaResp datasource.SchemaResponse
+			ds.Schema(t.Context(), datasource.SchemaRequest{}, &schemaResp)
+			require.False(t, schemaResp.Diagnostics.HasError(), "Must have a valid schema")
+
+			objType := schemaResp.Schema.Type().TerraformType(t.Context())
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+						"path":      tftypes.NewValue(tftypes.String, tc.path),
+						"dashboard": tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+						"charts":    tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+					}),
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objType, nil),
+				},
+			}
+
+			ds.Read(t.Context(), req, resp)
+			require.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors: %v", resp.Diagnostics)
+			if tc.errors > 0 {
+				return
+			}
+
+			var model dashboardExportDataSourceModel
+			require.False(t, resp.State.Get(t.Context(), &model).HasError(), "Must read the state")
+			assert.Equal(t, types.StringValue(tc.path), model.Path, "Must keep the configured path")
+
+			dash, _ := NewDynamicValue(t.Context(), map[string]any{"name": "Hosts"})
+			assert.True(t, dash.Equal(model.Dashboard), "Must set the dashboard arguments: %s", model.Dashboard)
+
+			charts, _ := NewDynamicValue(t.Context(), map[string]any{
+				"chart-01": map[string]any{"resource": "signalfx_text_chart"},
+			})
+			assert.True(t, charts.Equal(model.Charts), "Must set the charts: %s", model.Charts)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwexport
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/chart"
+
+	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+type DashboardSnapshotDataSource struct {
+	fwembed.DatasourceData
+}
+
+type dashboardSnapshotDataSourceModel struct {
+	DashboardID types.String `tfsdk:"dashboard_id"`
+	JSON        types.String `tfsdk:"json"`
+	References  types.Map    `tfsdk:"references"`
+}
+
+var (
+	_ datasource.DataSource              = (*DashboardSnapshotDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*DashboardSnapshotDataSource)(nil)
+)
+
+func NewDashboardSnapshotDataSource() datasource.DataSource {
+	return &DashboardSnapshotDataSource{}
+}
+
+func (ds *DashboardSnapshotDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_snapshot"
+}
+
+func (ds *DashboardSnapshotDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads an existing dashboard along with its charts and returns a portable JSON document, " +
+			"using the same format as the dashboards exported from the UI. " +
+			"IDs that are specific to the organization are replaced with symbolic references so the dashboard can be recreated in another organization.",
+		Attributes: map[string]schema.Attribute{
+			"dashboard_id": schema.StringAttribute{
+				Description: "ID of the dashboard to read.",
+				Required:    true,
+			},
+			"json": schema.StringAttribute{
+				Description: "The dashboard, its charts, variables, filters and event overlays as a JSON document that can be read by `signalfx_dashboard_export` or `provider::signalfx::decode_dashboard_export`.",
+				Computed:    true,
+			},
+			"references": schema.MapAttribute{
+				Description: "Map of the symbolic references used within the document to the IDs they replaced, such as `chart_1`, `dashboard_group`, `detector_1` or `team_1`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (ds *DashboardSnapshotDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dashboardSnapshotDataSourceModel
+	if resp.Diagnostics.Append(req.Config.Get(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := pmeta.LoadClient(ctx, ds.Details())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Load Client", err.Error())
+		return
+	}
+
+	dash, err := client.GetDashboard(ctx, model.DashboardID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("dashboard_id"), "Unable to Read Dashboard", err.Error())
+		return
+	}
+
+	charts := make(map[string]*chart.Chart, len(dash.Charts))
+	for _, c := range dash.Charts {
+		if charts[c.ChartId], err = client.GetChart(ctx, c.ChartId); err != nil {
+			resp.Diagnostics.AddError("Unable to Read Chart", err.Error())
+			return
+		}
+	}
+
+	doc, refs, err := NewDashboardSnapshot(dash, charts)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Dashboard Snapshot", err.Error())
+		return
+	}
+
+	references, diags := types.MapValueFrom(ctx, types.StringType, refs)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.JSON = types.StringValue(string(doc))
+	model.References = references
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
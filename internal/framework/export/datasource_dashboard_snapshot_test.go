@@ -0,0 +1,97 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwexport
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func TestDashboardSnapshotDataSourceMetadata(t *testing.T) {
+	t.Parallel()
+
+	var resp datasource.MetadataResponse
+	NewDashboardSnapshotDataSource().Metadata(t.Context(), datasource.MetadataRequest{ProviderTypeName: "signalfx"}, &resp)
+
+	assert.Equal(t, "signalfx_dashboard_snapshot", resp.TypeName, "Must match the expected name")
+}
+
+func TestDashboardSnapshotDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	dash, charts := newTestSnapshotDashboard()
+	expect, _, err := NewDashboardSnapshot(dash, charts)
+	require.NoError(t, err, "Must create the expected snapshot")
+
+	for _, tc := range []struct {
+		name   string
+		id     string
+		errors int
+	}{
+		{name: "missing dashboard", id: "missing", errors: 1},
+		{name: "existing dashboard", id: "AAAAAAAA"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+				"GET /v2/dashboard/AAAAAAAA": func(w http.ResponseWriter, _ *http.Request) {
+					_ = json.NewEncoder(w).Encode(dash)
+				},
+				"GET /v2/dashboard/missing": func(w http.ResponseWriter, _ *http.Request) {
+					http.Error(w, "not found", http.StatusNotFound)
+				},
+				"GET /v2/chart/{id}": func(w http.ResponseWriter, r *http.Request) {
+					_ = json.NewEncoder(w).Encode(charts[r.PathValue("id")])
+				},
+			})(t)
+
+			ds := NewDashboardSnapshotDataSource()
+			var configResp datasource.ConfigureResponse
+			ds.(datasource.DataSourceWithConfigure).Configure(t.Context(), datasource.ConfigureRequest{ProviderData: meta}, &configResp)
+			require.False(t, configResp.Diagnostics.HasError(), "Must configure the data source")
+
+			var schemaResp datasource.SchemaResponse
+			ds.Schema(t.Context(), datasource.SchemaRequest{}, &schemaResp)
+
+			objType := schemaResp.Schema.Type().TerraformType(t.Context())
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Schema: schemaResp.Schema,
+					Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+						"dashboard_id": tftypes.NewValue(tftypes.String, tc.id),
+						"json":         tftypes.NewValue(tftypes.String, nil),
+						"references":   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					}),
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(objType, nil),
+				},
+			}
+
+			ds.Read(t.Context(), req, resp)
+			require.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors: %v", resp.Diagnostics)
+			if tc.errors > 0 {
+				return
+			}
+
+			var model dashboardSnapshotDataSourceModel
+			require.False(t, resp.State.Get(t.Context(), &model).HasError(), "Must read the state")
+			assert.Equal(t, string(expect), model.JSON.ValueString(), "Must match the expected document")
+			assert.Len(t, model.References.Elements(), 9, "Must include the references")
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwexport
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Decoder converts an exported object into plain values,
+// using maps for objects and slices for lists.
+type Decoder func(data []byte) (map[string]any, error)
+
+// NewDynamicValue converts the decoded values into a dynamic value.
+// Objects are converted into object values and lists into tuples,
+// so that the values within them are not required to share the same type.
+func NewDynamicValue(ctx context.Context, values map[string]any) (types.Dynamic, error) {
+	v, err := newValue(ctx, values)
+	if err != nil {
+		return types.DynamicNull(), err
+	}
+	return types.DynamicValue(v), nil
+}
+
+func newValue(ctx context.Context, v any) (attr.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return types.StringNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case int:
+		return types.NumberValue(big.NewFloat(float64(v))), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for k, item := range v {
+			value, err := newValue(ctx, item)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			attrTypes[k], attrs[k] = value.Type(ctx), value
+		}
+		obj, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("invalid object: %v", diags)
+		}
+		return obj, nil
+	case []any:
+		elemTypes := make([]attr.Type, len(v))
+		elems := make([]attr.Value, len(v))
+		for i, item := range v {
+			value, err := newValue(ctx, item)
+			if err != nil {
+				return nil, fmt.Errorf("%d: %w", i, err)
+			}
+			elemTypes[i], elems[i] = value.Type(ctx), value
+		}
+		tuple, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("invalid list: %v", diags)
+		}
+		return tuple, nil
+	}
+	return nil, fmt.Errorf("unsupported value type %T", v)
+}
@@ -0,0 +1,107 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwexport
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		values map[string]any
+		expect types.Dynamic
+		errVal string
+	}{
+		{
+			name:   "empty",
+			values: map[string]any{},
+			expect: types.DynamicValue(types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{})),
+		},
+		{
+			name: "scalar values",
+			values: map[string]any{
+				"name":    "Hosts",
+				"width":   6,
+				"ratio":   0.5,
+				"enabled": true,
+				"unset":   nil,
+			},
+			expect: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"name":    types.StringType,
+					"width":   types.NumberType,
+					"ratio":   types.NumberType,
+					"enabled": types.BoolType,
+					"unset":   types.StringType,
+				},
+				map[string]attr.Value{
+					"name":    types.StringValue("Hosts"),
+					"width":   types.NumberValue(big.NewFloat(6)),
+					"ratio":   types.NumberValue(big.NewFloat(0.5)),
+					"enabled": types.BoolValue(true),
+					"unset":   types.StringNull(),
+				},
+			)),
+		},
+		{
+			name: "nested values",
+			values: map[string]any{
+				"chart": []any{
+					map[string]any{"chart_id": "chart-01"},
+					"text",
+				},
+			},
+			expect: types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"chart": types.TupleType{ElemTypes: []attr.Type{
+						types.ObjectType{AttrTypes: map[string]attr.Type{"chart_id": types.StringType}},
+						types.StringType,
+					}},
+				},
+				map[string]attr.Value{
+					"chart": types.TupleValueMust(
+						[]attr.Type{
+							types.ObjectType{AttrTypes: map[string]attr.Type{"chart_id": types.StringType}},
+							types.StringType,
+						},
+						[]attr.Value{
+							types.ObjectValueMust(
+								map[string]attr.Type{"chart_id": types.StringType},
+								map[string]attr.Value{"chart_id": types.StringValue("chart-01")},
+							),
+							types.StringValue("text"),
+						},
+					),
+				},
+			)),
+		},
+		{
+			name:   "unsupported value",
+			values: map[string]any{"chart": []any{struct{}{}}},
+			errVal: "chart: 0: unsupported value type struct {}",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := NewDynamicValue(t.Context(), tc.values)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must return the expected error")
+				assert.True(t, actual.IsNull(), "Must return a null value on error")
+				return
+			}
+			require.NoError(t, err, "Must not error converting values")
+			assert.True(t, tc.expect.Equal(actual), "Must match the expected value: %s", actual)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwexport
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+)
+
+// snapshotMetadata are the fields that are set by the API
+// and only apply to the organization the object was read from.
+var snapshotMetadata = []string{"created", "creator", "lastUpdated", "lastUpdatedBy"}
+
+// snapshotIDFields are the fields that hold organization specific IDs,
+// which are the only values that are replaced with symbolic references.
+var snapshotIDFields = []string{"id", "groupId", "chartId", "sloId", "detectorId", "parent", "principalId", "teams", "users"}
+
+// snapshotReferences assigns a symbolic reference to each of the organization specific IDs.
+type snapshotReferences struct {
+	symbols map[string]string
+	counts  map[string]int
+}
+
+func (sr *snapshotReferences) add(kind, id string) {
+	if id == "" {
+		return
+	}
+	if _, exist := sr.symbols[id]; exist {
+		return
+	}
+	sr.counts[kind]++
+	sr.symbols[id] = fmt.Sprintf("%s_%d", kind, sr.counts[kind])
+}
+
+// NewDashboardSnapshot converts the dashboard and its charts into the same JSON format
+// that is exported from the UI, so that it can be read by the dashboard export data source.
+// All IDs that are specific to the organization are replaced with symbolic references,
+// and the returned references map each symbol to the ID it replaced.
+// Charts are ordered by their position within the dashboard so the document is stable between reads.
+func NewDashboardSnapshot(dash *dashboard.Dashboard, charts map[string]*chart.Chart) ([]byte, map[string]string, error) {
+	refs := &snapshotReferences{
+		symbols: map[string]string{dash.Id: "dashboard"},
+		counts:  make(map[string]int),
+	}
+	if dash.GroupId != "" {
+		refs.symbols[dash.GroupId] = "dashboard_group"
+	}
+
+	layout := slices.Clone(dash.Charts)
+	slices.SortStableFunc(layout, func(a, b *dashboard.DashboardChart) int {
+		return cmp.Or(cmp.Compare(a.Row, b.Row), cmp.Compare(a.Column, b.Column))
+	})
+	snapshot := *dash
+	snapshot.Charts = layout
+
+	exports := make([]any, 0, len(layout))
+	for _, c := range layout {
+		refs.add("chart", c.ChartId)
+
+		ch, ok := charts[c.ChartId]
+		if !ok {
+			return nil, nil, fmt.Errorf("missing chart %q", c.ChartId)
+		}
+		refs.add("slo", ch.SloId)
+		exports = append(exports, map[string]any{"chart": ch})
+	}
+
+	for _, overlay := range slices.Concat(dash.EventOverlays, dash.SelectedEventOverlays) {
+		if overlay != nil && overlay.EventSignal != nil {
+			refs.add("detector", overlay.EventSignal.DetectorId)
+		}
+	}
+	if aw := dash.AuthorizedWriters; aw != nil {
+		for _, id := range aw.Teams {
+			refs.add("team", id)
+		}
+		for _, id := range aw.Users {
+			refs.add("user", id)
+		}
+	}
+	if p := dash.Permissions; p != nil {
+		for _, acl := range p.Acl {
+			if acl != nil {
+				refs.add(strings.ToLower(acl.PrincipalType), acl.PrincipalId)
+			}
+		}
+	}
+
+	// Converting the objects into generic values allows
+	// the references to be replaced wherever they are used.
+	var doc any
+	raw, err := json.Marshal(map[string]any{
+		"packageType":     "DASHBOARD",
+		"dashboardExport": map[string]any{"dashboard": &snapshot},
+		"chartExports":    exports,
+	})
+	if err == nil {
+		err = json.Unmarshal(raw, &doc)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc = refs.replace(doc, "")
+	removeSnapshotMetadata(doc.(map[string]any)["dashboardExport"].(map[string]any)["dashboard"])
+	for _, ce := range doc.(map[string]any)["chartExports"].([]any) {
+		removeSnapshotMetadata(ce.(map[string]any)["chart"])
+	}
+
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	references := make(map[string]string, len(refs.symbols))
+	for id, symbol := range refs.symbols {
+		references[symbol] = id
+	}
+	return out, references, nil
+}
+
+// replace walks the values and replaces any known ID held by an ID field with its symbol,
+// so that text which happens to match an ID is left as it is.
+// Null values are removed since they are the same as not being set.
+func (sr *snapshotReferences) replace(v any, field string) any {
+	switch v := v.(type) {
+	case string:
+		if !slices.Contains(snapshotIDFields, field) {
+			break
+		}
+		if symbol, ok := sr.symbols[v]; ok {
+			return symbol
+		}
+	case map[string]any:
+		for k, item := range v {
+			if item == nil {
+				delete(v, k)
+				continue
+			}
+			v[k] = sr.replace(item, k)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = sr.replace(item, field)
+		}
+	}
+	return v
+}
+
+func removeSnapshotMetadata(v any) {
+	if obj, ok := v.(map[string]any); ok {
+		for _, k := range snapshotMetadata {
+			delete(obj, k)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwexport
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSnapshotDashboard() (*dashboard.Dashboard, map[string]*chart.Chart) {
+	density := dashboard.HIGH
+	dash := &dashboard.Dashboard{
+		Id:            "AAAAAAAA",
+		Name:          "Hosts",
+		GroupId:       "BBBBBBBB",
+		ChartDensity:  &density,
+		Created:       1700000000000,
+		Creator:       "CCCCCCCC",
+		LastUpdatedBy: "CCCCCCCC",
+		Charts: []*dashboard.DashboardChart{
+			{ChartId: "DDDDDDDD", Row: 1, Column: 0, Width: 12, Height: 1},
+			{ChartId: "EEEEEEEE", Row: 0, Column: 6, Width: 6, Height: 1},
+			{ChartId: "FFFFFFFF", Row: 0, Column: 0, Width: 6, Height: 1},
+		},
+		Filters: &dashboard.ChartsFilters{
+			Variables: []*dashboard.ChartsWebUiFilter{
+				{Alias: "host", Property: "host.name", Value: []string{"web-01"}},
+			},
+		},
+		EventOverlays: []*dashboard.ChartEventOverlay{
+			{EventSignal: &dashboard.DashboardEventSignal{EventSearchText: "CPU", EventType: "detectorEvents", DetectorId: "GGGGGGGG"}},
+		},
+		SelectedEventOverlays: []*dashboard.ChartEventOverlay{
+			{EventSignal: &dashboard.DashboardEventSignal{EventSearchText: "CPU", EventType: "detectorEvents", DetectorId: "GGGGGGGG"}},
+		},
+		AuthorizedWriters: &dashboard.AuthorizedWriters{
+			Teams: []string{"HHHHHHHH"},
+		},
+		Permissions: &dashboard.ObjectPermissions{
+			Parent: "BBBBBBBB",
+			Acl: []*dashboard.AclEntry{
+				{PrincipalId: "HHHHHHHH", PrincipalType: "TEAM", Actions: []string{"READ", "WRITE"}},
+				{PrincipalId: "IIIIIIII", PrincipalType: "USER", Actions: []string{"READ"}},
+			},
+		},
+	}
+	charts := map[string]*chart.Chart{
+		"DDDDDDDD": {Id: "DDDDDDDD", Name: "Notes", Creator: "CCCCCCCC", Options: &chart.Options{Type: "Text", Markdown: "# Notes"}},
+		"EEEEEEEE": {Id: "EEEEEEEE", Name: "SLO", SloId: "JJJJJJJJ", Options: &chart.Options{Type: "SloChart"}},
+		"FFFFFFFF": {Id: "FFFFFFFF", Name: "CPU", ProgramText: "A = data('cpu.utilization').publish(label='A')", Options: &chart.Options{Type: "TimeSeriesChart"}},
+	}
+	return dash, charts
+}
+
+func TestNewDashboardSnapshot(t *testing.T) {
+	t.Parallel()
+
+	dash, charts := newTestSnapshotDashboard()
+
+	doc, refs, err := NewDashboardSnapshot(dash, charts)
+	require.NoError(t, err, "Must not error creating the snapshot")
+
+	assert.Equal(t, map[string]string{
+		"dashboard":       "AAAAAAAA",
+		"dashboard_group": "BBBBBBBB",
+		"chart_1":         "FFFFFFFF",
+		"chart_2":         "EEEEEEEE",
+		"chart_3":         "DDDDDDDD",
+		"slo_1":           "JJJJJJJJ",
+		"detector_1":      "GGGGGGGG",
+		"team_1":          "HHHHHHHH",
+		"user_1":          "IIIIIIII",
+	}, refs, "Must match the expected references")
+
+	for _, id := range refs {
+		assert.NotContains(t, string(doc), id, "Must not include organization specific IDs")
+	}
+	assert.NotContains(t, string(doc), "creator", "Must not include the object metadata")
+	assert.NotContains(t, string(doc), "CCCCCCCC", "Must not include the object metadata")
+
+	var actual struct {
+		PackageType     string `json:"packageType"`
+		DashboardExport struct {
+			Dashboard *dashboard.Dashboard `json:"dashboard"`
+		} `json:"dashboardExport"`
+		ChartExports []struct {
+			Chart *chart.Chart `json:"chart"`
+		} `json:"chartExports"`
+	}
+	require.NoError(t, json.Unmarshal(doc, &actual), "Must be a valid JSON document")
+
+	assert.Equal(t, "DASHBOARD", actual.PackageType, "Must use the dashboard export format")
+	assert.Equal(t, "dashboard_group", actual.DashboardExport.Dashboard.GroupId, "Must replace the dashboard group")
+	assert.Equal(t, "dashboard_group", actual.DashboardExport.Dashboard.Permissions.Parent, "Must replace the permission parent")
+	assert.Equal(t, []string{"team_1"}, actual.DashboardExport.Dashboard.AuthorizedWriters.Teams, "Must replace the teams")
+	assert.Equal(t, "detector_1", actual.DashboardExport.Dashboard.SelectedEventOverlays[0].EventSignal.DetectorId, "Must replace the detectors")
+	assert.Equal(t, "host.name", actual.DashboardExport.Dashboard.Filters.Variables[0].Property, "Must include the variables")
+
+	require.Len(t, actual.ChartExports, 3, "Must include all the charts")
+	for i, expect := range []struct{ id, name string }{{"chart_1", "CPU"}, {"chart_2", "SLO"}, {"chart_3", "Notes"}} {
+		assert.Equal(t, expect.id, actual.ChartExports[i].Chart.Id, "Must replace the chart id")
+		assert.Equal(t, expect.name, actual.ChartExports[i].Chart.Name, "Must order the charts by their position")
+		assert.Equal(t, expect.id, actual.DashboardExport.Dashboard.Charts[i].ChartId, "Must order the layout by position")
+	}
+	assert.Equal(t, "slo_1", actual.ChartExports[1].Chart.SloId, "Must replace the SLO")
+
+	assert.Equal(t, "DDDDDDDD", dash.Charts[0].ChartId, "Must not modify the dashboard")
+
+	again, _, err := NewDashboardSnapshot(dash, charts)
+	require.NoError(t, err, "Must not error creating the snapshot")
+	assert.Equal(t, string(doc), string(again), "Must produce the same document each time")
+}
+
+func TestNewDashboardSnapshotMissingChart(t *testing.T) {
+	t.Parallel()
+
+	dash, charts := newTestSnapshotDashboard()
+	delete(charts, "DDDDDDDD")
+
+	_, _, err := NewDashboardSnapshot(dash, charts)
+	assert.EqualError(t, err, `missing chart "DDDDDDDD"`, "Must report the missing chart")
+}
+
+func TestNewDashboardSnapshotOnlyReplacesIDs(t *testing.T) {
+	t.Parallel()
+
+	dash, charts := newTestSnapshotDashboard()
+	dash.Description = "GGGGGGGG"
+	charts["DDDDDDDD"].Options.Markdown = "AAAAAAAA"
+
+	doc, _, err := NewDashboardSnapshot(dash, charts)
+	require.NoError(t, err, "Must not error creating the snapshot")
+
+	var actual struct {
+		DashboardExport struct {
+			Dashboard *dashboard.Dashboard `json:"dashboard"`
+		} `json:"dashboardExport"`
+		ChartExports []struct {
+			Chart *chart.Chart `json:"chart"`
+		} `json:"chartExports"`
+	}
+	require.NoError(t, json.Unmarshal(doc, &actual), "Must be a valid JSON document")
+
+	assert.Equal(t, "GGGGGGGG", actual.DashboardExport.Dashboard.Description, "Must not replace text that matches an ID")
+	assert.Equal(t, "AAAAAAAA", actual.ChartExports[2].Chart.Options.Markdown, "Must not replace text that matches an ID")
+	assert.Equal(t, "dashboard", actual.DashboardExport.Dashboard.Id, "Must replace the dashboard id")
+}
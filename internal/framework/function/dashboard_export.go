@@ -0,0 +1,65 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internalfunction
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	fwexport "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/export"
+)
+
+type DashboardExportDecoder struct {
+	decode fwexport.Decoder
+}
+
+var _ function.Function = (*DashboardExportDecoder)(nil)
+
+// NewDashboardExportDecoder returns the function that converts the dashboard JSON exported from the UI,
+// using the decoder to convert it into the resource arguments.
+func NewDashboardExportDecoder(decode fwexport.Decoder) func() function.Function {
+	return func() function.Function {
+		return &DashboardExportDecoder{decode: decode}
+	}
+}
+
+func (DashboardExportDecoder) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "decode_dashboard_export"
+}
+
+func (DashboardExportDecoder) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Convert a dashboard exported from the UI into resource arguments",
+		Description: "Converts the JSON exported from the UI into an object with the `dashboard` arguments of the `signalfx_dashboard` resource, and the `charts` keyed by their exported ID with the `resource` type that manages each chart and its `arguments`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue: false,
+				Name:           "json",
+				Description:    "The dashboard JSON exported from the UI.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (de DashboardExportDecoder) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var data string
+	if resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &data)); resp.Error != nil {
+		return
+	}
+
+	values, err := de.decode([]byte(data))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := fwexport.NewDynamicValue(ctx, values)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,91 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internalfunction
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwexport "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/export"
+)
+
+func TestDashboardExportDecoder_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	NewDashboardExportDecoder(nil)().Metadata(t.Context(), function.MetadataRequest{}, resp)
+
+	assert.Equal(t, "decode_dashboard_export", resp.Name, "Function name must match")
+}
+
+func TestDashboardExportDecoder_Definition(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.DefinitionResponse{}
+	NewDashboardExportDecoder(nil)().Definition(t.Context(), function.DefinitionRequest{}, resp)
+
+	assert.NotEmpty(t, resp.Definition.Summary, "Must have a summary")
+	assert.Len(t, resp.Definition.Parameters, 1, "Must have one parameter")
+	assert.Equal(t, function.DynamicReturn{}, resp.Definition.Return, "Must return a dynamic value")
+}
+
+func TestDashboardExportDecoder_Run(t *testing.T) {
+	t.Parallel()
+
+	decoder := func(data []byte) (map[string]any, error) {
+		if string(data) != `{}` {
+			return nil, errors.New("invalid dashboard export")
+		}
+		return map[string]any{
+			"dashboard": map[string]any{"name": "Hosts"},
+			"charts":    map[string]any{},
+		}, nil
+	}
+
+	expect, err := fwexport.NewDynamicValue(t.Context(), map[string]any{
+		"dashboard": map[string]any{"name": "Hosts"},
+		"charts":    map[string]any{},
+	})
+	require.NoError(t, err, "Must create the expected value")
+
+	for _, tc := range []struct {
+		name   string
+		data   string
+		expect *function.RunResponse
+	}{
+		{
+			name: "invalid export",
+			data: `{`,
+			expect: &function.RunResponse{
+				Result: function.NewResultData(types.DynamicUnknown()),
+				Error:  function.NewArgumentFuncError(0, "invalid dashboard export"),
+			},
+		},
+		{
+			name: "valid export",
+			data: `{}`,
+			expect: &function.RunResponse{
+				Result: function.NewResultData(expect),
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.DynamicUnknown()),
+			}
+			NewDashboardExportDecoder(decoder)().Run(t.Context(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.data)}),
+			}, resp)
+			assert.Equal(t, tc.expect, resp, "Must match the expected response")
+		})
+	}
+}
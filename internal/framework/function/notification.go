@@ -0,0 +1,282 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internalfunction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/notification"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	notificationdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/notification"
+)
+
+// NotificationBuilder returns the notification string for a single notification type.
+// The arguments are validated and decoded the same way as the structured notification blocks,
+// and the string is formatted and parsed by the same functions used by the resources
+// so that the formats can not drift.
+type NotificationBuilder struct {
+	block  string
+	params []notificationParam
+}
+
+type notificationParam struct {
+	name        string
+	description string
+	list        bool
+}
+
+var _ function.Function = (*NotificationBuilder)(nil)
+
+var (
+	credentialParam = notificationParam{name: "credential_id", description: "The ID of the integration credential used to send the notification."}
+	teamParam       = notificationParam{name: "team_id", description: "The ID of the team to notify."}
+)
+
+// NewNotificationBuilders returns a function for each supported notification type,
+// named `notification_<type>` after the matching notification block.
+func NewNotificationBuilders() []func() function.Function {
+	var functions []func() function.Function
+	for _, block := range notificationdef.BlockNames() {
+		nb := &NotificationBuilder{
+			block:  block,
+			params: []notificationParam{credentialParam},
+		}
+		switch block {
+		case "email":
+			nb.params = []notificationParam{
+				{name: "email", description: "The email address to notify."},
+				{name: "cc", description: "Email addresses to copy on the notification.", list: true},
+				{name: "bcc", description: "Email addresses to blind copy on the notification.", list: true},
+			}
+		case "opsgenie":
+			nb.params = append(nb.params,
+				notificationParam{name: "responder_name", description: "The name of the Opsgenie responder."},
+				notificationParam{name: "responder_id", description: "The ID of the Opsgenie responder."},
+				notificationParam{name: "responder_type", description: "The type of the Opsgenie responder, must be one of: Team, User, Escalation, Schedule."},
+			)
+		case "slack":
+			nb.params = append(nb.params, notificationParam{name: "channel", description: "The Slack channel to notify, excluding the leading `#`."})
+		case "team", "team_email":
+			nb.params = []notificationParam{teamParam}
+		case "victorops":
+			nb.params = append(nb.params, notificationParam{name: "routing_key", description: "The Splunk On-Call routing key."})
+		case "webhook":
+			nb.params = []notificationParam{
+				{name: "credential_id", description: "The ID of the webhook integration credential, must be empty when `url` is set."},
+				{name: "secret", description: "The secret sent with the webhook when using `url`."},
+				{name: "url", description: "The URL of the webhook, must be empty when `credential_id` is set."},
+			}
+		}
+		functions = append(functions, func() function.Function { return nb })
+	}
+	return functions
+}
+
+func (nb *NotificationBuilder) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "notification_" + nb.block
+}
+
+func (nb *NotificationBuilder) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	params := make([]function.Parameter, 0, len(nb.params))
+	for _, p := range nb.params {
+		if p.list {
+			params = append(params, function.ListParameter{
+				ElementType:    types.StringType,
+				AllowNullValue: false,
+				Name:           p.name,
+				Description:    p.description,
+			})
+			continue
+		}
+		params = append(params, function.StringParameter{
+			AllowNullValue: false,
+			Name:           p.name,
+			Description:    p.description,
+		})
+	}
+
+	resp.Definition = function.Definition{
+		Summary:     fmt.Sprintf("Build the %s notification string", nb.block),
+		Description: "Validates the arguments and returns the notification string that can be used anywhere a notification string is accepted.",
+		Parameters:  params,
+		Return:      function.StringReturn{},
+	}
+}
+
+func (nb *NotificationBuilder) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	targets := make([]any, 0, len(nb.params))
+	for _, p := range nb.params {
+		if p.list {
+			targets = append(targets, new([]string))
+		} else {
+			targets = append(targets, new(string))
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, targets...))
+	if resp.Error != nil {
+		return
+	}
+
+	fields := make(map[string]any, len(nb.params))
+	for i, p := range nb.params {
+		switch v := targets[i].(type) {
+		case *string:
+			fields[p.name] = *v
+		case *[]string:
+			values := make([]any, 0, len(*v))
+			for _, s := range *v {
+				values = append(values, s)
+			}
+			fields[p.name] = values
+		}
+	}
+
+	if err := notificationdef.ValidateBlock(nb.block, fields); err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	items, err := notificationdef.DecodeTerraform([]any{
+		map[string]any{nb.block: []any{fields}},
+	})
+	if err != nil {
+		// Only a single notification is decoded so the index prefix is removed.
+		if inner := errors.Unwrap(err); inner != nil {
+			err = inner
+		}
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	str, err := common.NewNotificationStringFromAPI(items[0])
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	// Values containing the delimiter would be read back as a different notification,
+	// so the string is parsed again to ensure it round trips.
+	if parsed, err := common.NewNotificationFromString(str); err != nil || !roundTrips(parsed, str) {
+		resp.Error = function.NewFuncError(`notification arguments must not contain ","`)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, str)
+}
+
+func roundTrips(n *notification.Notification, str string) bool {
+	s, err := common.NewNotificationStringFromAPI(n)
+	return err == nil && s == str
+}
+
+// NotificationParser converts a notification string into an object
+// with the same attributes as the structured notification blocks.
+type NotificationParser struct{}
+
+var _ function.Function = (*NotificationParser)(nil)
+
+// notificationAttributes is the union of the attributes from every notification block,
+// attributes that are not used by the parsed notification type are set to null.
+var notificationAttributes = map[string]attr.Type{
+	"type":           types.StringType,
+	"credential_id":  types.StringType,
+	"channel":        types.StringType,
+	"email":          types.StringType,
+	"cc":             types.ListType{ElemType: types.StringType},
+	"bcc":            types.ListType{ElemType: types.StringType},
+	"responder_name": types.StringType,
+	"responder_id":   types.StringType,
+	"responder_type": types.StringType,
+	"routing_key":    types.StringType,
+	"secret":         types.StringType,
+	"team_id":        types.StringType,
+	"url":            types.StringType,
+}
+
+func NewNotificationParser() function.Function {
+	return &NotificationParser{}
+}
+
+func (NotificationParser) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_notification"
+}
+
+func (NotificationParser) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse a notification string into an object",
+		Description: "Parses the notification string and returns an object with the `type` set to the name of the matching notification block, and the attributes of that block. Attributes not used by the notification type are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				AllowNullValue: false,
+				Name:           "notification",
+				Description:    "The notification string to parse, for example `Slack,credential_id,channel`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: notificationAttributes,
+		},
+	}
+}
+
+func (NotificationParser) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var str string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &str))
+	if resp.Error != nil {
+		return
+	}
+
+	n, err := common.NewNotificationFromString(str)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	blocks, err := notificationdef.EncodeTerraform([]*notification.Notification{n})
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	values := make(map[string]attr.Value, len(notificationAttributes))
+	for name, t := range notificationAttributes {
+		if lt, ok := t.(types.ListType); ok {
+			values[name] = types.ListNull(lt.ElemType)
+		} else {
+			values[name] = types.StringNull()
+		}
+	}
+	for block, raw := range blocks[0] {
+		values["type"] = types.StringValue(block)
+		for name, v := range raw.([]any)[0].(map[string]any) {
+			switch v := v.(type) {
+			case string:
+				if v != "" {
+					values[name] = types.StringValue(v)
+				}
+			case []string:
+				if len(v) > 0 {
+					elems := make([]attr.Value, 0, len(v))
+					for _, s := range v {
+						elems = append(elems, types.StringValue(s))
+					}
+					values[name] = types.ListValueMust(types.StringType, elems)
+				}
+			}
+		}
+	}
+
+	obj, diags := types.ObjectValue(notificationAttributes, values)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, obj)
+}
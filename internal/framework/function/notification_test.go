@@ -0,0 +1,286 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package internalfunction
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	notificationdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/notification"
+)
+
+func newNotificationBuilder(t *testing.T, name string) function.Function {
+	t.Helper()
+
+	for _, fn := range NewNotificationBuilders() {
+		resp := &function.MetadataResponse{}
+		f := fn()
+		f.Metadata(t.Context(), function.MetadataRequest{}, resp)
+		if resp.Name == name {
+			return f
+		}
+	}
+	require.FailNow(t, "Unknown notification function", name)
+	return nil
+}
+
+func TestNotificationBuilder_Metadata(t *testing.T) {
+	t.Parallel()
+
+	var names []string
+	for _, fn := range NewNotificationBuilders() {
+		resp := &function.MetadataResponse{}
+		fn().Metadata(t.Context(), function.MetadataRequest{}, resp)
+		names = append(names, resp.Name)
+	}
+
+	var expect []string
+	for _, block := range notificationdef.BlockNames() {
+		expect = append(expect, "notification_"+block)
+	}
+	assert.Equal(t, expect, names, "Must define a function for each notification block")
+}
+
+func TestNotificationBuilder_Definition(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.DefinitionResponse{}
+	newNotificationBuilder(t, "notification_slack").Definition(t.Context(), function.DefinitionRequest{}, resp)
+
+	assert.Equal(t, "Build the slack notification string", resp.Definition.Summary, "Summary must match")
+	assert.Len(t, resp.Definition.Parameters, 2, "Must have two parameters")
+	assert.Nil(t, resp.Definition.VariadicParameter, "Must not have a variadic parameter")
+
+	resp = &function.DefinitionResponse{}
+	newNotificationBuilder(t, "notification_email").Definition(t.Context(), function.DefinitionRequest{}, resp)
+
+	assert.Len(t, resp.Definition.Parameters, 3, "Must have three parameters")
+	assert.IsType(t, function.ListParameter{}, resp.Definition.Parameters[1], "Must accept a list of cc addresses")
+	assert.IsType(t, function.ListParameter{}, resp.Definition.Parameters[2], "Must accept a list of bcc addresses")
+}
+
+func TestNotificationBuilder_Run(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		function string
+		args     []attr.Value
+		expect   string
+		errVal   string
+	}{
+		{
+			name:     "slack",
+			function: "notification_slack",
+			args:     []attr.Value{types.StringValue("cred"), types.StringValue("alerts")},
+			expect:   "Slack,cred,alerts",
+		},
+		{
+			name:     "email without cc",
+			function: "notification_email",
+			args:     []attr.Value{types.StringValue("oncall@example.com"), types.ListValueMust(types.StringType, nil), types.ListValueMust(types.StringType, nil)},
+			expect:   "Email,oncall@example.com",
+		},
+		{
+			name:     "email with cc",
+			function: "notification_email",
+			args: []attr.Value{
+				types.StringValue("oncall@example.com"),
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("lead@example.com")}),
+				types.ListValueMust(types.StringType, nil),
+			},
+			expect: "Email,oncall@example.com,lead@example.com,",
+		},
+		{
+			name:     "email with bcc",
+			function: "notification_email",
+			args: []attr.Value{
+				types.StringValue("oncall@example.com"),
+				types.ListValueMust(types.StringType, nil),
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("audit@example.com")}),
+			},
+			expect: "Email,oncall@example.com,,audit@example.com",
+		},
+		{
+			name:     "invalid cc",
+			function: "notification_email",
+			args: []attr.Value{
+				types.StringValue("oncall@example.com"),
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("lead")}),
+				types.ListValueMust(types.StringType, nil),
+			},
+			errVal: "cc.0: mail: missing '@' or angle-addr",
+		},
+		{
+			name:     "slack without credential",
+			function: "notification_slack",
+			args:     []attr.Value{types.StringValue(""), types.StringValue("alerts")},
+			errVal:   "credential_id must not be empty",
+		},
+		{
+			name:     "slack channel with hash",
+			function: "notification_slack",
+			args:     []attr.Value{types.StringValue("cred"), types.StringValue("#alerts")},
+			errVal:   `expected value of channel to not contain any of "#", got #alerts`,
+		},
+		{
+			name:     "opsgenie",
+			function: "notification_opsgenie",
+			args:     []attr.Value{types.StringValue("cred"), types.StringValue("ops"), types.StringValue("id"), types.StringValue("Team")},
+			expect:   "Opsgenie,cred,ops,id,Team",
+		},
+		{
+			name:     "opsgenie invalid responder type",
+			function: "notification_opsgenie",
+			args:     []attr.Value{types.StringValue("cred"), types.StringValue("ops"), types.StringValue("id"), types.StringValue("Group")},
+			errVal:   `expected responder_type to be one of ["Team" "User" "Escalation" "Schedule"], got Group`,
+		},
+		{
+			name:     "team",
+			function: "notification_team",
+			args:     []attr.Value{types.StringValue("team-01")},
+			expect:   "Team,team-01",
+		},
+		{
+			name:     "webhook url",
+			function: "notification_webhook",
+			args:     []attr.Value{types.StringValue(""), types.StringValue("s3cret"), types.StringValue("https://example.com/hook")},
+			expect:   "Webhook,,s3cret,https://example.com/hook",
+		},
+		{
+			name:     "webhook with credential and url",
+			function: "notification_webhook",
+			args:     []attr.Value{types.StringValue("cred"), types.StringValue(""), types.StringValue("https://example.com/hook")},
+			errVal:   "webhook must set only one of credential_id or url",
+		},
+		{
+			name:     "value containing delimiter",
+			function: "notification_slack",
+			args:     []attr.Value{types.StringValue("cred"), types.StringValue("alerts,ops")},
+			errVal:   `notification arguments must not contain ","`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+			newNotificationBuilder(t, tc.function).Run(t.Context(), function.RunRequest{
+				Arguments: function.NewArgumentsData(tc.args),
+			}, resp)
+
+			if tc.errVal != "" {
+				require.NotNil(t, resp.Error, "Must return an error")
+				assert.Equal(t, tc.errVal, resp.Error.Text, "Must match the expected error")
+				return
+			}
+			require.Nil(t, resp.Error, "Must not return an error")
+			assert.Equal(t, function.NewResultData(types.StringValue(tc.expect)), resp.Result, "Must match the expected string")
+
+			_, err := common.NewNotificationFromString(tc.expect)
+			assert.NoError(t, err, "Must be a valid notification string")
+		})
+	}
+}
+
+func TestNotificationParser_Metadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.MetadataResponse{}
+	NewNotificationParser().Metadata(t.Context(), function.MetadataRequest{}, resp)
+
+	assert.Equal(t, "parse_notification", resp.Name, "Function name must match")
+}
+
+func TestNotificationParser_Definition(t *testing.T) {
+	t.Parallel()
+
+	resp := &function.DefinitionResponse{}
+	NewNotificationParser().Definition(t.Context(), function.DefinitionRequest{}, resp)
+
+	assert.Equal(t, "Parse a notification string into an object", resp.Definition.Summary, "Summary must match")
+	assert.Len(t, resp.Definition.Parameters, 1, "Must have one parameter")
+	assert.Equal(t, function.ObjectReturn{AttributeTypes: notificationAttributes}, resp.Definition.Return, "Must return an object")
+}
+
+func TestNotificationParser_Run(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		str    string
+		expect map[string]attr.Value
+		errVal string
+	}{
+		{
+			name: "slack",
+			str:  "Slack,cred,alerts",
+			expect: map[string]attr.Value{
+				"type":          types.StringValue("slack"),
+				"credential_id": types.StringValue("cred"),
+				"channel":       types.StringValue("alerts"),
+			},
+		},
+		{
+			name: "email",
+			str:  "Email,oncall@example.com,lead@example.com",
+			expect: map[string]attr.Value{
+				"type":  types.StringValue("email"),
+				"email": types.StringValue("oncall@example.com"),
+				"cc":    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("lead@example.com")}),
+			},
+		},
+		{
+			name: "team email",
+			str:  "TeamEmail,team-01",
+			expect: map[string]attr.Value{
+				"type":    types.StringValue("team_email"),
+				"team_id": types.StringValue("team-01"),
+			},
+		},
+		{
+			name:   "invalid notification",
+			str:    "Carrier,pigeon",
+			errVal: "invalid notification type \"Carrier\"",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(notificationAttributes)),
+			}
+			NewNotificationParser().Run(t.Context(), function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tc.str)}),
+			}, resp)
+
+			if tc.errVal != "" {
+				require.NotNil(t, resp.Error, "Must return an error")
+				assert.Contains(t, resp.Error.Text, tc.errVal, "Must match the expected error")
+				return
+			}
+			require.Nil(t, resp.Error, "Must not return an error")
+
+			values := make(map[string]attr.Value, len(notificationAttributes))
+			for name, typ := range notificationAttributes {
+				values[name] = types.StringNull()
+				if lt, ok := typ.(types.ListType); ok {
+					values[name] = types.ListNull(lt.ElemType)
+				}
+			}
+			for name, v := range tc.expect {
+				values[name] = v
+			}
+			expect := types.ObjectValueMust(notificationAttributes, values)
+			assert.Equal(t, function.NewResultData(expect), resp.Result, "Must match the expected object")
+		})
+	}
+}
@@ -17,7 +17,7 @@ import (
 
 // ErrorHandler abstracts the required error handling logic for the framework API.
 // This will standardize how the error is returned to the user.
-func ErrorHandler(ctx context.Context, state tfsdk.State, err error) diag.Diagnostics {
+func ErrorHandler(ctx context.Context, state *tfsdk.State, err error) diag.Diagnostics {
 	if err == nil {
 		return nil
 	}
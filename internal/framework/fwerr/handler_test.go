@@ -6,12 +6,17 @@ package fwerr
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/signalfx/signalfx-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestErrorHandler(t *testing.T) {
@@ -42,8 +47,40 @@ func TestErrorHandler(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := ErrorHandler(context.TODO(), tfsdk.State{}, tt.err)
+			result := ErrorHandler(context.TODO(), &tfsdk.State{}, tt.err)
 			assert.Equal(t, tt.expected, result, "Must match expected diagnostics")
 		})
 	}
 }
+
+func TestErrorHandlerRemovesResource(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := signalfx.NewClient(t.Name(), signalfx.HTTPClient(srv.Client()), signalfx.APIUrl(srv.URL))
+	require.NoError(t, err, "Must not error creating client")
+
+	_, err = client.GetDetector(context.Background(), "id-01")
+	require.Error(t, err, "Must return an error for missing detector")
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+		},
+	}
+	state := &tfsdk.State{
+		Schema: s,
+		Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "id-01"),
+		}),
+	}
+
+	issues := ErrorHandler(context.Background(), state, err)
+	assert.False(t, issues.HasError(), "Must only warn about the removed resource")
+	assert.Equal(t, 1, issues.WarningsCount(), "Must warn about the removed resource")
+	assert.True(t, state.Raw.IsNull(), "Must have removed the resource from state")
+}
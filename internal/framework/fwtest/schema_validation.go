@@ -11,6 +11,7 @@ import (
 	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"go.uber.org/multierr"
@@ -33,6 +34,12 @@ func ResourceSchemaValidate(res resource.Resource, model any) (errs error) {
 		if wv.Err() != nil {
 			return wv.Err()
 		}
+		// Slices are walked using their first element, so they are matched
+		// against the attribute that defines the list instead.
+		if isListIndex(wv.Path()) {
+			expected[wv.Path().ParentPath().String()] = nil
+			continue
+		}
 		expected[wv.Path().String()] = wv.Attr()
 	}
 
@@ -68,3 +75,9 @@ func ResourceSchemaValidate(res resource.Resource, model any) (errs error) {
 
 	return errs
 }
+
+func isListIndex(p path.Path) bool {
+	step, _ := p.Steps().LastStep()
+	_, ok := step.(path.PathStepElementKeyInt)
+	return ok
+}
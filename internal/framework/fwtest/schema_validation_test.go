@@ -147,6 +147,41 @@ func TestResourceSchemaValidate(t *testing.T) {
 			}{},
 			expect: `field "id" has type "basetypes.StringType", expected "basetypes.Int64Type"`,
 		},
+		{
+			name: "Valid schema and model with lists",
+			res: ResourceMock{
+				schema: schema.Schema{
+					Description: "Test schema",
+					Attributes: map[string]schema.Attribute{
+						"id": id,
+						"tags": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tags",
+						},
+						"rules": schema.ListNestedAttribute{
+							Optional:    true,
+							Description: "Rules",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "Name",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			model: struct {
+				Id    types.String   `tfsdk:"id"`
+				Tags  []types.String `tfsdk:"tags"`
+				Rules []struct {
+					Name types.String `tfsdk:"name"`
+				} `tfsdk:"rules"`
+			}{},
+		},
 		{
 			name: "no description applied",
 			res: ResourceMock{
@@ -6,18 +6,19 @@ package fwintegration
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go"
 	"github.com/signalfx/signalfx-go/integration"
 
 	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
-	"github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/fwerr"
 	fwshared "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/shared"
 )
 
 type ResourceSplunkOncall struct {
-	fwembed.ResourceData
+	fwembed.ResourceCRUD[resourceSplunkOnCallModel, integration.VictorOpsIntegration, integration.VictorOpsIntegration]
 	fwembed.ResourceIDImporter
 }
 
@@ -35,7 +36,22 @@ var (
 )
 
 func NewResourceSplunkOncall() resource.Resource {
-	return &ResourceSplunkOncall{}
+	oncall := &ResourceSplunkOncall{}
+	oncall.Encode = oncall.encode
+	oncall.Decode = oncall.decode
+	oncall.CreateFunc = func(ctx context.Context, client *signalfx.Client, req *integration.VictorOpsIntegration) (*integration.VictorOpsIntegration, error) {
+		return client.CreateVictorOpsIntegration(ctx, req)
+	}
+	oncall.ReadFunc = func(ctx context.Context, client *signalfx.Client, id string) (*integration.VictorOpsIntegration, error) {
+		return client.GetVictorOpsIntegration(ctx, id)
+	}
+	oncall.UpdateFunc = func(ctx context.Context, client *signalfx.Client, id string, req *integration.VictorOpsIntegration) (*integration.VictorOpsIntegration, error) {
+		return client.UpdateVictorOpsIntegration(ctx, id, req)
+	}
+	oncall.DeleteFunc = func(ctx context.Context, client *signalfx.Client, id string) error {
+		return client.DeleteVictorOpsIntegration(ctx, id)
+	}
+	return oncall
 }
 
 func (oncall *ResourceSplunkOncall) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -64,105 +80,21 @@ func (oncall *ResourceSplunkOncall) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
-func (oncall *ResourceSplunkOncall) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var model resourceSplunkOnCallModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	details, err := oncall.Details().Client.CreateVictorOpsIntegration(
-		ctx,
-		&integration.VictorOpsIntegration{
-			// Internally this still uses the VictorOps details
-			// but the API has been rebranded to Splunk Oncall.
-			Type:    integration.VICTOR_OPS,
-			Enabled: model.Enabled.ValueBool(),
-			Name:    model.Name.ValueString(),
-			PostUrl: model.PostURL.ValueString(),
-		},
-	)
-
-	if resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, resp.State, err)...); resp.Diagnostics.HasError() {
-		return
-	}
-
-	model.Id = types.StringValue(details.Id)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
-}
-
-func (oncall *ResourceSplunkOncall) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var model resourceSplunkOnCallModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	details, err := oncall.Details().Client.GetVictorOpsIntegration(
-		ctx,
-		model.Id.ValueString(),
-	)
-
-	if resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, resp.State, err)...); resp.Diagnostics.HasError() {
-		return
-	}
-
-	if details == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
-	model.Enabled = types.BoolValue(details.Enabled)
-	model.Name = types.StringValue(details.Name)
-	model.PostURL = types.StringValue(details.PostUrl)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+func (oncall *ResourceSplunkOncall) encode(_ context.Context, model *resourceSplunkOnCallModel) (*integration.VictorOpsIntegration, diag.Diagnostics) {
+	return &integration.VictorOpsIntegration{
+		// Internally this still uses the VictorOps details
+		// but the API has been rebranded to Splunk Oncall.
+		Type:    integration.VICTOR_OPS,
+		Enabled: model.Enabled.ValueBool(),
+		Name:    model.Name.ValueString(),
+		PostUrl: model.PostURL.ValueString(),
+	}, nil
 }
 
-func (oncall *ResourceSplunkOncall) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model resourceSplunkOnCallModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	details, err := oncall.Details().Client.UpdateVictorOpsIntegration(
-		ctx,
-		model.Id.ValueString(),
-		&integration.VictorOpsIntegration{
-			Type:    integration.VICTOR_OPS,
-			Enabled: model.Enabled.ValueBool(),
-			Name:    model.Name.ValueString(),
-			PostUrl: model.PostURL.ValueString(),
-		},
-	)
-
-	if resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, resp.State, err)...); resp.Diagnostics.HasError() {
-		return
-	}
-
-	if details == nil {
-		resp.State.RemoveResource(ctx)
-		return
-	}
-
+func (oncall *ResourceSplunkOncall) decode(_ context.Context, details *integration.VictorOpsIntegration, model *resourceSplunkOnCallModel) diag.Diagnostics {
+	model.Id = types.StringValue(details.Id)
 	model.Enabled = types.BoolValue(details.Enabled)
 	model.Name = types.StringValue(details.Name)
 	model.PostURL = types.StringValue(details.PostUrl)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
-}
-
-func (oncall *ResourceSplunkOncall) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var model resourceSplunkOnCallModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	err := oncall.Details().Client.DeleteVictorOpsIntegration(
-		ctx,
-		model.Id.ValueString(),
-	)
-
-	resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, resp.State, err)...)
+	return nil
 }
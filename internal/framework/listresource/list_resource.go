@@ -0,0 +1,202 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwlist
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go"
+
+	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+// Item is the common representation of an object returned from the API search
+// that is used to filter and report the object as a list result.
+type Item struct {
+	ID      string
+	Name    string
+	Tags    []string
+	Creator string
+}
+
+// Filter contains the configured values to filter the listed objects.
+type Filter struct {
+	NamePrefix string
+	Tag        string
+	Creator    string
+}
+
+// Matches returns true when the item satisfies all of the configured filters.
+func (f Filter) Matches(item Item) bool {
+	if f.NamePrefix != "" && !strings.HasPrefix(item.Name, f.NamePrefix) {
+		return false
+	}
+	if f.Tag != "" && !slices.Contains(item.Tags, f.Tag) {
+		return false
+	}
+	if f.Creator != "" && item.Creator != f.Creator {
+		return false
+	}
+	return true
+}
+
+// Unsupported returns an error when any of the named filters are set,
+// since the objects being searched do not have the values to match them against.
+func (f Filter) Unsupported(names ...string) error {
+	values := map[string]string{
+		"name_prefix": f.NamePrefix,
+		"tag":         f.Tag,
+		"creator":     f.Creator,
+	}
+	for _, name := range names {
+		if values[name] != "" {
+			return fmt.Errorf("filter %q is not supported", name)
+		}
+	}
+	return nil
+}
+
+// SearchFunc returns an iterator of all the objects that could match the filter,
+// the filter is provided so that it can be passed to the API to reduce the number of results.
+type SearchFunc func(ctx context.Context, client *signalfx.Client, filter Filter) iter.Seq2[Item, error]
+
+type listResourceModel struct {
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	Tag        types.String `tfsdk:"tag"`
+	Creator    types.String `tfsdk:"creator"`
+}
+
+// ListResource implements the list resource protocol so that `terraform query`
+// can be used to find existing objects to import.
+// Resources defined using the SDKv2 provide the legacy resource for the schemas,
+// otherwise the schemas of the framework resource with the same name are used.
+type ListResource struct {
+	fwembed.ResourceData
+
+	name   string
+	legacy *sdkschema.Resource
+	search SearchFunc
+}
+
+var (
+	_ list.ListResource                 = (*ListResource)(nil)
+	_ list.ListResourceWithConfigure    = (*ListResource)(nil)
+	_ list.ListResourceWithRawV5Schemas = (*ListResource)(nil)
+)
+
+// NewListResource returns a list resource for the named resource,
+// the legacy resource is used to provide the resource and identity schemas
+// and is nil when the resource is defined using the framework.
+func NewListResource(name string, legacy *sdkschema.Resource, search SearchFunc) func() list.ListResource {
+	return func() list.ListResource {
+		return &ListResource{
+			name:   name,
+			legacy: legacy,
+			search: search,
+		}
+	}
+}
+
+func (lr *ListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + lr.name
+}
+
+func (lr *ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the existing objects within the organization so they can be imported.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include objects with a name that starts with the prefix.",
+			},
+			"tag": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include objects that have the tag set, not supported by objects without tags.",
+			},
+			"creator": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only include objects created by the user ID, not supported by objects without a creator.",
+			},
+		},
+	}
+}
+
+func (lr *ListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, resp *list.RawV5SchemaResponse) {
+	if lr.legacy == nil {
+		return
+	}
+	resp.ProtoV5Schema = lr.legacy.ProtoSchema(ctx)()
+	if identity := lr.legacy.ProtoIdentitySchema(ctx); identity != nil {
+		resp.ProtoV5IdentitySchema = identity()
+	}
+}
+
+func (lr *ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var model listResourceModel
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	client, err := pmeta.LoadClient(ctx, lr.Details())
+	if err != nil {
+		result := list.ListResult{}
+		result.Diagnostics.AddError("Unable to load client", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(result.Diagnostics)
+		return
+	}
+
+	filter := Filter{
+		NamePrefix: model.NamePrefix.ValueString(),
+		Tag:        model.Tag.ValueString(),
+		Creator:    model.Creator.ValueString(),
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for item, err := range lr.search(ctx, client, filter) {
+			if err != nil {
+				result := req.NewListResult(ctx)
+				result.Diagnostics.AddError("Unable to search "+lr.name, err.Error())
+				push(result)
+				return
+			}
+			if !filter.Matches(item) {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			if !push(lr.newResult(ctx, req, item)) {
+				return
+			}
+		}
+	}
+}
+
+func (lr *ListResource) newResult(ctx context.Context, req list.ListRequest, item Item) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = item.Name
+
+	result.Diagnostics.Append(fwembed.SetResourceIdentity(ctx, lr.Details(), result.Identity, item.ID)...)
+	if req.IncludeResource {
+		// Only the ID is known from the search results,
+		// the remaining values are populated once the object is imported.
+		result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), item.ID)...)
+	}
+	return result
+}
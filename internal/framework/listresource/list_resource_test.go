@@ -0,0 +1,224 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwlist
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+func TestFilterMatches(t *testing.T) {
+	t.Parallel()
+
+	item := Item{
+		ID:      "id-01",
+		Name:    "my-detector",
+		Tags:    []string{"prod", "team-a"},
+		Creator: "user-01",
+	}
+
+	for _, tc := range []struct {
+		name   string
+		filter Filter
+		expect bool
+	}{
+		{name: "empty filter", filter: Filter{}, expect: true},
+		{name: "matching prefix", filter: Filter{NamePrefix: "my-"}, expect: true},
+		{name: "mismatched prefix", filter: Filter{NamePrefix: "other"}, expect: false},
+		{name: "matching tag", filter: Filter{Tag: "team-a"}, expect: true},
+		{name: "missing tag", filter: Filter{Tag: "dev"}, expect: false},
+		{name: "matching creator", filter: Filter{Creator: "user-01"}, expect: true},
+		{name: "mismatched creator", filter: Filter{Creator: "user-02"}, expect: false},
+		{name: "all matching", filter: Filter{NamePrefix: "my", Tag: "prod", Creator: "user-01"}, expect: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, tc.filter.Matches(item), "Must match the expected value")
+		})
+	}
+}
+
+func TestFilterUnsupported(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, Filter{NamePrefix: "prod"}.Unsupported("tag", "creator"), "Must allow supported filters")
+	assert.EqualError(t, Filter{Creator: "user-01"}.Unsupported("tag", "creator"), `filter "creator" is not supported`, "Must report the unsupported filter")
+}
+
+func TestListResourceMetadata(t *testing.T) {
+	t.Parallel()
+
+	lr := NewListResource("detector", &sdkschema.Resource{}, nil)()
+
+	var resp resource.MetadataResponse
+	lr.Metadata(t.Context(), resource.MetadataRequest{ProviderTypeName: "signalfx"}, &resp)
+	assert.Equal(t, "signalfx_detector", resp.TypeName, "Must match the expected name")
+}
+
+func TestListResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	legacy := &sdkschema.Resource{
+		Schema: map[string]*sdkschema.Schema{
+			"name": {Type: sdkschema.TypeString, Required: true},
+		},
+		Identity: pmeta.NewResourceIdentity(),
+	}
+	lr := NewListResource("detector", legacy, nil)()
+
+	var resp list.ListResourceSchemaResponse
+	lr.ListResourceConfigSchema(t.Context(), list.ListResourceSchemaRequest{}, &resp)
+	assert.NotEmpty(t, resp.Schema.Description, "Must have a description set")
+	assert.Len(t, resp.Schema.Attributes, 3, "Must have all filter attributes")
+
+	var raw list.RawV5SchemaResponse
+	lr.(list.ListResourceWithRawV5Schemas).RawV5Schemas(t.Context(), list.RawV5SchemaRequest{}, &raw)
+	assert.NotNil(t, raw.ProtoV5Schema, "Must have the resource schema set")
+	assert.NotNil(t, raw.ProtoV5IdentitySchema, "Must have the identity schema set")
+
+	raw = list.RawV5SchemaResponse{}
+	NewListResource("chart", nil, nil)().(list.ListResourceWithRawV5Schemas).RawV5Schemas(t.Context(), list.RawV5SchemaRequest{}, &raw)
+	assert.Nil(t, raw.ProtoV5Schema, "Must use the framework resource schema")
+	assert.Nil(t, raw.ProtoV5IdentitySchema, "Must use the framework resource identity schema")
+}
+
+func newTestListRequest(ctx context.Context, values map[string]tftypes.Value, limit int64) list.ListRequest {
+	var resp list.ListResourceSchemaResponse
+	(&ListResource{}).ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &resp)
+
+	config := map[string]tftypes.Value{
+		"name_prefix": tftypes.NewValue(tftypes.String, nil),
+		"tag":         tftypes.NewValue(tftypes.String, nil),
+		"creator":     tftypes.NewValue(tftypes.String, nil),
+	}
+	for k, v := range values {
+		config[k] = v
+	}
+
+	return list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: resp.Schema,
+			Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), config),
+		},
+		IncludeResource: true,
+		Limit:           limit,
+		ResourceSchema: rschema.Schema{
+			Attributes: map[string]rschema.Attribute{
+				"id": rschema.StringAttribute{Computed: true},
+			},
+		},
+		ResourceIdentitySchema: fwembed.NewResourceIdentitySchema(),
+	}
+}
+
+func TestListResourceList(t *testing.T) {
+	t.Parallel()
+
+	items := []Item{
+		{ID: "id-01", Name: "prod-cpu", Creator: "user-01"},
+		{ID: "id-02", Name: "prod-memory", Creator: "user-02"},
+		{ID: "id-03", Name: "prod-disk", Creator: "user-01"},
+	}
+
+	search := func(ctx context.Context, _ *signalfx.Client, _ Filter) iter.Seq2[Item, error] {
+		return func(yield func(Item, error) bool) {
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+
+	failing := func(ctx context.Context, _ *signalfx.Client, _ Filter) iter.Seq2[Item, error] {
+		return func(yield func(Item, error) bool) {
+			yield(Item{}, errors.New("failed"))
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		search SearchFunc
+		values map[string]tftypes.Value
+		limit  int64
+		expect []string
+		errors int
+	}{
+		{
+			name:   "all items",
+			search: search,
+			expect: []string{"prod-cpu", "prod-memory", "prod-disk"},
+		},
+		{
+			name:   "filtered by creator",
+			search: search,
+			values: map[string]tftypes.Value{
+				"creator": tftypes.NewValue(tftypes.String, "user-01"),
+			},
+			expect: []string{"prod-cpu", "prod-disk"},
+		},
+		{
+			name:   "limited results",
+			search: search,
+			limit:  1,
+			expect: []string{"prod-cpu"},
+		},
+		{
+			name:   "search failed",
+			search: failing,
+			expect: []string{""},
+			errors: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client, err := signalfx.NewClient(t.Name())
+			require.NoError(t, err, "Must not error creating client")
+
+			lr := NewListResource("detector", &sdkschema.Resource{}, tc.search)()
+			lr.(list.ListResourceWithConfigure).Configure(t.Context(), resource.ConfigureRequest{
+				ProviderData: &pmeta.Meta{Client: client, APIURL: "https://api.us1.signalfx.com"},
+			}, &resource.ConfigureResponse{})
+
+			var stream list.ListResultsStream
+			lr.List(t.Context(), newTestListRequest(t.Context(), tc.values, tc.limit), &stream)
+
+			var (
+				names []string
+				errs  int
+			)
+			for result := range stream.Results {
+				names = append(names, result.DisplayName)
+				errs += result.Diagnostics.ErrorsCount()
+				if result.Diagnostics.HasError() {
+					continue
+				}
+
+				var identity fwembed.ResourceIdentityModel
+				require.False(t, result.Identity.Get(t.Context(), &identity).HasError(), "Must read the identity")
+				assert.Equal(t, "us1", identity.Realm.ValueString(), "Must have the realm set")
+				assert.NotEmpty(t, identity.Id.ValueString(), "Must have the id set")
+			}
+			assert.Equal(t, tc.expect, names, "Must match the expected results")
+			assert.Equal(t, tc.errors, errs, "Must match the expected number of errors")
+		})
+	}
+}
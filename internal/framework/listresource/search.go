@@ -0,0 +1,136 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwlist
+
+import (
+	"context"
+	"iter"
+	"slices"
+
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/alertmuting"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/signalfx/signalfx-go/dashboard_group"
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/signalfx/signalfx-go/team"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+)
+
+// searchItems converts the paginated API search results into list items.
+func searchItems[T any](ctx context.Context, search common.SearchPageFunc[T], convert func(T) (Item, bool)) iter.Seq2[Item, error] {
+	return func(yield func(Item, error) bool) {
+		for v, err := range common.SearchAll(ctx, common.DefaultSearchPageSize, search) {
+			if err != nil {
+				yield(Item{}, err)
+				return
+			}
+			if item, ok := convert(v); ok && !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// searchError returns a search that only reports the error.
+func searchError(err error) iter.Seq2[Item, error] {
+	return func(yield func(Item, error) bool) {
+		yield(Item{}, err)
+	}
+}
+
+func SearchDetectors(ctx context.Context, client *signalfx.Client, filter Filter) iter.Seq2[Item, error] {
+	return searchItems(ctx, func(ctx context.Context, limit, offset int) ([]detector.Detector, error) {
+		results, err := client.SearchDetectors(ctx, limit, filter.NamePrefix, offset, filter.Tag)
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}, func(d detector.Detector) (Item, bool) {
+		return Item{ID: d.Id, Name: d.Name, Tags: d.Tags, Creator: d.Creator}, true
+	})
+}
+
+func SearchDashboards(ctx context.Context, client *signalfx.Client, filter Filter) iter.Seq2[Item, error] {
+	return searchItems(ctx, func(ctx context.Context, limit, offset int) ([]dashboard.Dashboard, error) {
+		results, err := client.SearchDashboard(ctx, limit, filter.NamePrefix, offset, filter.Tag)
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}, func(d dashboard.Dashboard) (Item, bool) {
+		return Item{ID: d.Id, Name: d.Name, Tags: d.Tags, Creator: d.Creator}, true
+	})
+}
+
+func SearchDashboardGroups(ctx context.Context, client *signalfx.Client, filter Filter) iter.Seq2[Item, error] {
+	if err := filter.Unsupported("tag"); err != nil {
+		return searchError(err)
+	}
+	return searchItems(ctx, func(ctx context.Context, limit, offset int) ([]*dashboard_group.DashboardGroup, error) {
+		results, err := client.SearchDashboardGroups(ctx, limit, filter.NamePrefix, offset)
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}, func(g *dashboard_group.DashboardGroup) (Item, bool) {
+		if g == nil {
+			return Item{}, false
+		}
+		return Item{ID: g.Id, Name: g.Name, Creator: g.Creator}, true
+	})
+}
+
+// NewChartSearch returns a search that only includes charts of the provided types,
+// since each chart type is managed by a different resource.
+func NewChartSearch(chartTypes ...string) SearchFunc {
+	return func(ctx context.Context, client *signalfx.Client, filter Filter) iter.Seq2[Item, error] {
+		return searchItems(ctx, func(ctx context.Context, limit, offset int) ([]*chart.Chart, error) {
+			results, err := client.SearchCharts(ctx, limit, filter.NamePrefix, offset, filter.Tag)
+			if err != nil {
+				return nil, err
+			}
+			return results.Results, nil
+		}, func(c *chart.Chart) (Item, bool) {
+			if c == nil || c.Options == nil || !slices.Contains(chartTypes, c.Options.Type) {
+				return Item{}, false
+			}
+			return Item{ID: c.Id, Name: c.Name, Tags: c.Tags, Creator: c.Creator}, true
+		})
+	}
+}
+
+func SearchTeams(ctx context.Context, client *signalfx.Client, filter Filter) iter.Seq2[Item, error] {
+	if err := filter.Unsupported("tag", "creator"); err != nil {
+		return searchError(err)
+	}
+	return searchItems(ctx, func(ctx context.Context, limit, offset int) ([]team.Team, error) {
+		results, err := client.SearchTeam(ctx, limit, filter.NamePrefix, offset, "")
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}, func(t team.Team) (Item, bool) {
+		return Item{ID: t.Id, Name: t.Name}, true
+	})
+}
+
+// SearchAlertMutingRules uses the rule description as the name
+// since muting rules do not have a name.
+// The name prefix and creator filters are matched against the returned rules.
+func SearchAlertMutingRules(ctx context.Context, client *signalfx.Client, filter Filter) iter.Seq2[Item, error] {
+	if err := filter.Unsupported("tag"); err != nil {
+		return searchError(err)
+	}
+	return searchItems(ctx, func(ctx context.Context, limit, offset int) ([]alertmuting.AlertMutingRule, error) {
+		results, err := client.SearchAlertMutingRules(ctx, "", limit, "", offset)
+		if err != nil {
+			return nil, err
+		}
+		return results.Results, nil
+	}, func(r alertmuting.AlertMutingRule) (Item, bool) {
+		return Item{ID: r.Id, Name: r.Description, Creator: r.Creator}, true
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwlist
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, routes map[string]any) *signalfx.Client {
+	mux := http.NewServeMux()
+	for route, body := range routes {
+		mux.HandleFunc(route, func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(body)
+		})
+	}
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	client, err := signalfx.NewClient(t.Name(), signalfx.HTTPClient(srv.Client()), signalfx.APIUrl(srv.URL))
+	require.NoError(t, err, "Must not error creating client")
+	return client
+}
+
+func TestSearchDetectors(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, map[string]any{
+		"GET /v2/detector": &detector.SearchResults{
+			Count: 2,
+			Results: []detector.Detector{
+				{Id: "id-01", Name: "cpu", Tags: []string{"prod"}, Creator: "user-01"},
+				{Id: "id-02", Name: "memory"},
+			},
+		},
+	})
+
+	var items []Item
+	for item, err := range SearchDetectors(t.Context(), client, Filter{}) {
+		require.NoError(t, err, "Must not error searching detectors")
+		items = append(items, item)
+	}
+	assert.Equal(t, []Item{
+		{ID: "id-01", Name: "cpu", Tags: []string{"prod"}, Creator: "user-01"},
+		{ID: "id-02", Name: "memory"},
+	}, items, "Must match the expected items")
+}
+
+func TestSearchChartsByType(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, map[string]any{
+		"GET /v2/chart": &chart.SearchResult{
+			Count: 3,
+			Results: []*chart.Chart{
+				{Id: "id-01", Name: "time", Options: &chart.Options{Type: "TimeSeriesChart"}},
+				{Id: "id-02", Name: "text", Options: &chart.Options{Type: "Text"}},
+				{Id: "id-03", Name: "unknown"},
+			},
+		},
+	})
+
+	var items []Item
+	for item, err := range NewChartSearch("Text")(t.Context(), client, Filter{}) {
+		require.NoError(t, err, "Must not error searching charts")
+		items = append(items, item)
+	}
+	assert.Equal(t, []Item{{ID: "id-02", Name: "text"}}, items, "Must only include charts of the requested type")
+
+	items = nil
+	for item, err := range NewChartSearch("Text", "TimeSeriesChart")(t.Context(), client, Filter{}) {
+		require.NoError(t, err, "Must not error searching charts")
+		items = append(items, item)
+	}
+	assert.Equal(t, []Item{
+		{ID: "id-01", Name: "time"},
+		{ID: "id-02", Name: "text"},
+	}, items, "Must include charts of all the requested types")
+}
+
+func TestSearchFailed(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, map[string]any{})
+
+	var errs int
+	for _, err := range SearchTeams(t.Context(), client, Filter{}) {
+		if err != nil {
+			errs++
+		}
+	}
+	assert.Equal(t, 1, errs, "Must report the search failure")
+}
+
+func TestSearchUnsupportedFilters(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, map[string]any{})
+
+	for _, tc := range []struct {
+		name   string
+		search SearchFunc
+		filter Filter
+		errVal string
+	}{
+		{name: "dashboard group tag", search: SearchDashboardGroups, filter: Filter{Tag: "prod"}, errVal: `filter "tag" is not supported`},
+		{name: "team tag", search: SearchTeams, filter: Filter{Tag: "prod"}, errVal: `filter "tag" is not supported`},
+		{name: "team creator", search: SearchTeams, filter: Filter{Creator: "user-01"}, errVal: `filter "creator" is not supported`},
+		{name: "muting rule tag", search: SearchAlertMutingRules, filter: Filter{Tag: "prod"}, errVal: `filter "tag" is not supported`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var errs []error
+			for _, err := range tc.search(t.Context(), client, tc.filter) {
+				errs = append(errs, err)
+			}
+			require.Len(t, errs, 1, "Must only report the unsupported filter")
+			assert.EqualError(t, errs[0], tc.errVal, "Must match the expected error")
+		})
+	}
+}
@@ -0,0 +1,390 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwmetricruleset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/metric_ruleset"
+
+	metricrulesetdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/metricruleset"
+	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/fwerr"
+	fwshared "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/shared"
+)
+
+type ResourceMetricRuleset struct {
+	fwembed.ResourceCRUD[resourceMetricRulesetModel, metric_ruleset.MetricRuleset, metric_ruleset.GetMetricRulesetResponse]
+	fwembed.ResourceIDImporter
+}
+
+var (
+	_ resource.Resource                   = (*ResourceMetricRuleset)(nil)
+	_ resource.ResourceWithConfigure      = (*ResourceMetricRuleset)(nil)
+	_ resource.ResourceWithImportState    = (*ResourceMetricRuleset)(nil)
+	_ resource.ResourceWithIdentity       = (*ResourceMetricRuleset)(nil)
+	_ resource.ResourceWithValidateConfig = (*ResourceMetricRuleset)(nil)
+	_ resource.ResourceWithModifyPlan     = (*ResourceMetricRuleset)(nil)
+)
+
+func NewResourceMetricRuleset() resource.Resource {
+	rs := &ResourceMetricRuleset{}
+	rs.Encode = rs.encode
+	rs.Decode = rs.decode
+	rs.CreateFunc = func(ctx context.Context, client *signalfx.Client, req *metric_ruleset.MetricRuleset) (*metric_ruleset.GetMetricRulesetResponse, error) {
+		resp, err := client.CreateMetricRuleset(ctx, &metric_ruleset.CreateMetricRulesetRequest{
+			AggregationRules: req.AggregationRules,
+			ExceptionRules:   req.ExceptionRules,
+			MetricName:       req.GetMetricName(),
+			Description:      req.Description,
+			RoutingRule:      req.GetRoutingRule(),
+		})
+		return (*metric_ruleset.GetMetricRulesetResponse)(resp), err
+	}
+	rs.ReadFunc = func(ctx context.Context, client *signalfx.Client, id string) (*metric_ruleset.GetMetricRulesetResponse, error) {
+		return client.GetMetricRuleset(ctx, id)
+	}
+	rs.UpdateFunc = func(ctx context.Context, client *signalfx.Client, id string, req *metric_ruleset.MetricRuleset) (*metric_ruleset.GetMetricRulesetResponse, error) {
+		resp, err := client.UpdateMetricRuleset(ctx, id, &metric_ruleset.UpdateMetricRulesetRequest{
+			AggregationRules: req.AggregationRules,
+			ExceptionRules:   req.ExceptionRules,
+			MetricName:       req.MetricName,
+			Description:      req.Description,
+			RoutingRule:      req.RoutingRule,
+			Version:          req.Version,
+		})
+		return (*metric_ruleset.GetMetricRulesetResponse)(resp), err
+	}
+	rs.DeleteFunc = func(ctx context.Context, client *signalfx.Client, id string) error {
+		return client.DeleteMetricRuleset(ctx, id)
+	}
+	return rs
+}
+
+func (rs *ResourceMetricRuleset) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metric_ruleset"
+}
+
+func (rs *ResourceMetricRuleset) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a metric ruleset, which aggregates and routes the data points of a metric. " +
+			"Rules are matched to the API by name, so their order does not cause a difference.",
+		Attributes: map[string]schema.Attribute{
+			"id": fwshared.ResourceIDAttribute(),
+			"metric_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the input metric.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Information about the metric ruleset.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the ruleset, which is used to detect changes made outside of Terraform.",
+			},
+			"creator": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the creator of the metric ruleset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of when the metric ruleset was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of user who last updated the metric ruleset.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Description: "Timestamp of when the metric ruleset was last updated.",
+			},
+			"last_updated_by_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of user who last updated this metric ruleset.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"aggregation_rules": schema.ListNestedBlock{
+				Description: "Aggregation rules in the ruleset, matched to the API by `name`, or by `output_name` when the rule is unnamed.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of this aggregation rule.",
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Description: "Information about an aggregation rule.",
+						},
+						"enabled": schema.BoolAttribute{
+							Required:    true,
+							Description: "Status of this aggregation rule.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"matcher": newMatcherBlock(),
+						"aggregator": schema.ListNestedBlock{
+							Description: "The aggregator for this rule.",
+							Validators: []validator.List{
+								fwshared.ListSizeBetween(1, 1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:    true,
+										Description: "The type of the aggregator.",
+										Validators: []validator.String{
+											fwshared.StringOneOf("rollup"),
+										},
+									},
+									"output_name": schema.StringAttribute{
+										Required:    true,
+										Description: "The aggregated metric name.",
+									},
+									"dimensions": schema.SetAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "List of dimensions to keep or drop in aggregated metric.",
+									},
+									"drop_dimensions": schema.BoolAttribute{
+										Required:    true,
+										Description: "Flag specifying to keep or drop given dimensions.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"exception_rules": schema.ListNestedBlock{
+				Description: "Exception rules in the ruleset, matched to the API by `name`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of this exception rule.",
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Description: "Information about an exception rule.",
+						},
+						"enabled": schema.BoolAttribute{
+							Required:    true,
+							Description: "Status of this exception rule.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"matcher": newMatcherBlock(),
+						"restoration": schema.ListNestedBlock{
+							Description: "Restoration for this rule.",
+							Validators: []validator.List{
+								fwshared.ListSizeBetween(0, 1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"restoration_id": schema.StringAttribute{
+										Computed:    true,
+										Description: "ID of the restoration job.",
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"start_time": schema.StringAttribute{
+										Required:    true,
+										Description: "Time from which the restoration job will restore archived data, in the form of *nix time in milliseconds.",
+									},
+									"stop_time": schema.StringAttribute{
+										Optional:    true,
+										Description: "Time to which the restoration job will restore archived data, in the form of *nix time in milliseconds.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"routing_rule": schema.ListNestedBlock{
+				Description: "Location to send the input metric.",
+				Validators: []validator.List{
+					fwshared.ListSizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"destination": schema.StringAttribute{
+							Required:    true,
+							Description: "Destination to send the input metric.",
+							Validators: []validator.String{
+								fwshared.StringOneOf("RealTime", "Archived", "Drop"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newMatcherBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: "The matcher for this rule.",
+		Validators: []validator.List{
+			fwshared.ListSizeBetween(1, 1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:    true,
+					Description: "The type of the matcher.",
+					Validators: []validator.String{
+						fwshared.StringOneOf("dimension"),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"filters": schema.ListNestedBlock{
+					Description: "List of filters to match on.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"not": schema.BoolAttribute{
+								Required:    true,
+								Description: "Flag specifying equals or not equals.",
+							},
+							"property": schema.StringAttribute{
+								Required:    true,
+								Description: "Name of dimension to match.",
+							},
+							"property_value": schema.SetAttribute{
+								ElementType: types.StringType,
+								Required:    true,
+								Description: "List of dimension values to match.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that each rule can be matched to the API by its name.
+func (rs *ResourceMetricRuleset) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model resourceMetricRulesetModel
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		// Blocks that are not known yet can not be read into the model,
+		// they are validated once they are known.
+		return
+	}
+
+	keys := make([]string, 0, len(model.AggregationRules))
+	for _, rule := range model.AggregationRules {
+		keys = append(keys, rule.key())
+	}
+	validateUniqueKeys(path.Root("aggregation_rules"), keys, &resp.Diagnostics)
+
+	keys = make([]string, 0, len(model.ExceptionRules))
+	for _, rule := range model.ExceptionRules {
+		keys = append(keys, rule.Name.ValueString())
+	}
+	validateUniqueKeys(path.Root("exception_rules"), keys, &resp.Diagnostics)
+}
+
+// ModifyPlan reports the charts and detectors that use the dimensions dropped
+// by the planned aggregation rules, when the preview is enabled.
+func (rs *ResourceMetricRuleset) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed or the configuration is not known yet.
+	if req.Plan.Raw.IsNull() || !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+	if meta := rs.Details(); meta == nil || meta.Client == nil {
+		return
+	}
+
+	var plan resourceMetricRulesetModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...); resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state resourceMetricRulesetModel
+		if resp.Diagnostics.Append(req.State.Get(ctx, &state)...); resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.MetricName.Equal(state.MetricName) && aggregationRulesEqual(plan.AggregationRules, state.AggregationRules) {
+			return
+		}
+	}
+
+	payload, diags := rs.encode(ctx, &plan)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	impacts, err := metricrulesetdef.FindImpacts(ctx, rs.Details(), payload)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Analyze Metric Ruleset Impact", err.Error())
+		return
+	}
+	for _, impact := range impacts {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("aggregation_rules"),
+			fmt.Sprintf("Metric Ruleset Drops Dimensions Used by %s %q", impact.Kind, impact.Name),
+			impact.String(),
+		)
+	}
+}
+
+// Update sends the version from the prior state with the update,
+// and fails when the metric ruleset has been changed since it was last read
+// so that changes made outside of Terraform are not overwritten.
+func (rs *ResourceMetricRuleset) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var id, version types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("version"), &version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := rs.Details().Client.GetMetricRuleset(ctx, id.ValueString())
+	if resp.Diagnostics.Append(fwerr.ErrorHandler(ctx, &resp.State, err)...); resp.Diagnostics.HasError() {
+		return
+	}
+	if current == nil {
+		return
+	}
+
+	if expect := versionString(current.Version); !version.IsNull() && version.ValueString() != expect {
+		resp.Diagnostics.AddError(
+			"Metric Ruleset Conflict",
+			fmt.Sprintf(
+				"The metric ruleset %q was changed outside of Terraform after it was last read, "+
+					"the state has version %s but the current version is %s. "+
+					"Refresh the state and review the plan before applying the changes again.",
+				id.ValueString(), version.ValueString(), expect,
+			),
+		)
+		return
+	}
+
+	// The planned version is unknown, so the prior version is set on the plan
+	// for it to be sent with the update request.
+	if resp.Diagnostics.Append(req.Plan.SetAttribute(ctx, path.Root("version"), version)...); resp.Diagnostics.HasError() {
+		return
+	}
+	rs.ResourceCRUD.Update(ctx, req, resp)
+}
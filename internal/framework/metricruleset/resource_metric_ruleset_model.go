@@ -0,0 +1,371 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwmetricruleset
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/metric_ruleset"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+)
+
+type resourceMetricRulesetModel struct {
+	Id                types.String           `tfsdk:"id"`
+	MetricName        types.String           `tfsdk:"metric_name"`
+	Description       types.String           `tfsdk:"description"`
+	Version           types.String           `tfsdk:"version"`
+	Creator           types.String           `tfsdk:"creator"`
+	Created           types.String           `tfsdk:"created"`
+	LastUpdatedBy     types.String           `tfsdk:"last_updated_by"`
+	LastUpdated       types.String           `tfsdk:"last_updated"`
+	LastUpdatedByName types.String           `tfsdk:"last_updated_by_name"`
+	AggregationRules  []aggregationRuleModel `tfsdk:"aggregation_rules"`
+	ExceptionRules    []exceptionRuleModel   `tfsdk:"exception_rules"`
+	RoutingRule       []routingRuleModel     `tfsdk:"routing_rule"`
+}
+
+type aggregationRuleModel struct {
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	Enabled     types.Bool        `tfsdk:"enabled"`
+	Matcher     []matcherModel    `tfsdk:"matcher"`
+	Aggregator  []aggregatorModel `tfsdk:"aggregator"`
+}
+
+type exceptionRuleModel struct {
+	Name        types.String       `tfsdk:"name"`
+	Description types.String       `tfsdk:"description"`
+	Enabled     types.Bool         `tfsdk:"enabled"`
+	Matcher     []matcherModel     `tfsdk:"matcher"`
+	Restoration []restorationModel `tfsdk:"restoration"`
+}
+
+type matcherModel struct {
+	Type    types.String  `tfsdk:"type"`
+	Filters []filterModel `tfsdk:"filters"`
+}
+
+type filterModel struct {
+	Not           types.Bool     `tfsdk:"not"`
+	Property      types.String   `tfsdk:"property"`
+	PropertyValue []types.String `tfsdk:"property_value"`
+}
+
+type aggregatorModel struct {
+	Type           types.String   `tfsdk:"type"`
+	OutputName     types.String   `tfsdk:"output_name"`
+	Dimensions     []types.String `tfsdk:"dimensions"`
+	DropDimensions types.Bool     `tfsdk:"drop_dimensions"`
+}
+
+type restorationModel struct {
+	RestorationId types.String `tfsdk:"restoration_id"`
+	StartTime     types.String `tfsdk:"start_time"`
+	StopTime      types.String `tfsdk:"stop_time"`
+}
+
+type routingRuleModel struct {
+	Destination types.String `tfsdk:"destination"`
+}
+
+// key is used to match the aggregation rule with the rule returned by the API,
+// which is the name of the rule, or the aggregated metric name when the rule is unnamed.
+func (rule aggregationRuleModel) key() string {
+	if name := rule.Name.ValueString(); name != "" {
+		return name
+	}
+	if len(rule.Aggregator) > 0 {
+		return rule.Aggregator[0].OutputName.ValueString()
+	}
+	return ""
+}
+
+func aggregationRuleKey(rule metric_ruleset.AggregationRule) string {
+	if name := rule.GetName(); name != "" {
+		return name
+	}
+	if rule.Aggregator.RollupAggregator != nil {
+		return rule.Aggregator.RollupAggregator.OutputName
+	}
+	return ""
+}
+
+func aggregationRulesEqual(a, b []aggregationRuleModel) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+func (rs *ResourceMetricRuleset) encode(_ context.Context, model *resourceMetricRulesetModel) (*metric_ruleset.MetricRuleset, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	details := &metric_ruleset.MetricRuleset{
+		MetricName:       common.AsPointer(model.MetricName.ValueString()),
+		Description:      common.AsPointer(model.Description.ValueString()),
+		AggregationRules: make([]metric_ruleset.AggregationRule, 0, len(model.AggregationRules)),
+		ExceptionRules:   make([]metric_ruleset.ExceptionRule, 0, len(model.ExceptionRules)),
+		RoutingRule:      &metric_ruleset.RoutingRule{},
+	}
+
+	if !model.Version.IsNull() && !model.Version.IsUnknown() {
+		version, err := strconv.ParseInt(model.Version.ValueString(), 10, 64)
+		if err != nil {
+			diags.AddAttributeError(path.Root("version"), "Invalid Metric Ruleset Version", err.Error())
+			return nil, diags
+		}
+		details.Version = &version
+	}
+
+	for _, rule := range model.AggregationRules {
+		ar := metric_ruleset.AggregationRule{
+			Name:        common.AsPointer(rule.Name.ValueString()),
+			Description: common.AsPointer(rule.Description.ValueString()),
+			Enabled:     rule.Enabled.ValueBool(),
+		}
+		if len(rule.Matcher) > 0 {
+			matcher := rule.Matcher[0].toAPI()
+			ar.Matcher = metric_ruleset.MetricMatcher{DimensionMatcher: &matcher}
+		}
+		if len(rule.Aggregator) > 0 {
+			agg := rule.Aggregator[0]
+			ar.Aggregator = metric_ruleset.MetricAggregator{
+				RollupAggregator: &metric_ruleset.RollupAggregator{
+					Type:           agg.Type.ValueString(),
+					OutputName:     agg.OutputName.ValueString(),
+					Dimensions:     valueStrings(agg.Dimensions),
+					DropDimensions: common.AsPointer(agg.DropDimensions.ValueBool()),
+				},
+			}
+		}
+		details.AggregationRules = append(details.AggregationRules, ar)
+	}
+
+	for i, rule := range model.ExceptionRules {
+		er := metric_ruleset.ExceptionRule{
+			Name:        rule.Name.ValueString(),
+			Description: common.AsPointer(rule.Description.ValueString()),
+			Enabled:     rule.Enabled.ValueBool(),
+			Restoration: &metric_ruleset.ExceptionRuleRestorationFields{},
+		}
+		if len(rule.Matcher) > 0 {
+			er.Matcher = rule.Matcher[0].toAPI()
+		}
+		if len(rule.Restoration) > 0 {
+			restoration, err := rule.Restoration[0].toAPI()
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("exception_rules").AtListIndex(i).AtName("restoration").AtListIndex(0),
+					"Invalid Metric Ruleset Restoration",
+					err.Error(),
+				)
+				continue
+			}
+			er.Restoration = restoration
+		}
+		details.ExceptionRules = append(details.ExceptionRules, er)
+	}
+
+	if len(model.RoutingRule) > 0 {
+		details.RoutingRule.Destination = common.AsPointer(model.RoutingRule[0].Destination.ValueString())
+	}
+
+	return details, diags
+}
+
+func (mm matcherModel) toAPI() metric_ruleset.DimensionMatcher {
+	matcher := metric_ruleset.DimensionMatcher{Type: mm.Type.ValueString()}
+	for _, f := range mm.Filters {
+		matcher.Filters = append(matcher.Filters, metric_ruleset.PropertyFilter{
+			Property:      common.AsPointer(f.Property.ValueString()),
+			PropertyValue: valueStrings(f.PropertyValue),
+			NOT:           common.AsPointer(f.Not.ValueBool()),
+		})
+	}
+	return matcher
+}
+
+func (rm restorationModel) toAPI() (*metric_ruleset.ExceptionRuleRestorationFields, error) {
+	start, err := strconv.ParseInt(rm.StartTime.ValueString(), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("start_time must be a timestamp in milliseconds: %w", err)
+	}
+	restoration := &metric_ruleset.ExceptionRuleRestorationFields{
+		RestorationId: common.AsPointer(rm.RestorationId.ValueString()),
+		StartTime:     &start,
+	}
+	if stop := rm.StopTime.ValueString(); stop != "" {
+		v, err := strconv.ParseInt(stop, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("stop_time must be a timestamp in milliseconds: %w", err)
+		}
+		restoration.StopTime = &v
+	}
+	return restoration, nil
+}
+
+func (rs *ResourceMetricRuleset) decode(_ context.Context, details *metric_ruleset.GetMetricRulesetResponse, model *resourceMetricRulesetModel) (diags diag.Diagnostics) {
+	prior := *model
+
+	model.Id = types.StringValue(details.GetId())
+	model.MetricName = types.StringValue(details.GetMetricName())
+	model.Description = stringValue(details.GetDescription())
+	model.Version = int64Value(details.Version)
+	model.Creator = stringValue(details.GetCreator())
+	model.Created = int64Value(details.Created)
+	model.LastUpdatedBy = stringValue(details.GetLastUpdatedBy())
+	model.LastUpdated = int64Value(details.LastUpdated)
+	model.LastUpdatedByName = stringValue(details.GetLastUpdatedByName())
+
+	keys := make([]string, 0, len(prior.AggregationRules))
+	for _, rule := range prior.AggregationRules {
+		keys = append(keys, rule.key())
+	}
+	model.AggregationRules = make([]aggregationRuleModel, 0, len(details.AggregationRules))
+	for _, rule := range orderRules(details.AggregationRules, aggregationRuleKey, keys) {
+		ar := aggregationRuleModel{
+			Name:        stringValue(rule.GetName()),
+			Description: stringValue(rule.GetDescription()),
+			Enabled:     types.BoolValue(rule.Enabled),
+			Matcher:     []matcherModel{},
+			Aggregator:  []aggregatorModel{},
+		}
+		if rule.Matcher.DimensionMatcher != nil {
+			ar.Matcher = []matcherModel{newMatcherModel(*rule.Matcher.DimensionMatcher)}
+		}
+		if agg := rule.Aggregator.RollupAggregator; agg != nil {
+			ar.Aggregator = []aggregatorModel{{
+				Type:           types.StringValue(agg.Type),
+				OutputName:     types.StringValue(agg.OutputName),
+				Dimensions:     stringValues(agg.Dimensions),
+				DropDimensions: types.BoolValue(agg.GetDropDimensions()),
+			}}
+		}
+		model.AggregationRules = append(model.AggregationRules, ar)
+	}
+
+	keys = make([]string, 0, len(prior.ExceptionRules))
+	for _, rule := range prior.ExceptionRules {
+		keys = append(keys, rule.Name.ValueString())
+	}
+	model.ExceptionRules = make([]exceptionRuleModel, 0, len(details.ExceptionRules))
+	for _, rule := range orderRules(details.ExceptionRules, func(rule metric_ruleset.ExceptionRule) string { return rule.Name }, keys) {
+		er := exceptionRuleModel{
+			Name:        types.StringValue(rule.Name),
+			Description: stringValue(rule.GetDescription()),
+			Enabled:     types.BoolValue(rule.Enabled),
+			Matcher:     []matcherModel{newMatcherModel(rule.Matcher)},
+			Restoration: []restorationModel{},
+		}
+		if r := rule.Restoration; r != nil && r.GetStartTime() > 0 {
+			rm := restorationModel{
+				RestorationId: stringValue(r.GetRestorationId()),
+				StartTime:     int64Value(r.StartTime),
+				StopTime:      types.StringNull(),
+			}
+			if r.GetStopTime() > 0 {
+				rm.StopTime = int64Value(r.StopTime)
+			}
+			er.Restoration = []restorationModel{rm}
+		}
+		model.ExceptionRules = append(model.ExceptionRules, er)
+	}
+
+	model.RoutingRule = []routingRuleModel{}
+	if details.RoutingRule != nil {
+		model.RoutingRule = []routingRuleModel{{Destination: types.StringValue(details.RoutingRule.GetDestination())}}
+	}
+
+	return diags
+}
+
+func newMatcherModel(matcher metric_ruleset.DimensionMatcher) matcherModel {
+	mm := matcherModel{
+		Type:    types.StringValue(matcher.Type),
+		Filters: make([]filterModel, 0, len(matcher.Filters)),
+	}
+	for _, f := range matcher.Filters {
+		mm.Filters = append(mm.Filters, filterModel{
+			Not:           types.BoolValue(f.GetNOT()),
+			Property:      types.StringValue(f.GetProperty()),
+			PropertyValue: stringValues(f.PropertyValue),
+		})
+	}
+	return mm
+}
+
+// orderRules orders the API rules in the same order as the prior rules by their key,
+// since rules reordered by the API or the UI do not change the ruleset,
+// with any remaining rules kept in the order returned by the API.
+func orderRules[R any](rules []R, key func(R) string, prior []string) []R {
+	position := func(k string) int {
+		if i := slices.Index(prior, k); i >= 0 && k != "" {
+			return i
+		}
+		return len(prior)
+	}
+
+	ordered := slices.Clone(rules)
+	slices.SortStableFunc(ordered, func(a, b R) int {
+		return position(key(a)) - position(key(b))
+	})
+	return ordered
+}
+
+// validateUniqueKeys reports the rules that share a name,
+// since they can not be matched to the rules returned by the API.
+func validateUniqueKeys(p path.Path, keys []string, diags *diag.Diagnostics) {
+	for i, key := range keys {
+		if key == "" {
+			continue
+		}
+		if j := slices.Index(keys, key); j < i {
+			diags.AddAttributeError(
+				p.AtListIndex(i),
+				"Duplicate Metric Ruleset Rule",
+				fmt.Sprintf("The rule %q is also used by rule %d, each rule must have a unique name since rules are matched by name.", key, j),
+			)
+		}
+	}
+}
+
+func stringValue(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func int64Value(v *int64) types.String {
+	if v == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(strconv.FormatInt(*v, 10))
+}
+
+func versionString(v *int64) string {
+	return int64Value(v).ValueString()
+}
+
+func valueStrings(values []types.String) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, v.ValueString())
+	}
+	return out
+}
+
+// stringValues always returns a value, since the sets are required.
+func stringValues(values []string) []types.String {
+	out := make([]types.String, 0, len(values))
+	for _, v := range values {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
@@ -0,0 +1,414 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwmetricruleset
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/signalfx/signalfx-go/metric_ruleset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func newTestResourceMetricRuleset(t *testing.T, meta *pmeta.Meta) *ResourceMetricRuleset {
+	rs := NewResourceMetricRuleset().(*ResourceMetricRuleset)
+
+	if meta == nil {
+		meta = &pmeta.Meta{Registry: feature.NewRegistry()}
+	}
+	resp := &resource.ConfigureResponse{}
+	rs.Configure(context.Background(), resource.ConfigureRequest{ProviderData: meta}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Must not error configuring resource")
+
+	return rs
+}
+
+func newTestMetricRulesetSchema(t *testing.T) resource.SchemaResponse {
+	resp := resource.SchemaResponse{}
+	NewResourceMetricRuleset().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "Must not error loading schema")
+	return resp
+}
+
+// newTestMetricRulesetState writes the model into a state, which fails when the model does not match the schema.
+func newTestMetricRulesetState(t *testing.T, model *resourceMetricRulesetModel) tfsdk.State {
+	s := newTestMetricRulesetSchema(t).Schema
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	diags := state.Set(context.Background(), model)
+	require.False(t, diags.HasError(), "Must not error setting the model: %v", diags)
+	return state
+}
+
+func newTestAggregationRule(name, output string, drop bool, dims ...string) aggregationRuleModel {
+	return aggregationRuleModel{
+		Name:        stringValue(name),
+		Description: types.StringNull(),
+		Enabled:     types.BoolValue(true),
+		Matcher: []matcherModel{{
+			Type: types.StringValue("dimension"),
+			Filters: []filterModel{{
+				Not:           types.BoolValue(false),
+				Property:      types.StringValue("realm"),
+				PropertyValue: []types.String{types.StringValue("us-east-1")},
+			}},
+		}},
+		Aggregator: []aggregatorModel{{
+			Type:           types.StringValue("rollup"),
+			OutputName:     types.StringValue(output),
+			Dimensions:     stringValues(dims),
+			DropDimensions: types.BoolValue(drop),
+		}},
+	}
+}
+
+func newTestExceptionRule(name string) exceptionRuleModel {
+	return exceptionRuleModel{
+		Name:        types.StringValue(name),
+		Description: types.StringNull(),
+		Enabled:     types.BoolValue(true),
+		Matcher: []matcherModel{{
+			Type:    types.StringValue("dimension"),
+			Filters: []filterModel{},
+		}},
+		Restoration: []restorationModel{},
+	}
+}
+
+func newTestMetricRulesetModel() resourceMetricRulesetModel {
+	return resourceMetricRulesetModel{
+		Id:                types.StringValue("ruleset-01"),
+		MetricName:        types.StringValue("cpu.utilization"),
+		Description:       types.StringValue("Routing ruleset for cpu.utilization"),
+		Version:           types.StringValue("2"),
+		Creator:           types.StringValue("user-01"),
+		Created:           types.StringValue("1700000000000"),
+		LastUpdatedBy:     types.StringValue("user-01"),
+		LastUpdated:       types.StringValue("1700000001000"),
+		LastUpdatedByName: types.StringValue("Jamie"),
+		AggregationRules: []aggregationRuleModel{
+			newTestAggregationRule("by service", "cpu.utilization.by.service", false, "service"),
+			newTestAggregationRule("", "cpu.utilization.by.region", true, "host"),
+		},
+		ExceptionRules: []exceptionRuleModel{
+			newTestExceptionRule("us-east-2"),
+			newTestExceptionRule("eu-west-1"),
+		},
+		RoutingRule: []routingRuleModel{{Destination: types.StringValue("Archived")}},
+	}
+}
+
+// newTestMetricRulesetResponse returns the API response for the model,
+// with the audit fields the API sets.
+func newTestMetricRulesetResponse(t *testing.T, model *resourceMetricRulesetModel) *metric_ruleset.GetMetricRulesetResponse {
+	req, diags := NewResourceMetricRuleset().(*ResourceMetricRuleset).encode(context.Background(), model)
+	require.False(t, diags.HasError(), "Must not error encoding metric ruleset: %v", diags)
+
+	resp := metric_ruleset.GetMetricRulesetResponse{
+		Id:                model.Id.ValueStringPointer(),
+		MetricName:        req.MetricName,
+		Description:       req.Description,
+		AggregationRules:  req.AggregationRules,
+		ExceptionRules:    req.ExceptionRules,
+		RoutingRule:       req.RoutingRule,
+		Version:           req.Version,
+		Creator:           model.Creator.ValueStringPointer(),
+		LastUpdatedBy:     model.LastUpdatedBy.ValueStringPointer(),
+		LastUpdatedByName: model.LastUpdatedByName.ValueStringPointer(),
+	}
+	created, updated := int64(1700000000000), int64(1700000001000)
+	resp.Created, resp.LastUpdated = &created, &updated
+	return &resp
+}
+
+func TestResourceMetricRulesetMetadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &resource.MetadataResponse{}
+	NewResourceMetricRuleset().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "signalfx"}, resp)
+
+	assert.Equal(t, "signalfx_metric_ruleset", resp.TypeName, "Must match the expected type name")
+}
+
+func TestResourceMetricRulesetSchema(t *testing.T) {
+	t.Parallel()
+
+	resp := newTestMetricRulesetSchema(t)
+	assert.False(t, resp.Schema.ValidateImplementation(context.Background()).HasError(), "Must be a valid schema")
+	assert.Equal(t, int64(0), resp.Schema.Version, "Must read the state written by the SDK implementation")
+
+	model := newTestMetricRulesetModel()
+	newTestMetricRulesetState(t, &model)
+}
+
+func TestResourceMetricRulesetValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		modify func(model *resourceMetricRulesetModel)
+		errors int
+	}{
+		{
+			name:   "unique rules",
+			modify: func(*resourceMetricRulesetModel) {},
+			errors: 0,
+		},
+		{
+			name: "duplicate aggregation rule names",
+			modify: func(model *resourceMetricRulesetModel) {
+				model.AggregationRules[1].Name = types.StringValue("by service")
+			},
+			errors: 1,
+		},
+		{
+			name: "unnamed aggregation rules with the same output",
+			modify: func(model *resourceMetricRulesetModel) {
+				model.AggregationRules = append(model.AggregationRules, newTestAggregationRule("", "cpu.utilization.by.region", false, "region"))
+			},
+			errors: 1,
+		},
+		{
+			name: "duplicate exception rule names",
+			modify: func(model *resourceMetricRulesetModel) {
+				model.ExceptionRules[1].Name = types.StringValue("us-east-2")
+			},
+			errors: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			model := newTestMetricRulesetModel()
+			tc.modify(&model)
+			state := newTestMetricRulesetState(t, &model)
+
+			resp := &resource.ValidateConfigResponse{}
+			newTestResourceMetricRuleset(t, nil).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected validation result: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestResourceMetricRulesetEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	rs := newTestResourceMetricRuleset(t, nil)
+	expect := newTestMetricRulesetModel()
+	details := newTestMetricRulesetResponse(t, &expect)
+	assert.Equal(t, int64(2), details.GetVersion(), "Must encode the version")
+
+	// Rules reordered in the UI are read back in the configured order.
+	details.AggregationRules[0], details.AggregationRules[1] = details.AggregationRules[1], details.AggregationRules[0]
+	details.ExceptionRules[0], details.ExceptionRules[1] = details.ExceptionRules[1], details.ExceptionRules[0]
+
+	actual := newTestMetricRulesetModel()
+	diags := rs.decode(context.Background(), details, &actual)
+	require.False(t, diags.HasError(), "Must not error decoding metric ruleset: %v", diags)
+	assert.Equal(t, expect, actual, "Must match the model after the round trip")
+	newTestMetricRulesetState(t, &actual)
+}
+
+func TestResourceMetricRulesetDecodeImport(t *testing.T) {
+	t.Parallel()
+
+	rs := newTestResourceMetricRuleset(t, nil)
+	model := newTestMetricRulesetModel()
+	details := newTestMetricRulesetResponse(t, &model)
+	details.ExceptionRules[0].Restoration = &metric_ruleset.ExceptionRuleRestorationFields{
+		RestorationId: new(string),
+		StartTime:     new(int64),
+	}
+	*details.ExceptionRules[0].Restoration.StartTime = 1700000000000
+
+	var imported resourceMetricRulesetModel
+	diags := rs.decode(context.Background(), details, &imported)
+	require.False(t, diags.HasError(), "Must not error decoding metric ruleset: %v", diags)
+	assert.Equal(t, "cpu.utilization.by.service", imported.AggregationRules[0].Aggregator[0].OutputName.ValueString(), "Must keep the API order without prior rules")
+	assert.Equal(t, []restorationModel{{
+		RestorationId: types.StringNull(),
+		StartTime:     types.StringValue("1700000000000"),
+		StopTime:      types.StringNull(),
+	}}, imported.ExceptionRules[0].Restoration, "Must read the restoration without a stop time")
+	assert.Empty(t, imported.ExceptionRules[1].Restoration, "Must not read an empty restoration")
+	newTestMetricRulesetState(t, &imported)
+}
+
+func TestResourceMetricRulesetEncodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	rs := newTestResourceMetricRuleset(t, nil)
+
+	model := newTestMetricRulesetModel()
+	model.Version = types.StringValue("latest")
+	_, diags := rs.encode(context.Background(), &model)
+	assert.True(t, diags.HasError(), "Must error on an invalid version")
+
+	model = newTestMetricRulesetModel()
+	model.ExceptionRules[0].Restoration = []restorationModel{{
+		RestorationId: types.StringUnknown(),
+		StartTime:     types.StringValue("yesterday"),
+		StopTime:      types.StringNull(),
+	}}
+	_, diags = rs.encode(context.Background(), &model)
+	assert.True(t, diags.HasError(), "Must error on an invalid restoration")
+}
+
+func TestOrderRules(t *testing.T) {
+	t.Parallel()
+
+	key := func(s string) string { return s }
+	assert.Equal(t, []string{"b", "a", "c", "d"}, orderRules([]string{"a", "c", "b", "d"}, key, []string{"b", "a"}), "Must order the prior rules first")
+	assert.Equal(t, []string{"c", "a"}, orderRules([]string{"c", "a"}, key, nil), "Must keep the API order without prior rules")
+}
+
+func newTestMetricRulesetRoutes(version int64, updates *atomic.Int32) map[string]http.HandlerFunc {
+	model := newTestMetricRulesetModel()
+	return map[string]http.HandlerFunc{
+		"GET /v2/metricruleset/ruleset-01": func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":         "ruleset-01",
+				"metricName": model.MetricName.ValueString(),
+				"version":    version,
+			})
+		},
+		"PUT /v2/metricruleset/ruleset-01": func(w http.ResponseWriter, r *http.Request) {
+			updates.Add(1)
+			var req metric_ruleset.UpdateMetricRulesetRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.GetVersion() != version {
+				http.Error(w, "version conflict", http.StatusConflict)
+				return
+			}
+			next := req.GetVersion() + 1
+			_ = json.NewEncoder(w).Encode(metric_ruleset.UpdateMetricRulesetResponse{
+				Id:               model.Id.ValueStringPointer(),
+				MetricName:       req.MetricName,
+				Description:      req.Description,
+				AggregationRules: req.AggregationRules,
+				ExceptionRules:   req.ExceptionRules,
+				RoutingRule:      req.RoutingRule,
+				Version:          &next,
+			})
+		},
+	}
+}
+
+func TestResourceMetricRulesetUpdate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		remote  int64
+		version string
+		updates int32
+		errors  bool
+	}{
+		{
+			name:    "matching version",
+			remote:  2,
+			version: "3",
+			updates: 1,
+			errors:  false,
+		},
+		{
+			name:    "changed outside of terraform",
+			remote:  5,
+			updates: 0,
+			errors:  true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var updates atomic.Int32
+			meta := tftest.NewTestHTTPMockMeta(newTestMetricRulesetRoutes(tc.remote, &updates))(t).(*pmeta.Meta)
+			rs := newTestResourceMetricRuleset(t, meta)
+
+			prior := newTestMetricRulesetModel()
+			state := newTestMetricRulesetState(t, &prior)
+
+			planned := newTestMetricRulesetModel()
+			planned.Description = types.StringValue("Updated routing ruleset")
+			planned.Version = types.StringUnknown()
+			plan := newTestMetricRulesetState(t, &planned)
+
+			resp := &resource.UpdateResponse{State: state}
+			rs.Update(context.Background(), resource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+				State: state,
+			}, resp)
+
+			assert.Equal(t, tc.errors, resp.Diagnostics.HasError(), "Must match the expected update result: %v", resp.Diagnostics)
+			assert.Equal(t, tc.updates, updates.Load(), "Must match the expected number of updates")
+			if tc.errors {
+				assert.Equal(t, diag.Diagnostics{diag.NewErrorDiagnostic(
+					"Metric Ruleset Conflict",
+					`The metric ruleset "ruleset-01" was changed outside of Terraform after it was last read, `+
+						"the state has version 2 but the current version is 5. "+
+						"Refresh the state and review the plan before applying the changes again.",
+				)}, resp.Diagnostics, "Must report the conflict")
+				return
+			}
+
+			var actual resourceMetricRulesetModel
+			require.False(t, resp.State.Get(context.Background(), &actual).HasError(), "Must read the updated state")
+			assert.Equal(t, tc.version, actual.Version.ValueString(), "Must store the version returned by the update")
+			assert.Equal(t, "Updated routing ruleset", actual.Description.ValueString(), "Must store the updated values")
+		})
+	}
+}
+
+func TestResourceMetricRulesetModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/chart": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"count":1,"results":[
+				{"id":"chart-01","name":"CPU by host","programText":"data('cpu.utilization').mean(by=['host']).publish()"}
+			]}`))
+		},
+		"GET /v2/detector": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"count":0,"results":[]}`))
+		},
+	})(t).(*pmeta.Meta)
+	meta.Registry = feature.NewRegistry()
+	meta.Registry.MustRegister(feature.PreviewMetricRulesetImpact).SetEnabled(true)
+	rs := newTestResourceMetricRuleset(t, meta)
+
+	model := newTestMetricRulesetModel()
+	plan := newTestMetricRulesetState(t, &model)
+	empty := tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Schema.Type().TerraformType(context.Background()), nil)}
+
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}
+	rs.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State:  empty,
+	}, resp)
+	require.Len(t, resp.Diagnostics, 1, "Must warn about the affected chart: %v", resp.Diagnostics)
+	assert.Equal(t, diag.SeverityWarning, resp.Diagnostics[0].Severity(), "Must not block the plan")
+	assert.Equal(t, `Metric Ruleset Drops Dimensions Used by chart "CPU by host"`, resp.Diagnostics[0].Summary(), "Must name the affected chart")
+
+	resp = &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}
+	rs.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		State:  plan,
+	}, resp)
+	assert.Empty(t, resp.Diagnostics, "Must not analyze unchanged aggregation rules")
+}
@@ -6,14 +6,17 @@ package internalframework
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -21,11 +24,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/signalfx/signalfx-go"
 
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/builtincontent"
+	fwchart "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/chart"
+	fwexport "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/export"
 	internalfunction "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/function"
+	fwlist "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/listresource"
+	fwmetricruleset "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/metricruleset"
+	fwslo "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/slo"
 	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
 	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/track"
@@ -34,11 +43,14 @@ import (
 type ollyProvider struct {
 	version  string
 	features *feature.Registry
+	legacy   map[string]*sdkschema.Resource
+	decoder  fwexport.Decoder
 }
 
 var (
 	_ provider.Provider                   = (*ollyProvider)(nil)
 	_ provider.ProviderWithFunctions      = (*ollyProvider)(nil)
+	_ provider.ProviderWithListResources  = (*ollyProvider)(nil)
 	_ provider.ProviderWithValidateConfig = (*ollyProvider)(nil)
 )
 
@@ -104,6 +116,10 @@ func (op *ollyProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:    true,
 				Description: "Required if the user is configured to be part of multiple organizations",
 			},
+			"realm": schema.StringAttribute{
+				Optional:    true,
+				Description: "The realm of your Splunk Observability Cloud org, used as part of the resource identity when it can not be determined from the API URL",
+			},
 			"feature_preview": schema.MapAttribute{
 				ElementType: types.BoolType,
 				Optional:    true,
@@ -155,6 +171,7 @@ func (op *ollyProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		Email:          model.Email.ValueString(),
 		Password:       model.Password.ValueString(),
 		OrganizationID: model.OrganizationID.ValueString(),
+		Realm:          model.Realm.ValueString(),
 	}
 
 	for _, val := range model.Tags.Elements() {
@@ -217,10 +234,11 @@ func (op *ollyProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		MaxIdleConnsPerHost: 100,
 	})
 
+	meta.HTTPClient = rc.StandardClient()
 	meta.Client, err = signalfx.NewClient(
 		token,
 		signalfx.APIUrl(meta.APIURL),
-		signalfx.HTTPClient(rc.StandardClient()),
+		signalfx.HTTPClient(meta.HTTPClient),
 		signalfx.UserAgent(fmt.Sprintf("Terraform %s terraform-provider-signalfx/%s", req.TerraformVersion, op.version)),
 	)
 
@@ -268,25 +286,72 @@ func (op *ollyProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	resp.DataSourceData = meta
 	resp.ResourceData = meta
 	resp.EphemeralResourceData = meta
+	resp.ListResourceData = meta
 }
 
 func (op *ollyProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	// To implement: Register data sources.
-	return []func() datasource.DataSource{
+	datasources := []func() datasource.DataSource{
 		builtincontent.NewDashboardGroupsDataSource,
 		builtincontent.NewAutoDetectorDataSource,
+		fwexport.NewDashboardSnapshotDataSource,
+	}
+	if op.decoder != nil {
+		datasources = append(datasources, fwexport.NewDashboardExportDataSource(op.decoder))
 	}
+	return datasources
 }
 
 func (op *ollyProvider) Resources(ctx context.Context) []func() resource.Resource {
-	// To implement: Register resources.
-	return nil
+	return []func() resource.Resource{
+		fwchart.NewResourceChart,
+		fwmetricruleset.NewResourceMetricRuleset,
+		fwslo.NewResourceSlo,
+	}
+}
+
+func (op *ollyProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	searches := map[string]fwlist.SearchFunc{
+		"detector":           fwlist.SearchDetectors,
+		"dashboard":          fwlist.SearchDashboards,
+		"dashboard_group":    fwlist.SearchDashboardGroups,
+		"time_chart":         fwlist.NewChartSearch("TimeSeriesChart"),
+		"list_chart":         fwlist.NewChartSearch("List"),
+		"single_value_chart": fwlist.NewChartSearch("SingleValue"),
+		"heatmap_chart":      fwlist.NewChartSearch("Heatmap"),
+		"text_chart":         fwlist.NewChartSearch("Text"),
+		"table_chart":        fwlist.NewChartSearch("TableChart"),
+		"event_feed_chart":   fwlist.NewChartSearch("Event"),
+		"log_view":           fwlist.NewChartSearch("LogsChart"),
+		"log_timeline":       fwlist.NewChartSearch("LogsTimeSeriesChart"),
+		"slo_chart":          fwlist.NewChartSearch("SloChart"),
+		"team":               fwlist.SearchTeams,
+		"alert_muting_rule":  fwlist.SearchAlertMutingRules,
+	}
+
+	// The list resources rely on the legacy resource schemas,
+	// so only resources that have been provided are able to be listed.
+	var resources []func() list.ListResource
+	for _, name := range slices.Sorted(maps.Keys(searches)) {
+		if legacy, ok := op.legacy["signalfx_"+name]; ok {
+			resources = append(resources, fwlist.NewListResource(name, legacy, searches[name]))
+		}
+	}
+	// Resources defined using the framework provide their own schemas.
+	resources = append(resources, fwlist.NewListResource("chart", nil, fwlist.NewChartSearch(fwchart.APITypes()...)))
+	return resources
 }
 
 func (op *ollyProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{
+	functions := []func() function.Function{
 		internalfunction.NewTimeRangeParser,
+		internalfunction.NewNotificationParser,
+	}
+	functions = append(functions, internalfunction.NewNotificationBuilders()...)
+	if op.decoder != nil {
+		functions = append(functions, internalfunction.NewDashboardExportDecoder(op.decoder))
 	}
+	return functions
 }
 
 func (op *ollyProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
@@ -20,6 +20,7 @@ type OllyProviderModel struct {
 	Email               types.String `tfsdk:"email"`
 	Password            types.String `tfsdk:"password"`
 	OrganizationID      types.String `tfsdk:"organization_id"`
+	Realm               types.String `tfsdk:"realm"`
 	FeaturePreview      types.Map    `tfsdk:"feature_preview"`
 	Tags                types.List   `tfsdk:"tags"`
 	Teams               types.List   `tfsdk:"teams"`
@@ -37,6 +38,7 @@ func newDefaultOllyProviderModel() *OllyProviderModel {
 		Email:               types.StringNull(),
 		Password:            types.StringNull(),
 		OrganizationID:      types.StringNull(),
+		Realm:               types.StringNull(),
 		FeaturePreview:      types.MapNull(types.BoolType),
 		Tags:                types.ListNull(types.StringType),
 		Teams:               types.ListNull(types.StringType),
@@ -3,7 +3,12 @@
 
 package internalframework
 
-import "github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	fwexport "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/export"
+)
 
 type ProviderOption func(*ollyProvider)
 
@@ -12,3 +17,19 @@ func WithProviderFeatureRegistry(reg *feature.Registry) ProviderOption {
 		p.features = reg
 	}
 }
+
+// WithProviderLegacyResources provides the resources defined by the SDKv2 provider
+// so that the framework provider can offer functionality for them, such as list resources.
+func WithProviderLegacyResources(resources map[string]*schema.Resource) ProviderOption {
+	return func(p *ollyProvider) {
+		p.legacy = resources
+	}
+}
+
+// WithProviderDashboardExportDecoder provides the conversion of the dashboards exported from the UI,
+// which relies on the mappers used by the SDKv2 resources to read the API objects.
+func WithProviderDashboardExportDecoder(decoder fwexport.Decoder) ProviderOption {
+	return func(p *ollyProvider) {
+		p.decoder = decoder
+	}
+}
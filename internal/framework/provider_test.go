@@ -15,10 +15,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	internalfunction "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/function"
 	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
 )
 
@@ -36,6 +38,7 @@ func NewTestConfig(p provider.Provider, values map[string]tftypes.Value) tfsdk.C
 		"email":                  tftypes.NewValue(tftypes.String, nil),
 		"password":               tftypes.NewValue(tftypes.String, nil),
 		"organization_id":        tftypes.NewValue(tftypes.String, nil),
+		"realm":                  tftypes.NewValue(tftypes.String, nil),
 		"feature_preview":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.Bool}, nil),
 		"tags":                   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
 		"teams":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
@@ -56,6 +59,7 @@ func NewTestConfig(p provider.Provider, values map[string]tftypes.Value) tfsdk.C
 					"email":                  tftypes.String,
 					"password":               tftypes.String,
 					"organization_id":        tftypes.String,
+					"realm":                  tftypes.String,
 					"feature_preview":        tftypes.Map{ElementType: tftypes.Bool},
 					"tags":                   tftypes.List{ElementType: tftypes.String},
 					"teams":                  tftypes.List{ElementType: tftypes.String},
@@ -71,6 +75,7 @@ func NewTestConfig(p provider.Provider, values map[string]tftypes.Value) tfsdk.C
 					"email":                  {},
 					"password":               {},
 					"organization_id":        {},
+					"realm":                  {},
 					"feature_preview":        {},
 					"tags":                   {},
 					"teams":                  {},
@@ -115,7 +120,12 @@ func TestProviderDataSources(t *testing.T) {
 
 	p := NewProvider("1.0.0")
 
-	assert.Len(t, p.DataSources(context.Background()), 2, "Must return exactly two data sources")
+	assert.Len(t, p.DataSources(context.Background()), 3, "Must return exactly three data sources")
+
+	p = NewProvider("1.0.0", WithProviderDashboardExportDecoder(func([]byte) (map[string]any, error) {
+		return nil, nil
+	}))
+	assert.Len(t, p.DataSources(context.Background()), 4, "Must include the dashboard export data source")
 }
 
 func TestProviderResource(t *testing.T) {
@@ -123,7 +133,21 @@ func TestProviderResource(t *testing.T) {
 
 	p := NewProvider("1.0.0")
 
-	assert.Empty(t, p.Resources(context.Background()), "Must not return any values")
+	assert.Len(t, p.Resources(context.Background()), 3, "Must return the registered resources")
+}
+
+func TestProviderListResources(t *testing.T) {
+	t.Parallel()
+
+	p := NewProvider("1.0.0")
+	assert.Len(t, p.(provider.ProviderWithListResources).ListResources(context.Background()), 1, "Must only return framework list resources without legacy resources")
+
+	p = NewProvider("1.0.0", WithProviderLegacyResources(map[string]*sdkschema.Resource{
+		"signalfx_detector":  {},
+		"signalfx_team":      {},
+		"signalfx_data_link": {},
+	}))
+	assert.Len(t, p.(provider.ProviderWithListResources).ListResources(context.Background()), 3, "Must only return supported list resources")
 }
 
 func TestProviderFunctions(t *testing.T) {
@@ -132,9 +156,15 @@ func TestProviderFunctions(t *testing.T) {
 	p := NewProvider("1.0.0")
 	if fp, ok := p.(provider.ProviderWithFunctions); ok {
 		assert.NotNil(t, fp.Functions(context.Background()), "ProviderWithFunctions should return non-nil functions")
+		assert.Len(t, fp.Functions(context.Background()), 2+len(internalfunction.NewNotificationBuilders()), "Must not include functions that require a decoder")
 	} else {
 		assert.Fail(t, "Provider does not implement ProviderWithFunctions")
 	}
+
+	p = NewProvider("1.0.0", WithProviderDashboardExportDecoder(func([]byte) (map[string]any, error) {
+		return nil, nil
+	}))
+	assert.Len(t, p.(provider.ProviderWithFunctions).Functions(context.Background()), 3+len(internalfunction.NewNotificationBuilders()), "Must include the dashboard export function")
 }
 
 func TestProviderConfigure(t *testing.T) {
@@ -173,6 +203,7 @@ func TestProviderConfigure(t *testing.T) {
 					"email":                  tftypes.NewValue(tftypes.String, nil),
 					"password":               tftypes.NewValue(tftypes.String, nil),
 					"organization_id":        tftypes.NewValue(tftypes.String, nil),
+					"realm":                  tftypes.NewValue(tftypes.String, nil),
 					"feature_preview":        tftypes.NewValue(tftypes.Map{ElementType: tftypes.Bool}, nil),
 					"tags":                   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
 					"teams":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
@@ -184,6 +215,22 @@ func TestProviderConfigure(t *testing.T) {
 				AuthToken: "my-secret-token",
 			},
 		},
+		{
+			name: "Sets the realm",
+			data: func(_ *testing.T) map[string]tftypes.Value {
+				return map[string]tftypes.Value{
+					"api_url":    tftypes.NewValue(tftypes.String, "http://localhost"),
+					"auth_token": tftypes.NewValue(tftypes.String, "my-secret-token"),
+					"realm":      tftypes.NewValue(tftypes.String, "us1"),
+				}
+			},
+			issues: nil,
+			expect: &pmeta.Meta{
+				APIURL:    "http://localhost",
+				AuthToken: "my-secret-token",
+				Realm:     "us1",
+			},
+		},
 		{
 			name: "Sets operational values",
 			data: func(_ *testing.T) map[string]tftypes.Value {
@@ -349,6 +396,7 @@ func TestProviderConfigure(t *testing.T) {
 			assert.Equal(t, tc.expect.Email, meta.Email, "Email should match expected")
 			assert.Equal(t, tc.expect.Password, meta.Password, "Password should match expected")
 			assert.Equal(t, tc.expect.OrganizationID, meta.OrganizationID, "OrganizationID should match expected")
+			assert.Equal(t, tc.expect.Realm, meta.Realm, "Realm should match expected")
 			assert.Equal(t, tc.expect.Tags, meta.Tags, "Tags should match expected")
 			assert.Equal(t, tc.expect.Teams, meta.Teams, "Teams should match expected")
 		})
@@ -0,0 +1,214 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwshared
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BlockFromSDK converts an SDK list of nested resources into a list block,
+// so that block definitions shared with the SDK resources can be reused by the framework resources.
+// Nested resources are converted into blocks and the remaining fields into attributes,
+// the SDK validation functions are not carried over and must be checked separately.
+func BlockFromSDK(s *sdkschema.Schema) schema.ListNestedBlock {
+	block := schema.ListNestedBlock{
+		Description: s.Description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: make(map[string]schema.Attribute),
+			Blocks:     make(map[string]schema.Block),
+		},
+	}
+	if s.MinItems > 0 || s.MaxItems > 0 {
+		block.Validators = []validator.List{ListSizeBetween(s.MinItems, s.MaxItems)}
+	}
+
+	elem, ok := s.Elem.(*sdkschema.Resource)
+	if !ok {
+		return block
+	}
+	for name, field := range elem.SchemaMap() {
+		if nested, ok := field.Elem.(*sdkschema.Resource); ok && nested != nil {
+			block.NestedObject.Blocks[name] = BlockFromSDK(field)
+			continue
+		}
+		block.NestedObject.Attributes[name] = attributeFromSDK(field)
+	}
+	return block
+}
+
+func attributeFromSDK(s *sdkschema.Schema) schema.Attribute {
+	switch s.Type {
+	case sdkschema.TypeBool:
+		return schema.BoolAttribute{Required: s.Required, Optional: s.Optional, Sensitive: s.Sensitive, Description: s.Description}
+	case sdkschema.TypeInt:
+		return schema.Int64Attribute{Required: s.Required, Optional: s.Optional, Sensitive: s.Sensitive, Description: s.Description}
+	case sdkschema.TypeFloat:
+		return schema.Float64Attribute{Required: s.Required, Optional: s.Optional, Sensitive: s.Sensitive, Description: s.Description}
+	case sdkschema.TypeList, sdkschema.TypeSet:
+		var elem attr.Type = types.StringType
+		if e, ok := s.Elem.(*sdkschema.Schema); ok {
+			elem = attributeFromSDK(e).GetType()
+		}
+		return schema.ListAttribute{ElementType: elem, Required: s.Required, Optional: s.Optional, Sensitive: s.Sensitive, Description: s.Description}
+	default:
+		return schema.StringAttribute{Required: s.Required, Optional: s.Optional, Sensitive: s.Sensitive, Description: s.Description}
+	}
+}
+
+// ValueToSDK converts the value into the form read from an SDK resource,
+// where lists are []any, objects are map[string]any and numbers are float64.
+// Null and unknown values are returned as nil.
+func ValueToSDK(ctx context.Context, v attr.Value) (any, error) {
+	tfv, err := v.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return terraformToSDK(tfv)
+}
+
+func terraformToSDK(v tftypes.Value) (any, error) {
+	if v.IsNull() || !v.IsKnown() {
+		return nil, nil
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.String):
+		var s string
+		return s, v.As(&s)
+	case typ.Is(tftypes.Bool):
+		var b bool
+		return b, v.As(&b)
+	case typ.Is(tftypes.Number):
+		var n big.Float
+		if err := v.As(&n); err != nil {
+			return nil, err
+		}
+		f, _ := n.Float64()
+		return f, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		var items []tftypes.Value
+		if err := v.As(&items); err != nil {
+			return nil, err
+		}
+		values := make([]any, 0, len(items))
+		for _, item := range items {
+			value, err := terraformToSDK(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case typ.Is(tftypes.Object{}):
+		var fields map[string]tftypes.Value
+		if err := v.As(&fields); err != nil {
+			return nil, err
+		}
+		values := make(map[string]any, len(fields))
+		for k, field := range fields {
+			value, err := terraformToSDK(field)
+			if err != nil {
+				return nil, err
+			}
+			values[k] = value
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", v.Type())
+}
+
+// ValueFromSDK converts a value in the form used by the SDK resources into a value of the type,
+// it is the reverse of [ValueToSDK] and accepts slices and maps of any element type.
+// Empty strings and lists of primitives are converted to null since they are optional attributes,
+// where as lists of objects are blocks and are always set.
+func ValueFromSDK(ctx context.Context, typ attr.Type, v any) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfv, err := sdkToTerraform(typ.TerraformType(ctx), reflect.ValueOf(v))
+	if err != nil {
+		diags.AddError("Unable to convert value", err.Error())
+		return nil, diags
+	}
+	value, err := typ.ValueFromTerraform(ctx, tfv)
+	if err != nil {
+		diags.AddError("Unable to convert value", err.Error())
+	}
+	return value, diags
+}
+
+func sdkToTerraform(typ tftypes.Type, v reflect.Value) (tftypes.Value, error) {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer) {
+		if v.IsNil() {
+			v = reflect.Value{}
+			break
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		if !v.IsValid() || v.Kind() != reflect.String || v.String() == "" {
+			return tftypes.NewValue(typ, nil), nil
+		}
+		return tftypes.NewValue(typ, v.String()), nil
+	case typ.Is(tftypes.Bool):
+		if !v.IsValid() || v.Kind() != reflect.Bool {
+			return tftypes.NewValue(typ, nil), nil
+		}
+		return tftypes.NewValue(typ, v.Bool()), nil
+	case typ.Is(tftypes.Number):
+		switch {
+		case !v.IsValid():
+			return tftypes.NewValue(typ, nil), nil
+		case v.CanInt():
+			return tftypes.NewValue(typ, new(big.Float).SetInt64(v.Int())), nil
+		case v.CanFloat():
+			return tftypes.NewValue(typ, big.NewFloat(v.Float())), nil
+		}
+		return tftypes.Value{}, fmt.Errorf("unable to convert %s to a number", v.Type())
+	case typ.Is(tftypes.List{}):
+		elem := typ.(tftypes.List).ElementType
+		_, block := elem.(tftypes.Object)
+		if !v.IsValid() || v.Kind() != reflect.Slice || v.Len() == 0 {
+			if block {
+				return tftypes.NewValue(typ, []tftypes.Value{}), nil
+			}
+			return tftypes.NewValue(typ, nil), nil
+		}
+		items := make([]tftypes.Value, v.Len())
+		for i := range items {
+			item, err := sdkToTerraform(elem, v.Index(i))
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			items[i] = item
+		}
+		return tftypes.NewValue(typ, items), nil
+	case typ.Is(tftypes.Object{}):
+		if !v.IsValid() || v.Kind() != reflect.Map {
+			return tftypes.Value{}, fmt.Errorf("unable to convert %v to an object", v)
+		}
+		fields := make(map[string]tftypes.Value)
+		for k, t := range typ.(tftypes.Object).AttributeTypes {
+			field, err := sdkToTerraform(t, v.MapIndex(reflect.ValueOf(k)))
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", k, err)
+			}
+			fields[k] = field
+		}
+		return tftypes.NewValue(typ, fields), nil
+	}
+	return tftypes.Value{}, fmt.Errorf("unsupported type %s", typ)
+}
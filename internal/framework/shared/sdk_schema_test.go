@@ -0,0 +1,85 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwshared
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSDKSchema() *sdkschema.Schema {
+	return &sdkschema.Schema{
+		Type:        sdkschema.TypeList,
+		Optional:    true,
+		Description: "Notifications to send",
+		Elem: &sdkschema.Resource{
+			Schema: map[string]*sdkschema.Schema{
+				"email": {
+					Type:     sdkschema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &sdkschema.Resource{
+						Schema: map[string]*sdkschema.Schema{
+							"email":   {Type: sdkschema.TypeString, Required: true},
+							"cc":      {Type: sdkschema.TypeList, Optional: true, Elem: &sdkschema.Schema{Type: sdkschema.TypeString}},
+							"enabled": {Type: sdkschema.TypeBool, Optional: true},
+							"delay":   {Type: sdkschema.TypeInt, Optional: true},
+							"secret":  {Type: sdkschema.TypeString, Optional: true, Sensitive: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestBlockFromSDK(t *testing.T) {
+	t.Parallel()
+
+	block := BlockFromSDK(newTestSDKSchema())
+	assert.Equal(t, "Notifications to send", block.Description, "Must keep the description")
+	assert.Empty(t, block.Validators, "Must not limit the number of blocks")
+
+	email, ok := block.NestedObject.Blocks["email"].(schema.ListNestedBlock)
+	require.True(t, ok, "Must convert nested resources into blocks")
+	assert.Len(t, email.Validators, 1, "Must limit the number of nested blocks")
+	assert.Equal(t, schema.StringAttribute{Required: true}, email.NestedObject.Attributes["email"], "Must convert string fields")
+	assert.Equal(t, schema.ListAttribute{ElementType: types.StringType, Optional: true}, email.NestedObject.Attributes["cc"], "Must convert list fields")
+	assert.Equal(t, schema.BoolAttribute{Optional: true}, email.NestedObject.Attributes["enabled"], "Must convert bool fields")
+	assert.Equal(t, schema.Int64Attribute{Optional: true}, email.NestedObject.Attributes["delay"], "Must convert int fields")
+	assert.Equal(t, schema.StringAttribute{Optional: true, Sensitive: true}, email.NestedObject.Attributes["secret"], "Must keep sensitive fields")
+}
+
+func TestValueSDKRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	typ := BlockFromSDK(newTestSDKSchema()).Type()
+
+	value, diags := ValueFromSDK(t.Context(), typ, []map[string]any{
+		{"email": []any{map[string]any{"email": "oncall@example.com", "cc": []string{"lead@example.com"}, "delay": 5}}},
+		{"email": []any{map[string]any{"email": "team@example.com", "enabled": true}}},
+	})
+	require.False(t, diags.HasError(), "Must not error converting the value: %v", diags)
+	assert.Len(t, value.(types.List).Elements(), 2, "Must convert each block")
+
+	raw, err := ValueToSDK(t.Context(), value)
+	require.NoError(t, err, "Must not error converting the value")
+	assert.Equal(t, []any{
+		map[string]any{"email": []any{map[string]any{"email": "oncall@example.com", "cc": []any{"lead@example.com"}, "enabled": nil, "delay": 5.0, "secret": nil}}},
+		map[string]any{"email": []any{map[string]any{"email": "team@example.com", "cc": nil, "enabled": true, "delay": nil, "secret": nil}}},
+	}, raw, "Must convert the value back to the SDK form")
+
+	empty, diags := ValueFromSDK(t.Context(), typ, nil)
+	require.False(t, diags.HasError(), "Must not error converting an empty value")
+	assert.False(t, empty.IsNull(), "Must convert missing blocks to an empty list")
+	assert.Empty(t, empty.(types.List).Elements(), "Must convert missing blocks to an empty list")
+
+	_, diags = ValueFromSDK(t.Context(), typ, []any{"email"})
+	assert.True(t, diags.HasError(), "Must error converting an invalid value")
+}
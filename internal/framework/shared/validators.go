@@ -0,0 +1,175 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwshared
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type stringOneOf []string
+
+var _ validator.String = stringOneOf(nil)
+
+// StringOneOf validates that the configured value is one of the provided values.
+func StringOneOf(values ...string) validator.String {
+	return stringOneOf(values)
+}
+
+func (so stringOneOf) Description(context.Context) string {
+	return "value must be one of: " + strings.Join(so, ", ")
+}
+
+func (so stringOneOf) MarkdownDescription(ctx context.Context) string {
+	return so.Description(ctx)
+}
+
+func (so stringOneOf) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if !slices.Contains(so, req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.Path, so.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+type int64Between struct {
+	min, max int64
+}
+
+var _ validator.Int64 = int64Between{}
+
+// Int64Between validates that the configured value is within the inclusive range.
+func Int64Between(min, max int64) validator.Int64 {
+	return int64Between{min: min, max: max}
+}
+
+func (ib int64Between) Description(context.Context) string {
+	return fmt.Sprintf("value must be between %d and %d", ib.min, ib.max)
+}
+
+func (ib int64Between) MarkdownDescription(ctx context.Context) string {
+	return ib.Description(ctx)
+}
+
+func (ib int64Between) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if v := req.ConfigValue.ValueInt64(); v < ib.min || v > ib.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, ib.Description(ctx), v),
+		)
+	}
+}
+
+type float64Between struct {
+	min, max float64
+}
+
+var _ validator.Float64 = float64Between{}
+
+// Float64Between validates that the configured value is within the inclusive range.
+func Float64Between(min, max float64) validator.Float64 {
+	return float64Between{min: min, max: max}
+}
+
+func (fb float64Between) Description(context.Context) string {
+	return fmt.Sprintf("value must be between %g and %g", fb.min, fb.max)
+}
+
+func (fb float64Between) MarkdownDescription(ctx context.Context) string {
+	return fb.Description(ctx)
+}
+
+func (fb float64Between) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if v := req.ConfigValue.ValueFloat64(); v < fb.min || v > fb.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %g", req.Path, fb.Description(ctx), v),
+		)
+	}
+}
+
+type stringLengthBetween struct {
+	min, max int
+}
+
+var _ validator.String = stringLengthBetween{}
+
+// StringLengthBetween validates that the configured value has between min and max characters.
+func StringLengthBetween(min, max int) validator.String {
+	return stringLengthBetween{min: min, max: max}
+}
+
+func (sl stringLengthBetween) Description(context.Context) string {
+	return fmt.Sprintf("length must be between %d and %d", sl.min, sl.max)
+}
+
+func (sl stringLengthBetween) MarkdownDescription(ctx context.Context) string {
+	return sl.Description(ctx)
+}
+
+func (sl stringLengthBetween) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if n := len(req.ConfigValue.ValueString()); n < sl.min || n > sl.max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, sl.Description(ctx), n),
+		)
+	}
+}
+
+type listSizeBetween struct {
+	min, max int
+}
+
+var _ validator.List = listSizeBetween{}
+
+// ListSizeBetween validates that the configured list has between min and max elements,
+// a max of zero allows for any number of elements.
+func ListSizeBetween(min, max int) validator.List {
+	return listSizeBetween{min: min, max: max}
+}
+
+func (lb listSizeBetween) Description(context.Context) string {
+	if lb.max == 0 {
+		return fmt.Sprintf("list must contain at least %d elements", lb.min)
+	}
+	return fmt.Sprintf("list must contain between %d and %d elements", lb.min, lb.max)
+}
+
+func (lb listSizeBetween) MarkdownDescription(ctx context.Context) string {
+	return lb.Description(ctx)
+}
+
+func (lb listSizeBetween) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if n := len(req.ConfigValue.Elements()); n < lb.min || (lb.max > 0 && n > lb.max) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %d", req.Path, lb.Description(ctx), n),
+		)
+	}
+}
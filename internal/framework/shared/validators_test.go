@@ -0,0 +1,161 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwshared
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringOneOf(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		value  types.String
+		errors int
+	}{
+		{name: "null value", value: types.StringNull()},
+		{name: "unknown value", value: types.StringUnknown()},
+		{name: "allowed value", value: types.StringValue("Metric")},
+		{name: "invalid value", value: types.StringValue("metric"), errors: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var resp validator.StringResponse
+			StringOneOf("Metric", "Binary").ValidateString(t.Context(), validator.StringRequest{
+				Path:        path.Root("unit_prefix"),
+				ConfigValue: tc.value,
+			}, &resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors")
+		})
+	}
+}
+
+func TestInt64Between(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		value  types.Int64
+		errors int
+	}{
+		{name: "null value", value: types.Int64Null()},
+		{name: "unknown value", value: types.Int64Unknown()},
+		{name: "lower bound", value: types.Int64Value(1)},
+		{name: "upper bound", value: types.Int64Value(10)},
+		{name: "below range", value: types.Int64Value(0), errors: 1},
+		{name: "above range", value: types.Int64Value(11), errors: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var resp validator.Int64Response
+			Int64Between(1, 10).ValidateInt64(t.Context(), validator.Int64Request{
+				Path:        path.Root("value"),
+				ConfigValue: tc.value,
+			}, &resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors")
+		})
+	}
+}
+
+func TestFloat64Between(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		value  types.Float64
+		errors int
+	}{
+		{name: "null value", value: types.Float64Null()},
+		{name: "unknown value", value: types.Float64Unknown()},
+		{name: "lower bound", value: types.Float64Value(0)},
+		{name: "upper bound", value: types.Float64Value(100)},
+		{name: "below range", value: types.Float64Value(-0.5), errors: 1},
+		{name: "above range", value: types.Float64Value(100.5), errors: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var resp validator.Float64Response
+			Float64Between(0, 100).ValidateFloat64(t.Context(), validator.Float64Request{
+				Path:        path.Root("value"),
+				ConfigValue: tc.value,
+			}, &resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors")
+		})
+	}
+}
+
+func TestStringLengthBetween(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		value  types.String
+		errors int
+	}{
+		{name: "null value", value: types.StringNull()},
+		{name: "unknown value", value: types.StringUnknown()},
+		{name: "lower bound", value: types.StringValue("a")},
+		{name: "upper bound", value: types.StringValue("abcd")},
+		{name: "too short", value: types.StringValue(""), errors: 1},
+		{name: "too long", value: types.StringValue("abcde"), errors: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var resp validator.StringResponse
+			StringLengthBetween(1, 4).ValidateString(t.Context(), validator.StringRequest{
+				Path:        path.Root("value"),
+				ConfigValue: tc.value,
+			}, &resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors")
+		})
+	}
+}
+
+func TestListSizeBetween(t *testing.T) {
+	t.Parallel()
+
+	newList := func(n int) types.List {
+		values := make([]attr.Value, n)
+		for i := range values {
+			values[i] = types.StringValue("value")
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		value  types.List
+		max    int
+		errors int
+	}{
+		{name: "null value", value: types.ListNull(types.StringType), max: 1},
+		{name: "unknown value", value: types.ListUnknown(types.StringType), max: 1},
+		{name: "within range", value: newList(1), max: 1},
+		{name: "below range", value: newList(0), max: 1, errors: 1},
+		{name: "above range", value: newList(2), max: 1, errors: 1},
+		{name: "unbounded", value: newList(5)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var resp validator.ListResponse
+			ListSizeBetween(1, tc.max).ValidateList(t.Context(), validator.ListRequest{
+				Path:        path.Root("rule"),
+				ConfigValue: tc.value,
+			}, &resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.ErrorsCount(), "Must match the expected number of errors")
+		})
+	}
+}
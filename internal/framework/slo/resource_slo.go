@@ -0,0 +1,302 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/notification"
+	"github.com/signalfx/signalfx-go/slo"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	notificationdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/notification"
+	fwembed "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/embed"
+	fwshared "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/shared"
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+type ResourceSlo struct {
+	fwembed.ResourceCRUD[resourceSloModel, slo.SloObject, slo.SloObject]
+	fwembed.ResourceIDImporter
+}
+
+var (
+	_ resource.Resource                   = (*ResourceSlo)(nil)
+	_ resource.ResourceWithConfigure      = (*ResourceSlo)(nil)
+	_ resource.ResourceWithImportState    = (*ResourceSlo)(nil)
+	_ resource.ResourceWithIdentity       = (*ResourceSlo)(nil)
+	_ resource.ResourceWithValidateConfig = (*ResourceSlo)(nil)
+	_ resource.ResourceWithModifyPlan     = (*ResourceSlo)(nil)
+	_ resource.ResourceWithUpgradeState   = (*ResourceSlo)(nil)
+)
+
+func NewResourceSlo() resource.Resource {
+	rs := &ResourceSlo{}
+	rs.Encode = rs.encode
+	rs.Decode = rs.decode
+	rs.CreateFunc = func(ctx context.Context, client *signalfx.Client, req *slo.SloObject) (*slo.SloObject, error) {
+		return client.CreateSlo(ctx, req)
+	}
+	rs.ReadFunc = func(ctx context.Context, client *signalfx.Client, id string) (*slo.SloObject, error) {
+		return client.GetSlo(ctx, id)
+	}
+	rs.UpdateFunc = func(ctx context.Context, client *signalfx.Client, id string, req *slo.SloObject) (*slo.SloObject, error) {
+		return client.UpdateSlo(ctx, id, req)
+	}
+	rs.DeleteFunc = func(ctx context.Context, client *signalfx.Client, id string) error {
+		return client.DeleteSlo(ctx, id)
+	}
+	return rs
+}
+
+func (rs *ResourceSlo) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slo"
+}
+
+func (rs *ResourceSlo) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:     2,
+		Description: "Manages a Service Level Objective (SLO), which tracks the ratio of good events to total events against a target.",
+		Attributes: map[string]schema.Attribute{
+			"id": fwshared.ResourceIDAttribute(),
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the SLO.",
+				Validators: []validator.String{
+					fwshared.StringLengthBetween(1, 256),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "Description of the SLO.",
+				Validators: []validator.String{
+					fwshared.StringLengthBetween(0, 1024),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "Type of the SLO. Currently only `RequestBased` SLO is supported.",
+				Validators: []validator.String{
+					fwshared.StringOneOf(slo.RequestBased),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"input": schema.ListNestedBlock{
+				Description: "SignalFlow program and arguments text strings that define the streams used as successful event count and total event count.",
+				Validators: []validator.List{
+					fwshared.ListSizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"program_text": schema.StringAttribute{
+							CustomType: fwtypes.SignalFlowType{},
+							Required:   true,
+							Description: "SignalFlow program text for the SLO. More info at https://dev.splunk.com/observability/docs/signalflow. " +
+								"The program text must contain at least 2 data blocks, one for the total stream and one for the good stream, whose labels are specified by `good_events_label` and `total_events_label`.",
+							Validators: []validator.String{
+								fwshared.StringLengthBetween(18, 50000),
+							},
+						},
+						"good_events_label": schema.StringAttribute{
+							Optional:    true,
+							Description: "Label used in `program_text` that refers to the data block which contains the stream of successful events.",
+						},
+						"total_events_label": schema.StringAttribute{
+							Optional:    true,
+							Description: "Label used in `program_text` that refers to the data block which contains the stream of total events.",
+						},
+					},
+				},
+			},
+			"target": schema.ListNestedBlock{
+				Description: "Define target value of the service level indicator in the appropriate time period.",
+				Validators: []validator.List{
+					fwshared.ListSizeBetween(1, 1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "SLO target type can be the following type: `RollingWindow`, `CalendarWindow`.",
+							Validators: []validator.String{
+								fwshared.StringOneOf(slo.RollingWindowTarget, slo.CalendarWindowTarget),
+							},
+						},
+						"slo": schema.Float64Attribute{
+							Required:    true,
+							Description: "Target value in the form of a percentage.",
+							Validators: []validator.Float64{
+								fwshared.Float64Between(0, 100),
+							},
+						},
+						"compliance_period": schema.StringAttribute{
+							CustomType:  fwtypes.TimeRangeType{},
+							Optional:    true,
+							Description: "(Required for `RollingWindow` type) Compliance period of this SLO. This value must be within the range of 1d (1 days) to 30d (30 days), inclusive.",
+						},
+						"cycle_type": schema.StringAttribute{
+							Optional:    true,
+							Description: "(Required for `CalendarWindow` type) The cycle type of the calendar window, e.g. week, month.",
+							Validators: []validator.String{
+								fwshared.StringOneOf("week", "month"),
+							},
+						},
+						"cycle_start": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "(Optional for `CalendarWindow` type) It can be used to change the cycle start time. For example, you can specify sunday as the start of the week (instead of the default monday).",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"burn_rate_policy": newBurnRatePolicyBlock(),
+						"alert_rule": schema.ListNestedBlock{
+							Description: "SLO alert rules.",
+							Validators: []validator.List{
+								fwshared.ListSizeBetween(1, 0),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required:    true,
+										Description: "SLO alert rule type, must be one of: `BREACH`, `ERROR_BUDGET_LEFT`, `BURN_RATE`.",
+										Validators: []validator.String{
+											fwshared.StringOneOf(slo.BreachRule, slo.ErrorBudgetLeftRule, slo.BurnRateRule),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"rule": newRuleBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures that each rule only sets the parameters of its alert rule type.
+func (rs *ResourceSlo) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model resourceSloModel
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		// Blocks that are not known yet can not be read into the model,
+		// they are validated once they are known.
+		return
+	}
+
+	for i, target := range model.Target {
+		for j, ar := range target.AlertRule {
+			if ar.Type.IsUnknown() {
+				continue
+			}
+			if err := ar.validateParameters(); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("target").AtListIndex(i).AtName("alert_rule").AtListIndex(j),
+					"Invalid SLO Alert Rule Parameters",
+					err.Error(),
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan validates the planned SLO with the API, and when the preview is enabled,
+// checks that the integration credentials and teams referenced by the notifications exist.
+func (rs *ResourceSlo) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The resource is being destroyed or the configuration is not known yet.
+	if req.Plan.Raw.IsNull() || !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+	if meta := rs.Details(); meta == nil || meta.Client == nil {
+		return
+	}
+
+	var model resourceSloModel
+	if resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, diags := rs.encode(ctx, &model)
+	if resp.Diagnostics.Append(diags...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API checks that the name is unique, so an existing SLO is validated using a different name.
+	if !req.State.Raw.IsNull() {
+		payload.Name = payload.Name + time.Now().String()
+	}
+	if err := rs.Details().Client.ValidateSlo(ctx, payload); err != nil {
+		resp.Diagnostics.AddError("Invalid SLO", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(rs.validateNotifications(ctx, &model)...)
+}
+
+// validateNotifications reports the notifications of each rule that reference
+// an integration credential or team that does not exist.
+func (rs *ResourceSlo) validateNotifications(ctx context.Context, model *resourceSloModel) (diags diag.Diagnostics) {
+	validate := func(p path.Path, strs []types.String, blocks basetypes.ListValue, escalation []escalationModel) {
+		check := func(p path.Path, n *notification.Notification) {
+			if err := pmeta.ValidateNotificationReference(ctx, rs.Details(), n); err != nil {
+				diags.AddAttributeError(p, "Invalid Notification", err.Error())
+			}
+		}
+		checkStrings := func(p path.Path, strs []types.String) {
+			for i, s := range strs {
+				if n, err := common.NewNotificationFromString(s.ValueString()); err == nil {
+					check(p.AtListIndex(i), n)
+				}
+			}
+		}
+
+		checkStrings(p.AtName("notifications"), strs)
+		if !blocks.IsNull() {
+			for i, block := range blocks.Elements() {
+				raw, err := fwshared.ValueToSDK(ctx, block)
+				if err != nil {
+					continue
+				}
+				if items, err := notificationdef.DecodeTerraform([]any{raw}); err == nil {
+					check(p.AtName("notification").AtListIndex(i), items[0])
+				}
+			}
+		}
+		for _, e := range escalation {
+			for i, step := range e.Step {
+				checkStrings(p.AtName("escalation").AtListIndex(0).AtName("step").AtListIndex(i).AtName("notifications"), step.Notifications)
+			}
+		}
+	}
+
+	for i, target := range model.Target {
+		p := path.Root("target").AtListIndex(i)
+		for j, ar := range target.AlertRule {
+			for k, rule := range ar.Rule {
+				validate(p.AtName("alert_rule").AtListIndex(j).AtName("rule").AtListIndex(k), rule.Notifications, rule.Notification, rule.Escalation)
+			}
+		}
+		for _, policy := range target.BurnRatePolicy {
+			validate(p.AtName("burn_rate_policy").AtListIndex(0), policy.Notifications, types.ListNull(newNotificationBlock().NestedObject.Type()), nil)
+		}
+	}
+	return diags
+}
@@ -0,0 +1,274 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/slo"
+
+	fwshared "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/shared"
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+)
+
+// maxBurnWindow is the longest window the API accepts for a burn rate alert.
+const maxBurnWindow = 90 * 24 * time.Hour
+
+// burnWindow is one window pair of a multi-window, multi-burn-rate alert.
+type burnWindow struct {
+	long     string
+	short    string
+	burnRate float64
+}
+
+// burnRatePresets are the windows recommended by the Google SRE workbook,
+// which page when 2% of a 30 day error budget is spent within an hour or 5% within 6 hours.
+var burnRatePresets = map[string]burnWindow{
+	"fast_burn": {long: "1h", short: "5m", burnRate: 14.4},
+	"slow_burn": {long: "6h", short: "30m", burnRate: 6},
+}
+
+type burnRatePolicyModel struct {
+	Severity             types.String      `tfsdk:"severity"`
+	Description          types.String      `tfsdk:"description"`
+	Notifications        []types.String    `tfsdk:"notifications"`
+	Disabled             types.Bool        `tfsdk:"disabled"`
+	ParameterizedBody    types.String      `tfsdk:"parameterized_body"`
+	ParameterizedSubject types.String      `tfsdk:"parameterized_subject"`
+	RunbookURL           types.String      `tfsdk:"runbook_url"`
+	Tip                  types.String      `tfsdk:"tip"`
+	FastBurn             []burnWindowModel `tfsdk:"fast_burn"`
+	SlowBurn             []burnWindowModel `tfsdk:"slow_burn"`
+}
+
+type burnWindowModel struct {
+	LongWindow  fwtypes.TimeRange `tfsdk:"long_window"`
+	ShortWindow fwtypes.TimeRange `tfsdk:"short_window"`
+	BurnRate    types.Float64     `tfsdk:"burn_rate"`
+}
+
+func newBurnRatePolicyBlock() schema.ListNestedBlock {
+	attributes := newRuleMessageAttributes()
+	attributes["severity"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString("Critical"),
+		Description: "The severity of the alert, must be one of: Critical, Warning, Major, Minor, Info. Defaults to `Critical`.",
+		Validators: []validator.String{
+			fwshared.StringOneOf("Critical", "Major", "Minor", "Warning", "Info"),
+		},
+	}
+
+	return schema.ListNestedBlock{
+		Description: "Multi-window, multi-burn-rate alert using the windows recommended by the Google SRE workbook, which is expanded into a `BURN_RATE` alert rule.",
+		Validators: []validator.List{
+			fwshared.ListSizeBetween(0, 1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks: map[string]schema.Block{
+				"fast_burn": newBurnWindowBlock("fast_burn", "Window pair that alerts on a fast burn of the error budget"),
+				"slow_burn": newBurnWindowBlock("slow_burn", "Window pair that alerts on a slow burn of the error budget"),
+			},
+		},
+	}
+}
+
+func newBurnWindowBlock(name, description string) schema.ListNestedBlock {
+	preset := burnRatePresets[name]
+	return schema.ListNestedBlock{
+		Description: fmt.Sprintf("%s. Defaults to a %s long window and a %s short window at a %gx burn rate.", description, preset.long, preset.short, preset.burnRate),
+		Validators: []validator.List{
+			fwshared.ListSizeBetween(0, 1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"long_window": schema.StringAttribute{
+					CustomType:  fwtypes.TimeRangeType{},
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(preset.long),
+					Description: "Long window the burn rate is calculated over, which must be shorter than the compliance period.",
+				},
+				"short_window": schema.StringAttribute{
+					CustomType:  fwtypes.TimeRangeType{},
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(preset.short),
+					Description: "Short window used to stop the alert once the burn rate recovers, which must be shorter than the long window and longer than 1/30 of it.",
+				},
+				"burn_rate": schema.Float64Attribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     float64default.StaticFloat64(preset.burnRate),
+					Description: "Burn rate threshold, which must be at most 100/(100-SLO target).",
+				},
+			},
+		},
+	}
+}
+
+// window returns the window pair configured by the block, or the preset when it is not set.
+func window(name string, blocks []burnWindowModel) burnWindow {
+	w := burnRatePresets[name]
+	if len(blocks) > 0 {
+		w.long = blocks[0].LongWindow.ValueString()
+		w.short = blocks[0].ShortWindow.ValueString()
+		w.burnRate = blocks[0].BurnRate.ValueFloat64()
+	}
+	return w
+}
+
+// validate checks the window pair against the SLO target, values that are not known yet are skipped.
+func (w burnWindow) validate(name string, target float64, period time.Duration) error {
+	if limit := 100 / (100 - target); target < 100 && w.burnRate > limit {
+		return fmt.Errorf("%s burn_rate %g must be at most %g for a %g%% target", name, w.burnRate, limit, target)
+	}
+	if w.long == "" || w.short == "" {
+		return nil
+	}
+
+	long, err := timeRangeValue(w.long).ParseDuration()
+	if err != nil {
+		return fmt.Errorf("%s long_window: %w", name, err)
+	}
+	short, err := timeRangeValue(w.short).ParseDuration()
+	if err != nil {
+		return fmt.Errorf("%s short_window: %w", name, err)
+	}
+	switch {
+	case short >= long:
+		return fmt.Errorf("%s short_window %s must be shorter than long_window %s", name, w.short, w.long)
+	case short*30 <= long:
+		return fmt.Errorf("%s short_window %s must be longer than 1/30 of long_window %s", name, w.short, w.long)
+	case long >= maxBurnWindow:
+		return fmt.Errorf("%s long_window %s must be shorter than 90d", name, w.long)
+	case period > 0 && long >= period:
+		return fmt.Errorf("%s long_window %s must be shorter than the compliance period", name, w.long)
+	}
+	return nil
+}
+
+// period returns the length of the target's compliance period, using the shortest
+// length of a calendar cycle, or zero when the period is not known yet.
+func (tm *targetModel) period() time.Duration {
+	switch tm.Type.ValueString() {
+	case slo.RollingWindowTarget:
+		d, err := tm.CompliancePeriod.ParseDuration()
+		if err != nil {
+			return 0
+		}
+		return d
+	case slo.CalendarWindowTarget:
+		switch tm.CycleType.ValueString() {
+		case "week":
+			return 7 * 24 * time.Hour
+		case "month":
+			return 28 * 24 * time.Hour
+		}
+	}
+	return 0
+}
+
+func (bp *burnRatePolicyModel) rule() *ruleModel {
+	return &ruleModel{
+		Severity:             bp.Severity,
+		Description:          bp.Description,
+		Notifications:        bp.Notifications,
+		Disabled:             bp.Disabled,
+		ParameterizedBody:    bp.ParameterizedBody,
+		ParameterizedSubject: bp.ParameterizedSubject,
+		RunbookURL:           bp.RunbookURL,
+		Tip:                  bp.Tip,
+		Notification:         types.ListNull(newNotificationBlock().NestedObject.Type()),
+	}
+}
+
+// toAPI expands the burn rate policy into a `BURN_RATE` alert rule that uses
+// the fast burn windows as its first window pair and the slow burn windows as its second.
+func (bp *burnRatePolicyModel) toAPI(ctx context.Context, target *targetModel) (*slo.SloAlertRule, error) {
+	for _, ar := range target.AlertRule {
+		if ar.Type.ValueString() == slo.BurnRateRule {
+			return nil, fmt.Errorf("burn_rate_policy conflicts with an alert_rule of type %s", slo.BurnRateRule)
+		}
+	}
+
+	var (
+		fast = window("fast_burn", bp.FastBurn)
+		slow = window("slow_burn", bp.SlowBurn)
+	)
+	if err := fast.validate("burn_rate_policy fast_burn", target.Slo.ValueFloat64(), target.period()); err != nil {
+		return nil, err
+	}
+	if err := slow.validate("burn_rate_policy slow_burn", target.Slo.ValueFloat64(), target.period()); err != nil {
+		return nil, err
+	}
+
+	rule, err := bp.rule().toAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &slo.SloAlertRule{
+		BaseSloAlertRule: slo.BaseSloAlertRule{Type: slo.BurnRateRule},
+		BurnRateSloAlertRule: &slo.BurnRateSloAlertRule{
+			Rules: []*slo.BurnRateDetectorRule{{
+				Rule: *rule,
+				Parameters: &slo.BurnRateDetectorParameters{
+					LongWindow1:        fast.long,
+					ShortWindow1:       fast.short,
+					BurnRateThreshold1: fast.burnRate,
+					LongWindow2:        slow.long,
+					ShortWindow2:       slow.short,
+					BurnRateThreshold2: slow.burnRate,
+				},
+			}},
+		},
+	}, nil
+}
+
+// fromAPI reads the `BURN_RATE` alert rule back into the policy, the window pairs
+// are only read when the prior policy set them since they are otherwise the presets.
+func (bp *burnRatePolicyModel) fromAPI(ctx context.Context, rule *slo.BurnRateDetectorRule, prior *burnRatePolicyModel) error {
+	rm := prior.rule()
+	if diags := rm.fromAPI(ctx, &rule.Rule, prior.rule()); diags.HasError() {
+		return fmt.Errorf("unable to read burn_rate_policy: %v", diags)
+	}
+
+	*bp = burnRatePolicyModel{
+		Severity:             rm.Severity,
+		Description:          rm.Description,
+		Notifications:        rm.Notifications,
+		Disabled:             rm.Disabled,
+		ParameterizedBody:    rm.ParameterizedBody,
+		ParameterizedSubject: rm.ParameterizedSubject,
+		RunbookURL:           rm.RunbookURL,
+		Tip:                  rm.Tip,
+		FastBurn:             []burnWindowModel{},
+		SlowBurn:             []burnWindowModel{},
+	}
+	if p := rule.Parameters; p != nil {
+		if len(prior.FastBurn) > 0 {
+			bp.FastBurn = []burnWindowModel{{
+				LongWindow:  timeRangeValue(p.LongWindow1),
+				ShortWindow: timeRangeValue(p.ShortWindow1),
+				BurnRate:    types.Float64Value(p.BurnRateThreshold1),
+			}}
+		}
+		if len(prior.SlowBurn) > 0 {
+			bp.SlowBurn = []burnWindowModel{{
+				LongWindow:  timeRangeValue(p.LongWindow2),
+				ShortWindow: timeRangeValue(p.ShortWindow2),
+				BurnRate:    types.Float64Value(p.BurnRateThreshold2),
+			}}
+		}
+	}
+	return nil
+}
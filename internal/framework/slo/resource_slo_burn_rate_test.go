@@ -0,0 +1,111 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/slo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBurnWindowValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		window burnWindow
+		period time.Duration
+		errVal string
+	}{
+		{
+			name:   "preset",
+			window: burnRatePresets["fast_burn"],
+			period: 30 * 24 * time.Hour,
+		},
+		{
+			name:   "burn rate above the target limit",
+			window: burnWindow{long: "1h", short: "5m", burnRate: 200},
+			errVal: "fast_burn burn_rate 200 must be at most 100 for a 99% target",
+		},
+		{
+			name:   "short window not shorter",
+			window: burnWindow{long: "1h", short: "1h", burnRate: 1},
+			errVal: "fast_burn short_window 1h must be shorter than long_window 1h",
+		},
+		{
+			name:   "short window too short",
+			window: burnWindow{long: "1h", short: "1m", burnRate: 1},
+			errVal: "fast_burn short_window 1m must be longer than 1/30 of long_window 1h",
+		},
+		{
+			name:   "long window too long",
+			window: burnWindow{long: "91d", short: "7d", burnRate: 1},
+			errVal: "fast_burn long_window 91d must be shorter than 90d",
+		},
+		{
+			name:   "long window longer than the period",
+			window: burnWindow{long: "2d", short: "1d", burnRate: 1},
+			period: 24 * time.Hour,
+			errVal: "fast_burn long_window 2d must be shorter than the compliance period",
+		},
+		{
+			name:   "unknown windows",
+			window: burnWindow{burnRate: 1},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.window.validate("fast_burn", 99, tc.period)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must match the expected error")
+			} else {
+				assert.NoError(t, err, "Must not error validating the window")
+			}
+		})
+	}
+}
+
+func TestBurnRatePolicy(t *testing.T) {
+	t.Parallel()
+
+	target := newTestSloModel().Target[0]
+	target.AlertRule = nil
+	policy := burnRatePolicyModel{
+		Severity:      types.StringValue("Critical"),
+		Disabled:      types.BoolValue(false),
+		Notifications: []types.String{types.StringValue("Email,oncall@example.com")},
+		FastBurn:      []burnWindowModel{},
+		SlowBurn: []burnWindowModel{{
+			LongWindow:  timeRangeValue("12h"),
+			ShortWindow: timeRangeValue("1h"),
+			BurnRate:    types.Float64Value(3),
+		}},
+	}
+
+	rule, err := policy.toAPI(context.Background(), &target)
+	require.NoError(t, err, "Must not error expanding the policy")
+	require.NotNil(t, rule.BurnRateSloAlertRule, "Must expand into a burn rate alert rule")
+	assert.Equal(t, &slo.BurnRateDetectorParameters{
+		LongWindow1:        "1h",
+		ShortWindow1:       "5m",
+		BurnRateThreshold1: 14.4,
+		LongWindow2:        "12h",
+		ShortWindow2:       "1h",
+		BurnRateThreshold2: 3,
+	}, rule.BurnRateSloAlertRule.Rules[0].Parameters, "Must use the preset for the windows that are not set")
+
+	var actual burnRatePolicyModel
+	require.NoError(t, actual.fromAPI(context.Background(), rule.BurnRateSloAlertRule.Rules[0], &policy), "Must not error reading the policy")
+	assert.Equal(t, policy, actual, "Must match the policy after the round trip")
+
+	target.AlertRule = []alertRuleModel{{Type: types.StringValue(slo.BurnRateRule)}}
+	_, err = policy.toAPI(context.Background(), &target)
+	assert.EqualError(t, err, "burn_rate_policy conflicts with an alert_rule of type BURN_RATE", "Must error when a burn rate alert rule is also set")
+}
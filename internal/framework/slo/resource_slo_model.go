@@ -0,0 +1,325 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/slo"
+
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+)
+
+type resourceSloModel struct {
+	Id          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	Type        types.String  `tfsdk:"type"`
+	Input       []inputModel  `tfsdk:"input"`
+	Target      []targetModel `tfsdk:"target"`
+}
+
+type inputModel struct {
+	ProgramText      fwtypes.SignalFlow `tfsdk:"program_text"`
+	GoodEventsLabel  types.String       `tfsdk:"good_events_label"`
+	TotalEventsLabel types.String       `tfsdk:"total_events_label"`
+}
+
+type targetModel struct {
+	Type             types.String          `tfsdk:"type"`
+	Slo              types.Float64         `tfsdk:"slo"`
+	CompliancePeriod fwtypes.TimeRange     `tfsdk:"compliance_period"`
+	CycleType        types.String          `tfsdk:"cycle_type"`
+	CycleStart       types.String          `tfsdk:"cycle_start"`
+	AlertRule        []alertRuleModel      `tfsdk:"alert_rule"`
+	BurnRatePolicy   []burnRatePolicyModel `tfsdk:"burn_rate_policy"`
+}
+
+type alertRuleModel struct {
+	Type types.String `tfsdk:"type"`
+	Rule []ruleModel  `tfsdk:"rule"`
+}
+
+func (rs *ResourceSlo) encode(ctx context.Context, model *resourceSloModel) (*slo.SloObject, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	details := &slo.SloObject{
+		BaseSlo: slo.BaseSlo{
+			Name:        model.Name.ValueString(),
+			Description: model.Description.ValueString(),
+			Type:        model.Type.ValueString(),
+		},
+	}
+
+	switch details.Type {
+	case slo.RequestBased:
+		if len(model.Input) != 1 {
+			diags.AddAttributeError(path.Root("input"), "Invalid SLO Input", "Exactly one input must be set.")
+			return nil, diags
+		}
+		details.RequestBasedSlo = &slo.RequestBasedSlo{
+			Inputs: &slo.RequestBasedSloInput{
+				ProgramText:      model.Input[0].ProgramText.ValueString(),
+				GoodEventsLabel:  model.Input[0].GoodEventsLabel.ValueString(),
+				TotalEventsLabel: model.Input[0].TotalEventsLabel.ValueString(),
+			},
+		}
+	default:
+		diags.AddAttributeError(path.Root("type"), "Invalid SLO Type", fmt.Sprintf("The SLO type %q is not supported.", details.Type))
+		return nil, diags
+	}
+
+	for i, target := range model.Target {
+		p := path.Root("target").AtListIndex(i)
+
+		t := slo.SloTarget{
+			BaseSloTarget: slo.BaseSloTarget{
+				Slo:  target.Slo.ValueFloat64(),
+				Type: target.Type.ValueString(),
+			},
+		}
+		switch t.Type {
+		case slo.RollingWindowTarget:
+			t.RollingWindowSloTarget = &slo.RollingWindowSloTarget{
+				CompliancePeriod: target.CompliancePeriod.ValueString(),
+			}
+		case slo.CalendarWindowTarget:
+			t.CalendarWindowSloTarget = &slo.CalendarWindowSloTarget{
+				CycleType:  target.CycleType.ValueString(),
+				CycleStart: target.CycleStart.ValueString(),
+			}
+		default:
+			diags.AddAttributeError(p.AtName("type"), "Invalid SLO Target", fmt.Sprintf("The target type %q is not supported.", t.Type))
+			continue
+		}
+
+		for j, ar := range target.AlertRule {
+			rule, err := ar.toAPI(ctx)
+			if err != nil {
+				diags.AddAttributeError(p.AtName("alert_rule").AtListIndex(j), "Invalid SLO Alert Rule", err.Error())
+				continue
+			}
+			t.SloAlertRules = append(t.SloAlertRules, *rule)
+		}
+
+		if len(target.BurnRatePolicy) > 0 {
+			rule, err := target.BurnRatePolicy[0].toAPI(ctx, &target)
+			if err != nil {
+				diags.AddAttributeError(p.AtName("burn_rate_policy").AtListIndex(0), "Invalid SLO Burn Rate Policy", err.Error())
+				continue
+			}
+			t.SloAlertRules = append(t.SloAlertRules, *rule)
+		}
+
+		details.Targets = append(details.Targets, t)
+	}
+
+	return details, diags
+}
+
+// validateParameters ensures the rules only set the parameter block of the alert rule type.
+func (ar *alertRuleModel) validateParameters() error {
+	typ := ar.Type.ValueString()
+	for i, rule := range ar.Rule {
+		if name, ok := rule.parameterBlock(); ok && parameterBlocks[typ] != name {
+			return fmt.Errorf("rule %d sets %s, which can not be used with an alert_rule of type %s", i, name, typ)
+		}
+	}
+	return nil
+}
+
+func (ar *alertRuleModel) toAPI(ctx context.Context) (*slo.SloAlertRule, error) {
+	if err := ar.validateParameters(); err != nil {
+		return nil, err
+	}
+
+	out := &slo.SloAlertRule{
+		BaseSloAlertRule: slo.BaseSloAlertRule{Type: ar.Type.ValueString()},
+	}
+	switch out.Type {
+	case slo.BreachRule:
+		out.BreachSloAlertRule = &slo.BreachSloAlertRule{}
+	case slo.ErrorBudgetLeftRule:
+		out.ErrorBudgetLeftSloAlertRule = &slo.ErrorBudgetLeftSloAlertRule{}
+	case slo.BurnRateRule:
+		out.BurnRateSloAlertRule = &slo.BurnRateSloAlertRule{}
+	default:
+		return nil, fmt.Errorf("unsupported SLO alert rule type: %s", out.Type)
+	}
+
+	for i := range ar.Rule {
+		var err error
+		switch out.Type {
+		case slo.BreachRule:
+			var r *slo.BreachDetectorRule
+			if r, err = newBreachRule(ctx, &ar.Rule[i]); err == nil {
+				out.BreachSloAlertRule.Rules = append(out.BreachSloAlertRule.Rules, r)
+			}
+		case slo.ErrorBudgetLeftRule:
+			var r *slo.ErrorBudgetLeftDetectorRule
+			if r, err = newErrorBudgetLeftRule(ctx, &ar.Rule[i]); err == nil {
+				out.ErrorBudgetLeftSloAlertRule.Rules = append(out.ErrorBudgetLeftSloAlertRule.Rules, r)
+			}
+		case slo.BurnRateRule:
+			var r *slo.BurnRateDetectorRule
+			if r, err = newBurnRateRule(ctx, &ar.Rule[i]); err == nil {
+				out.BurnRateSloAlertRule.Rules = append(out.BurnRateSloAlertRule.Rules, r)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i, err)
+		}
+	}
+	return out, nil
+}
+
+func (rs *ResourceSlo) decode(ctx context.Context, details *slo.SloObject, model *resourceSloModel) (diags diag.Diagnostics) {
+	prior := *model
+
+	model.Id = types.StringValue(details.Id)
+	model.Name = types.StringValue(details.Name)
+	model.Description = stringValue(details.Description)
+	model.Type = types.StringValue(details.Type)
+
+	switch {
+	case details.RequestBasedSlo != nil && details.RequestBasedSlo.Inputs != nil:
+		input := details.RequestBasedSlo.Inputs
+		model.Input = []inputModel{{
+			ProgramText:      fwtypes.NewSignalFlowValue(input.ProgramText),
+			GoodEventsLabel:  stringValue(input.GoodEventsLabel),
+			TotalEventsLabel: stringValue(input.TotalEventsLabel),
+		}}
+	default:
+		diags.AddError("Unsupported SLO Type", fmt.Sprintf("The SLO %q has the unsupported type %q.", details.Id, details.Type))
+		return diags
+	}
+
+	model.Target = make([]targetModel, 0, len(details.Targets))
+	for i, target := range details.Targets {
+		var p targetModel
+		if i < len(prior.Target) {
+			p = prior.Target[i]
+		}
+
+		tm := targetModel{
+			Type:           types.StringValue(target.Type),
+			Slo:            types.Float64Value(target.Slo),
+			CycleType:      types.StringNull(),
+			CycleStart:     types.StringNull(),
+			BurnRatePolicy: []burnRatePolicyModel{},
+		}
+		tm.CompliancePeriod = timeRangeValue("")
+		switch {
+		case target.RollingWindowSloTarget != nil:
+			tm.CompliancePeriod = timeRangeValue(target.CompliancePeriod)
+		case target.CalendarWindowSloTarget != nil:
+			tm.CycleType = stringValue(target.CycleType)
+			tm.CycleStart = stringValue(target.CycleStart)
+		}
+
+		alertRules := orderAlertRules(target.SloAlertRules, p.AlertRule)
+
+		// A burn rate policy is expanded into the only `BURN_RATE` alert rule,
+		// so it is read back into the policy when the prior target set one.
+		if len(p.BurnRatePolicy) > 0 {
+			for j, ar := range alertRules {
+				if ar.BurnRateSloAlertRule == nil || len(ar.BurnRateSloAlertRule.Rules) != 1 {
+					continue
+				}
+				var policy burnRatePolicyModel
+				if err := policy.fromAPI(ctx, ar.BurnRateSloAlertRule.Rules[0], &p.BurnRatePolicy[0]); err != nil {
+					diags.AddError("Unable to read SLO", err.Error())
+					return diags
+				}
+				tm.BurnRatePolicy = []burnRatePolicyModel{policy}
+				alertRules = slices.Delete(alertRules, j, j+1)
+				break
+			}
+		}
+
+		tm.AlertRule = make([]alertRuleModel, 0, len(alertRules))
+		for _, ar := range alertRules {
+			var priorRules []ruleModel
+			for _, pr := range p.AlertRule {
+				if pr.Type.ValueString() == ar.Type {
+					priorRules = pr.Rule
+				}
+			}
+			rules, issues := decodeRules(ctx, ar, priorRules)
+			if diags.Append(issues...); diags.HasError() {
+				return diags
+			}
+			tm.AlertRule = append(tm.AlertRule, alertRuleModel{
+				Type: types.StringValue(ar.Type),
+				Rule: rules,
+			})
+		}
+		model.Target = append(model.Target, tm)
+	}
+	return diags
+}
+
+// orderAlertRules orders the API alert rules in the same order as the prior alert rules,
+// since the API can return them in any order, with any remaining alert rules ordered by their type.
+func orderAlertRules(rules []slo.SloAlertRule, prior []alertRuleModel) []slo.SloAlertRule {
+	position := func(typ string) int {
+		for i, ar := range prior {
+			if ar.Type.ValueString() == typ {
+				return i
+			}
+		}
+		return len(prior)
+	}
+
+	ordered := slices.Clone(rules)
+	slices.SortStableFunc(ordered, func(a, b slo.SloAlertRule) int {
+		if pa, pb := position(a.Type), position(b.Type); pa != pb {
+			return pa - pb
+		}
+		return strings.Compare(a.Type, b.Type)
+	})
+	return ordered
+}
+
+func stringValue(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+func timeRangeValue(v string) fwtypes.TimeRange {
+	if v == "" {
+		return fwtypes.TimeRange{StringValue: types.StringNull()}
+	}
+	return fwtypes.TimeRange{StringValue: types.StringValue(v)}
+}
+
+func valueStrings(values []types.String) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, v.ValueString())
+	}
+	return out
+}
+
+func stringValues(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]types.String, 0, len(values))
+	for _, v := range values {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
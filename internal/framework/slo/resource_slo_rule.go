@@ -0,0 +1,594 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/signalfx/signalfx-go/notification"
+	"github.com/signalfx/signalfx-go/slo"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	notificationdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/notification"
+	ruledef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/rule"
+	fwshared "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/shared"
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+)
+
+// parameterBlocks maps each alert rule type to the block that configures its parameters.
+var parameterBlocks = map[string]string{
+	slo.BreachRule:          "breach_parameters",
+	slo.ErrorBudgetLeftRule: "error_budget_left_parameters",
+	slo.BurnRateRule:        "burn_rate_parameters",
+}
+
+type ruleModel struct {
+	Severity                    types.String                     `tfsdk:"severity"`
+	Description                 types.String                     `tfsdk:"description"`
+	Notifications               []types.String                   `tfsdk:"notifications"`
+	Disabled                    types.Bool                       `tfsdk:"disabled"`
+	ParameterizedBody           types.String                     `tfsdk:"parameterized_body"`
+	ParameterizedSubject        types.String                     `tfsdk:"parameterized_subject"`
+	RunbookURL                  types.String                     `tfsdk:"runbook_url"`
+	Tip                         types.String                     `tfsdk:"tip"`
+	SkipClearNotificationStates []types.String                   `tfsdk:"skip_clear_notification_states"`
+	Notification                types.List                       `tfsdk:"notification"`
+	Escalation                  []escalationModel                `tfsdk:"escalation"`
+	ReminderNotification        []reminderNotificationModel      `tfsdk:"reminder_notification"`
+	BreachParameters            []breachParametersModel          `tfsdk:"breach_parameters"`
+	ErrorBudgetLeftParameters   []errorBudgetLeftParametersModel `tfsdk:"error_budget_left_parameters"`
+	BurnRateParameters          []burnRateParametersModel        `tfsdk:"burn_rate_parameters"`
+}
+
+type escalationModel struct {
+	Step []escalationStepModel `tfsdk:"step"`
+}
+
+type escalationStepModel struct {
+	AfterMinutes  types.Int64    `tfsdk:"after_minutes"`
+	Notifications []types.String `tfsdk:"notifications"`
+}
+
+type reminderNotificationModel struct {
+	IntervalMs types.Int64  `tfsdk:"interval_ms"`
+	TimeoutMs  types.Int64  `tfsdk:"timeout_ms"`
+	Type       types.String `tfsdk:"type"`
+}
+
+type breachParametersModel struct {
+	FireLasting      fwtypes.TimeRange `tfsdk:"fire_lasting"`
+	PercentOfLasting types.Float64     `tfsdk:"percent_of_lasting"`
+}
+
+type errorBudgetLeftParametersModel struct {
+	FireLasting            fwtypes.TimeRange `tfsdk:"fire_lasting"`
+	PercentOfLasting       types.Float64     `tfsdk:"percent_of_lasting"`
+	PercentErrorBudgetLeft types.Float64     `tfsdk:"percent_error_budget_left"`
+}
+
+type burnRateParametersModel struct {
+	ShortWindow1       fwtypes.TimeRange `tfsdk:"short_window_1"`
+	LongWindow1        fwtypes.TimeRange `tfsdk:"long_window_1"`
+	ShortWindow2       fwtypes.TimeRange `tfsdk:"short_window_2"`
+	LongWindow2        fwtypes.TimeRange `tfsdk:"long_window_2"`
+	BurnRateThreshold1 types.Float64     `tfsdk:"burn_rate_threshold_1"`
+	BurnRateThreshold2 types.Float64     `tfsdk:"burn_rate_threshold_2"`
+}
+
+func newSeverityAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:    true,
+		Description: description,
+		Validators: []validator.String{
+			fwshared.StringOneOf("Critical", "Major", "Minor", "Warning", "Info"),
+		},
+	}
+}
+
+func newNotificationsAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "List of strings specifying where notifications will be sent when an incident occurs. See https://developers.signalfx.com/v2/docs/detector-model#notifications-models for more info.",
+	}
+}
+
+func newNotificationBlock() schema.ListNestedBlock {
+	return fwshared.BlockFromSDK(notificationdef.NewSchema(
+		"Structured notifications sent when an incident occurs, as an alternative to `notifications`. Each notification sets exactly one notification type block.",
+	))
+}
+
+// newRuleMessageAttributes returns the rule attributes that describe the alert and its notification message,
+// which are shared between the alert rules and the burn rate policy.
+func newRuleMessageAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Description: "Description of the rule.",
+		},
+		"notifications": newNotificationsAttribute(),
+		"disabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "When true, notifications and events will not be generated for the rule. Defaults to `false`.",
+		},
+		"parameterized_body": schema.StringAttribute{
+			Optional:    true,
+			Description: "Custom notification message body when an alert is triggered. See https://developers.signalfx.com/v2/reference#detector-model for more info.",
+		},
+		"parameterized_subject": schema.StringAttribute{
+			Optional:    true,
+			Description: "Custom notification message subject when an alert is triggered. See https://developers.signalfx.com/v2/reference#detector-model for more info.",
+		},
+		"runbook_url": schema.StringAttribute{
+			Optional:    true,
+			Description: "URL of page to consult when an alert is triggered.",
+		},
+		"tip": schema.StringAttribute{
+			Optional:    true,
+			Description: "Plain text suggested first course of action, such as a command to execute.",
+		},
+	}
+}
+
+func newRuleBlock() schema.ListNestedBlock {
+	attributes := newRuleMessageAttributes()
+	attributes["severity"] = newSeverityAttribute("The severity of the rule, must be one of: Critical, Warning, Major, Minor, Info.")
+	attributes["skip_clear_notification_states"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "One or more alert clear states for which clear notifications are not sent (one or more of: OK, AUTO_RESOLVED, STOPPED, MANUALLY_RESOLVED).",
+	}
+
+	return schema.ListNestedBlock{
+		Description: "Set of rules used for alerting.",
+		Validators: []validator.List{
+			fwshared.ListSizeBetween(1, 0),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+			Blocks: map[string]schema.Block{
+				"notification": newNotificationBlock(),
+				"escalation": schema.ListNestedBlock{
+					Description: "Notifications and their repeats written as steps, which are compiled into the rule's notifications and reminder notification. Later steps can only repeat the notifications of the first step at an even interval. Conflicts with `notifications`, `notification` and `reminder_notification`.",
+					Validators: []validator.List{
+						fwshared.ListSizeBetween(0, 1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"step": schema.ListNestedBlock{
+								Description: "The notifications to send once the alert has been active for `after_minutes` without clearing, every step after the first repeats the same notifications.",
+								Validators: []validator.List{
+									fwshared.ListSizeBetween(1, 0),
+								},
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"after_minutes": schema.Int64Attribute{
+											Optional:    true,
+											Computed:    true,
+											Default:     int64default.StaticInt64(0),
+											Description: "The number of minutes the alert must be active before the step notifies. Defaults to `0`.",
+										},
+										"notifications": schema.ListAttribute{
+											ElementType: types.StringType,
+											Required:    true,
+											Description: "List of strings specifying where notifications will be sent by this step.",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"reminder_notification": schema.ListNestedBlock{
+					Description: "Reminder notification in a rule lets you send multiple notifications for active alerts over a defined period of time.",
+					Validators: []validator.List{
+						fwshared.ListSizeBetween(0, 1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"interval_ms": schema.Int64Attribute{
+								Required:    true,
+								Description: "The interval at which you want to receive the notifications, in milliseconds.",
+							},
+							"timeout_ms": schema.Int64Attribute{
+								Optional:    true,
+								Computed:    true,
+								Description: "The duration during which repeat notifications are sent, in milliseconds.",
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+							},
+							"type": schema.StringAttribute{
+								Required:    true,
+								Description: "Type of reminder notification. Currently, the only supported value is TIMEOUT.",
+								Validators: []validator.String{
+									fwshared.StringOneOf(ruledef.EscalationReminderType),
+								},
+							},
+						},
+					},
+				},
+				"breach_parameters": newParametersBlock(slo.BreachRule, map[string]schema.Attribute{
+					"fire_lasting":       newDurationParameter("Duration that indicates how long the alert condition is met before the alert is triggered. The value must be positive and smaller than the compliance period of the SLO target."),
+					"percent_of_lasting": newFloatParameter("Percentage of the `fire_lasting` duration that the alert condition is met before the alert is triggered."),
+				}),
+				"error_budget_left_parameters": newParametersBlock(slo.ErrorBudgetLeftRule, map[string]schema.Attribute{
+					"fire_lasting":              newDurationParameter("Duration that indicates how long the alert condition is met before the alert is triggered. The value must be positive and smaller than the compliance period of the SLO target."),
+					"percent_of_lasting":        newFloatParameter("Percentage of the `fire_lasting` duration that the alert condition is met before the alert is triggered."),
+					"percent_error_budget_left": newFloatParameter("Error budget must be equal to or smaller than this percentage for the alert to be triggered."),
+				}),
+				"burn_rate_parameters": newParametersBlock(slo.BurnRateRule, map[string]schema.Attribute{
+					"short_window_1":        newDurationParameter("Short window 1 used in burn rate alert calculation. This value must be longer than 1/30 of `long_window_1`."),
+					"long_window_1":         newDurationParameter("Long window 1 used in burn rate alert calculation. This value must be longer than `short_window_1` and shorter than 90 days."),
+					"short_window_2":        newDurationParameter("Short window 2 used in burn rate alert calculation. This value must be longer than 1/30 of `long_window_2`."),
+					"long_window_2":         newDurationParameter("Long window 2 used in burn rate alert calculation. This value must be longer than `short_window_2` and shorter than 90 days."),
+					"burn_rate_threshold_1": newFloatParameter("Burn rate threshold 1 used in burn rate alert calculation. This value must be between 0 and 100/(100-SLO target)."),
+					"burn_rate_threshold_2": newFloatParameter("Burn rate threshold 2 used in burn rate alert calculation. This value must be between 0 and 100/(100-SLO target)."),
+				}),
+			},
+		},
+	}
+}
+
+func newParametersBlock(typ string, attributes map[string]schema.Attribute) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: fmt.Sprintf("Parameters of a `%s` alert rule, the parameters that are not set use the API defaults.", typ),
+		Validators: []validator.List{
+			fwshared.ListSizeBetween(0, 1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func newDurationParameter(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType:  fwtypes.TimeRangeType{},
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func newFloatParameter(description string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		PlanModifiers: []planmodifier.Float64{
+			float64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// parameterBlock returns the name of the parameter block that is set on the rule, if any.
+func (rm *ruleModel) parameterBlock() (string, bool) {
+	switch {
+	case len(rm.BreachParameters) > 0:
+		return parameterBlocks[slo.BreachRule], true
+	case len(rm.ErrorBudgetLeftParameters) > 0:
+		return parameterBlocks[slo.ErrorBudgetLeftRule], true
+	case len(rm.BurnRateParameters) > 0:
+		return parameterBlocks[slo.BurnRateRule], true
+	}
+	return "", false
+}
+
+// toAPI converts the rule into the API rule without its parameters.
+func (rm *ruleModel) toAPI(ctx context.Context) (*detector.Rule, error) {
+	rule := &detector.Rule{
+		Severity:                    detector.Severity(rm.Severity.ValueString()),
+		Description:                 rm.Description.ValueString(),
+		Disabled:                    rm.Disabled.ValueBool(),
+		ParameterizedBody:           rm.ParameterizedBody.ValueString(),
+		ParameterizedSubject:        rm.ParameterizedSubject.ValueString(),
+		RunbookUrl:                  rm.RunbookURL.ValueString(),
+		Tip:                         rm.Tip.ValueString(),
+		SkipClearNotificationStates: valueStrings(rm.SkipClearNotificationStates),
+	}
+
+	notifications, err := encodeNotifications(ctx, rm.Notifications, rm.Notification)
+	if err != nil {
+		return nil, err
+	}
+	rule.Notifications = notifications
+
+	if len(rm.ReminderNotification) > 0 {
+		reminder := rm.ReminderNotification[0]
+		rule.ReminderNotification = &detector.ReminderNotification{
+			IntervalMs: reminder.IntervalMs.ValueInt64(),
+			TimeoutMs:  reminder.TimeoutMs.ValueInt64(),
+			Type:       reminder.Type.ValueString(),
+		}
+	}
+
+	if len(rm.Escalation) == 0 {
+		return rule, nil
+	}
+
+	switch {
+	case len(rm.Notifications) > 0:
+		return nil, errors.New("escalation can not be used with notifications")
+	case len(rule.Notifications) > 0:
+		return nil, errors.New("escalation can not be used with notification")
+	case rule.ReminderNotification != nil:
+		return nil, errors.New("escalation can not be used with reminder_notification")
+	}
+
+	escalated, reminder, err := ruledef.CompileEscalation(rm.escalationSteps())
+	if err != nil {
+		return nil, err
+	}
+	if rule.Notifications, err = common.NewNotificationList(anyStrings(escalated)); err != nil {
+		return nil, err
+	}
+	rule.ReminderNotification = reminder
+	return rule, nil
+}
+
+// escalationSteps returns the escalation steps of the rule ordered by their delay.
+func (rm *ruleModel) escalationSteps() []ruledef.EscalationStep {
+	if len(rm.Escalation) == 0 {
+		return nil
+	}
+	steps := make([]ruledef.EscalationStep, 0, len(rm.Escalation[0].Step))
+	for _, step := range rm.Escalation[0].Step {
+		steps = append(steps, ruledef.EscalationStep{
+			AfterMinutes:  int(step.AfterMinutes.ValueInt64()),
+			Notifications: valueStrings(step.Notifications),
+		})
+	}
+	ruledef.SortEscalationSteps(steps)
+	return steps
+}
+
+// fromAPI updates the rule with the API rule, keeping the form of the prior rule
+// so that notifications configured using an escalation policy or notification blocks are read the same way.
+func (rm *ruleModel) fromAPI(ctx context.Context, rule *detector.Rule, prior *ruleModel) (diags diag.Diagnostics) {
+	if prior == nil {
+		prior = &ruleModel{Notification: types.ListNull(newNotificationBlock().NestedObject.Type())}
+	}
+
+	rm.Severity = types.StringValue(string(rule.Severity))
+	rm.Description = stringValue(rule.Description)
+	rm.Disabled = types.BoolValue(rule.Disabled)
+	rm.ParameterizedBody = stringValue(rule.ParameterizedBody)
+	rm.ParameterizedSubject = stringValue(rule.ParameterizedSubject)
+	rm.RunbookURL = stringValue(rule.RunbookUrl)
+	rm.Tip = stringValue(rule.Tip)
+	rm.SkipClearNotificationStates = stringValues(rule.SkipClearNotificationStates)
+	rm.Escalation = []escalationModel{}
+	rm.ReminderNotification = []reminderNotificationModel{}
+
+	notifications := make([]string, 0, len(rule.Notifications))
+	for _, n := range rule.Notifications {
+		s, err := common.NewNotificationStringFromAPI(n)
+		if err != nil {
+			diags.AddError("Unable to read notification", err.Error())
+			return diags
+		}
+		notifications = append(notifications, s)
+	}
+
+	if steps := prior.escalationSteps(); steps != nil && ruledef.EscalationMatches(steps, notifications, rule.ReminderNotification) {
+		rm.Escalation = prior.Escalation
+		rm.Notifications = nil
+		rm.Notification, diags = notificationBlocks(ctx, nil)
+		return diags
+	}
+
+	if r := rule.ReminderNotification; r != nil {
+		rm.ReminderNotification = []reminderNotificationModel{{
+			IntervalMs: types.Int64Value(r.IntervalMs),
+			TimeoutMs:  types.Int64Value(r.TimeoutMs),
+			Type:       types.StringValue(r.Type),
+		}}
+	}
+
+	priorBlocks, err := fwshared.ValueToSDK(ctx, prior.Notification)
+	if err != nil {
+		diags.AddError("Unable to read notification", err.Error())
+		return diags
+	}
+	blocks, _ := priorBlocks.([]any)
+
+	strs, encoded, err := notificationdef.EncodeTerraformPrior(rule.Notifications, anyStrings(valueStrings(prior.Notifications)), blocks)
+	if err != nil {
+		diags.AddError("Unable to read notification", err.Error())
+		return diags
+	}
+	rm.Notifications = stringValues(strs)
+
+	// Blocks that still notify the same recipients are kept as configured,
+	// since values such as webhook secrets are not returned by the API.
+	values := make([]any, len(encoded))
+	for i, block := range encoded {
+		values[i] = block
+	}
+	if len(blocks) > 0 && ruledef.SameNotifications(notificationdef.Strings(blocks), notificationdef.Strings(values)) {
+		rm.Notification = prior.Notification
+		return diags
+	}
+	rm.Notification, diags = notificationBlocks(ctx, encoded)
+	return diags
+}
+
+// notificationBlocks converts the notification blocks read by the shared encoding into the `notification` value.
+func notificationBlocks(ctx context.Context, blocks []map[string]any) (types.List, diag.Diagnostics) {
+	typ := newNotificationBlock().Type()
+	v, diags := fwshared.ValueFromSDK(ctx, typ, blocks)
+	if diags.HasError() {
+		return types.ListNull(typ.(types.ListType).ElemType), diags
+	}
+	return v.(types.List), diags
+}
+
+func encodeNotifications(ctx context.Context, strs []types.String, blocks types.List) ([]*notification.Notification, error) {
+	notifications, err := common.NewNotificationList(anyStrings(valueStrings(strs)))
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := fwshared.ValueToSDK(ctx, blocks)
+	if err != nil {
+		return nil, err
+	}
+	values, _ := raw.([]any)
+	structured, err := notificationdef.DecodeTerraform(values)
+	if err != nil {
+		return nil, err
+	}
+	return append(notifications, structured...), nil
+}
+
+func newBreachRule(ctx context.Context, rm *ruleModel) (*slo.BreachDetectorRule, error) {
+	rule, err := rm.toAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &slo.BreachDetectorRule{Rule: *rule}
+	if len(rm.BreachParameters) > 0 {
+		p := rm.BreachParameters[0]
+		out.Parameters = &slo.BreachDetectorParameters{
+			FireLasting:      p.FireLasting.ValueString(),
+			PercentOfLasting: p.PercentOfLasting.ValueFloat64(),
+		}
+	}
+	return out, nil
+}
+
+func newErrorBudgetLeftRule(ctx context.Context, rm *ruleModel) (*slo.ErrorBudgetLeftDetectorRule, error) {
+	rule, err := rm.toAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &slo.ErrorBudgetLeftDetectorRule{Rule: *rule}
+	if len(rm.ErrorBudgetLeftParameters) > 0 {
+		p := rm.ErrorBudgetLeftParameters[0]
+		out.Parameters = &slo.ErrorBudgetLeftDetectorParameters{
+			FireLasting:            p.FireLasting.ValueString(),
+			PercentOfLasting:       p.PercentOfLasting.ValueFloat64(),
+			PercentErrorBudgetLeft: p.PercentErrorBudgetLeft.ValueFloat64(),
+		}
+	}
+	return out, nil
+}
+
+func newBurnRateRule(ctx context.Context, rm *ruleModel) (*slo.BurnRateDetectorRule, error) {
+	rule, err := rm.toAPI(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := &slo.BurnRateDetectorRule{Rule: *rule}
+	if len(rm.BurnRateParameters) > 0 {
+		p := rm.BurnRateParameters[0]
+		out.Parameters = &slo.BurnRateDetectorParameters{
+			ShortWindow1:       p.ShortWindow1.ValueString(),
+			LongWindow1:        p.LongWindow1.ValueString(),
+			ShortWindow2:       p.ShortWindow2.ValueString(),
+			LongWindow2:        p.LongWindow2.ValueString(),
+			BurnRateThreshold1: p.BurnRateThreshold1.ValueFloat64(),
+			BurnRateThreshold2: p.BurnRateThreshold2.ValueFloat64(),
+		}
+	}
+	return out, nil
+}
+
+// decodeRules reads the rules of the API alert rule along with the parameters returned by the API.
+// The parameters are only left out of a rule when its prior rule did not set the parameter block,
+// since they are then the API defaults the user never set and would otherwise show a difference.
+func decodeRules(ctx context.Context, alertRule slo.SloAlertRule, prior []ruleModel) ([]ruleModel, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+		rules []ruleModel
+	)
+	add := func(rule *detector.Rule, set func(rm *ruleModel, prior *ruleModel)) {
+		var (
+			rm = ruleModel{
+				BreachParameters:          []breachParametersModel{},
+				ErrorBudgetLeftParameters: []errorBudgetLeftParametersModel{},
+				BurnRateParameters:        []burnRateParametersModel{},
+			}
+			p *ruleModel
+		)
+		if i := len(rules); i < len(prior) {
+			p = &prior[i]
+		}
+		diags.Append(rm.fromAPI(ctx, rule, p)...)
+		set(&rm, p)
+		rules = append(rules, rm)
+	}
+
+	switch {
+	case alertRule.BreachSloAlertRule != nil:
+		for _, r := range alertRule.BreachSloAlertRule.Rules {
+			add(&r.Rule, func(rm *ruleModel, prior *ruleModel) {
+				if p := r.Parameters; p != nil && (prior == nil || len(prior.BreachParameters) > 0) {
+					rm.BreachParameters = []breachParametersModel{{
+						FireLasting:      timeRangeValue(p.FireLasting),
+						PercentOfLasting: types.Float64Value(p.PercentOfLasting),
+					}}
+				}
+			})
+		}
+	case alertRule.ErrorBudgetLeftSloAlertRule != nil:
+		for _, r := range alertRule.ErrorBudgetLeftSloAlertRule.Rules {
+			add(&r.Rule, func(rm *ruleModel, prior *ruleModel) {
+				if p := r.Parameters; p != nil && (prior == nil || len(prior.ErrorBudgetLeftParameters) > 0) {
+					rm.ErrorBudgetLeftParameters = []errorBudgetLeftParametersModel{{
+						FireLasting:            timeRangeValue(p.FireLasting),
+						PercentOfLasting:       types.Float64Value(p.PercentOfLasting),
+						PercentErrorBudgetLeft: types.Float64Value(p.PercentErrorBudgetLeft),
+					}}
+				}
+			})
+		}
+	case alertRule.BurnRateSloAlertRule != nil:
+		for _, r := range alertRule.BurnRateSloAlertRule.Rules {
+			add(&r.Rule, func(rm *ruleModel, prior *ruleModel) {
+				if p := r.Parameters; p != nil && (prior == nil || len(prior.BurnRateParameters) > 0) {
+					rm.BurnRateParameters = []burnRateParametersModel{{
+						ShortWindow1:       timeRangeValue(p.ShortWindow1),
+						LongWindow1:        timeRangeValue(p.LongWindow1),
+						ShortWindow2:       timeRangeValue(p.ShortWindow2),
+						LongWindow2:        timeRangeValue(p.LongWindow2),
+						BurnRateThreshold1: types.Float64Value(p.BurnRateThreshold1),
+						BurnRateThreshold2: types.Float64Value(p.BurnRateThreshold2),
+					}}
+				}
+			})
+		}
+	default:
+		diags.AddError("Unsupported Alert Rule Type", fmt.Sprintf("The alert rule type %q is not supported.", alertRule.Type))
+	}
+	return rules, diags
+}
+
+func anyStrings(values []string) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
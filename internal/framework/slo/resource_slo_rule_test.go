@@ -0,0 +1,111 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/signalfx/signalfx-go/slo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleEscalation(t *testing.T) {
+	t.Parallel()
+
+	rule := newTestRule("Critical")
+	rule.Notifications = nil
+	rule.Escalation = []escalationModel{{Step: []escalationStepModel{
+		{AfterMinutes: types.Int64Value(15), Notifications: []types.String{types.StringValue("Email,oncall@example.com")}},
+		{AfterMinutes: types.Int64Value(0), Notifications: []types.String{types.StringValue("Email,oncall@example.com")}},
+	}}}
+
+	out, err := rule.toAPI(context.Background())
+	require.NoError(t, err, "Must not error compiling the escalation")
+	assert.Len(t, out.Notifications, 1, "Must send the initial notifications")
+	require.NotNil(t, out.ReminderNotification, "Must remind the later steps")
+	assert.Equal(t, int64(15*60*1000), out.ReminderNotification.TimeoutMs, "Must remind after the later step")
+
+	var actual ruleModel
+	diags := actual.fromAPI(context.Background(), out, &rule)
+	require.False(t, diags.HasError(), "Must not error reading the rule: %v", diags)
+	assert.Equal(t, rule.Escalation, actual.Escalation, "Must keep the escalation when it matches the API rule")
+	assert.Empty(t, actual.Notifications, "Must not read the escalated notifications")
+	assert.Empty(t, actual.ReminderNotification, "Must not read the escalation reminder")
+
+	diags = actual.fromAPI(context.Background(), out, nil)
+	require.False(t, diags.HasError(), "Must not error reading the rule: %v", diags)
+	assert.Empty(t, actual.Escalation, "Must not read an escalation without a prior escalation")
+	assert.Len(t, actual.Notifications, 1, "Must read the notifications without a prior escalation")
+	assert.Len(t, actual.ReminderNotification, 1, "Must read the reminder without a prior escalation")
+}
+
+func TestRuleEscalationConflicts(t *testing.T) {
+	t.Parallel()
+
+	rule := newTestRule("Critical")
+	rule.Escalation = []escalationModel{{Step: []escalationStepModel{
+		{AfterMinutes: types.Int64Value(0), Notifications: []types.String{types.StringValue("Email,oncall@example.com")}},
+	}}}
+
+	_, err := rule.toAPI(context.Background())
+	assert.EqualError(t, err, "escalation can not be used with notifications", "Must error when both notifications and escalation are set")
+
+	rule.Notifications = nil
+	rule.ReminderNotification = []reminderNotificationModel{{
+		IntervalMs: types.Int64Value(60000),
+		TimeoutMs:  types.Int64Value(0),
+		Type:       types.StringValue("TIMEOUT"),
+	}}
+	_, err = rule.toAPI(context.Background())
+	assert.EqualError(t, err, "escalation can not be used with reminder_notification", "Must error when both reminder_notification and escalation are set")
+}
+
+func TestAlertRuleParameters(t *testing.T) {
+	t.Parallel()
+
+	rule := newTestRule("Critical")
+	rule.BurnRateParameters = []burnRateParametersModel{{
+		ShortWindow1:       timeRangeValue("5m"),
+		LongWindow1:        timeRangeValue("1h"),
+		ShortWindow2:       timeRangeValue("30m"),
+		LongWindow2:        timeRangeValue("6h"),
+		BurnRateThreshold1: types.Float64Value(14.4),
+		BurnRateThreshold2: types.Float64Value(6),
+	}}
+
+	ar := alertRuleModel{Type: types.StringValue(slo.BurnRateRule), Rule: []ruleModel{rule}}
+	out, err := ar.toAPI(context.Background())
+	require.NoError(t, err, "Must not error encoding the alert rule")
+	require.NotNil(t, out.BurnRateSloAlertRule, "Must encode a burn rate alert rule")
+	assert.Equal(t, &slo.BurnRateDetectorParameters{
+		ShortWindow1:       "5m",
+		LongWindow1:        "1h",
+		ShortWindow2:       "30m",
+		LongWindow2:        "6h",
+		BurnRateThreshold1: 14.4,
+		BurnRateThreshold2: 6,
+	}, out.BurnRateSloAlertRule.Rules[0].Parameters, "Must encode the typed parameters")
+
+	rules, diags := decodeRules(context.Background(), *out, ar.Rule)
+	require.False(t, diags.HasError(), "Must not error decoding the rules: %v", diags)
+	assert.Equal(t, ar.Rule, rules, "Must read the typed parameters set by the prior rule")
+
+	rules, diags = decodeRules(context.Background(), *out, nil)
+	require.False(t, diags.HasError(), "Must not error decoding the rules: %v", diags)
+	assert.Equal(t, rule.BurnRateParameters, rules[0].BurnRateParameters, "Must read the typed parameters without a prior rule")
+
+	unset := newTestRule("Critical")
+	unset.BurnRateParameters = []burnRateParametersModel{}
+	rules, diags = decodeRules(context.Background(), *out, []ruleModel{unset})
+	require.False(t, diags.HasError(), "Must not error decoding the rules: %v", diags)
+	assert.Empty(t, rules[0].BurnRateParameters, "Must not read the API defaults when the prior rule did not set them")
+
+	ar.Type = types.StringValue(slo.ErrorBudgetLeftRule)
+	assert.EqualError(t, ar.validateParameters(), "rule 0 sets burn_rate_parameters, which can not be used with an alert_rule of type ERROR_BUDGET_LEFT")
+	_, err = ar.toAPI(context.Background())
+	assert.Error(t, err, "Must error encoding mismatched parameters")
+}
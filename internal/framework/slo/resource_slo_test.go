@@ -0,0 +1,208 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/signalfx/signalfx-go/slo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	fwtypes "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/types"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+func newTestResourceSlo(t *testing.T) *ResourceSlo {
+	rs := NewResourceSlo().(*ResourceSlo)
+
+	resp := &resource.ConfigureResponse{}
+	rs.Configure(context.Background(), resource.ConfigureRequest{
+		ProviderData: &pmeta.Meta{Registry: feature.NewRegistry()},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "Must not error configuring resource")
+
+	return rs
+}
+
+func newTestSloSchema(t *testing.T) resource.SchemaResponse {
+	resp := resource.SchemaResponse{}
+	NewResourceSlo().Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "Must not error loading schema")
+	return resp
+}
+
+// newTestSloState writes the model into a state, which fails when the model does not match the schema.
+func newTestSloState(t *testing.T, model *resourceSloModel) tfsdk.State {
+	s := newTestSloSchema(t).Schema
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	diags := state.Set(context.Background(), model)
+	require.False(t, diags.HasError(), "Must not error setting the model: %v", diags)
+	return state
+}
+
+func newTestRule(severity string) ruleModel {
+	return ruleModel{
+		Severity:                  types.StringValue(severity),
+		Disabled:                  types.BoolValue(false),
+		Notifications:             []types.String{types.StringValue("Email,oncall@example.com")},
+		Notification:              types.ListValueMust(newNotificationBlock().NestedObject.Type(), []attr.Value{}),
+		Escalation:                []escalationModel{},
+		ReminderNotification:      []reminderNotificationModel{},
+		BreachParameters:          []breachParametersModel{},
+		ErrorBudgetLeftParameters: []errorBudgetLeftParametersModel{},
+		BurnRateParameters:        []burnRateParametersModel{},
+	}
+}
+
+func newTestSloModel() resourceSloModel {
+	breach := newTestRule("Critical")
+	breach.BreachParameters = []breachParametersModel{{
+		FireLasting:      timeRangeValue("10m"),
+		PercentOfLasting: types.Float64Value(90),
+	}}
+
+	return resourceSloModel{
+		Id:   types.StringValue("slo-01"),
+		Name: types.StringValue("checkout availability"),
+		Type: types.StringValue(slo.RequestBased),
+		Input: []inputModel{{
+			ProgramText:      fwtypes.NewSignalFlowValue("G = data('good').publish(label='G')\nT = data('total').publish(label='T')"),
+			GoodEventsLabel:  types.StringValue("G"),
+			TotalEventsLabel: types.StringValue("T"),
+		}},
+		Target: []targetModel{{
+			Type:             types.StringValue(slo.RollingWindowTarget),
+			Slo:              types.Float64Value(99.9),
+			CompliancePeriod: timeRangeValue("30d"),
+			CycleType:        types.StringNull(),
+			CycleStart:       types.StringNull(),
+			BurnRatePolicy:   []burnRatePolicyModel{},
+			AlertRule: []alertRuleModel{
+				{Type: types.StringValue(slo.BreachRule), Rule: []ruleModel{breach}},
+				{Type: types.StringValue(slo.ErrorBudgetLeftRule), Rule: []ruleModel{newTestRule("Warning")}},
+			},
+		}},
+	}
+}
+
+func TestResourceSloMetadata(t *testing.T) {
+	t.Parallel()
+
+	resp := &resource.MetadataResponse{}
+	NewResourceSlo().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "signalfx"}, resp)
+
+	assert.Equal(t, "signalfx_slo", resp.TypeName, "Must match the expected type name")
+}
+
+func TestResourceSloSchema(t *testing.T) {
+	t.Parallel()
+
+	resp := newTestSloSchema(t)
+	assert.False(t, resp.Schema.ValidateImplementation(context.Background()).HasError(), "Must be a valid schema")
+	assert.Equal(t, int64(2), resp.Schema.Version, "Must be the version after the SDK schema")
+
+	model := newTestSloModel()
+	newTestSloState(t, &model)
+}
+
+func TestResourceSloValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		modify func(model *resourceSloModel)
+		errors bool
+	}{
+		{
+			name:   "matching parameters",
+			modify: func(*resourceSloModel) {},
+			errors: false,
+		},
+		{
+			name: "mismatched parameters",
+			modify: func(model *resourceSloModel) {
+				model.Target[0].AlertRule[0].Type = types.StringValue(slo.BurnRateRule)
+			},
+			errors: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			model := newTestSloModel()
+			tc.modify(&model)
+			state := newTestSloState(t, &model)
+
+			resp := &resource.ValidateConfigResponse{}
+			newTestResourceSlo(t).ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw},
+			}, resp)
+			assert.Equal(t, tc.errors, resp.Diagnostics.HasError(), "Must match the expected validation result: %v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestResourceSloEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	rs := newTestResourceSlo(t)
+	expect := newTestSloModel()
+
+	req, diags := rs.encode(context.Background(), &expect)
+	require.False(t, diags.HasError(), "Must not error encoding SLO: %v", diags)
+	require.Len(t, req.Targets, 1, "Must encode the target")
+	require.Len(t, req.Targets[0].SloAlertRules, 2, "Must encode each alert rule")
+	require.NotNil(t, req.Targets[0].SloAlertRules[0].BreachSloAlertRule, "Must encode the breach alert rule")
+	assert.Equal(t, &slo.BreachDetectorParameters{FireLasting: "10m", PercentOfLasting: 90}, req.Targets[0].SloAlertRules[0].BreachSloAlertRule.Rules[0].Parameters, "Must encode the typed parameters")
+
+	// The API can return the alert rules in any order.
+	req.Id = "slo-01"
+	req.Targets[0].SloAlertRules[0], req.Targets[0].SloAlertRules[1] = req.Targets[0].SloAlertRules[1], req.Targets[0].SloAlertRules[0]
+
+	actual := newTestSloModel()
+	diags = rs.decode(context.Background(), req, &actual)
+	require.False(t, diags.HasError(), "Must not error decoding SLO: %v", diags)
+	assert.Equal(t, expect, actual, "Must match the model after the round trip")
+	newTestSloState(t, &actual)
+}
+
+func TestResourceSloDecodeImport(t *testing.T) {
+	t.Parallel()
+
+	rs := newTestResourceSlo(t)
+	model := newTestSloModel()
+	req, diags := rs.encode(context.Background(), &model)
+	require.False(t, diags.HasError(), "Must not error encoding SLO: %v", diags)
+	req.Id = "slo-01"
+
+	var imported resourceSloModel
+	diags = rs.decode(context.Background(), req, &imported)
+	require.False(t, diags.HasError(), "Must not error decoding SLO: %v", diags)
+	assert.Equal(t, model.Target[0].AlertRule[0].Rule[0].BreachParameters, imported.Target[0].AlertRule[0].Rule[0].BreachParameters, "Must read the parameters returned by the API")
+	newTestSloState(t, &imported)
+}
+
+func TestResourceSloEncodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	rs := newTestResourceSlo(t)
+
+	model := newTestSloModel()
+	model.Type = types.StringValue("WindowBased")
+	_, diags := rs.encode(context.Background(), &model)
+	assert.True(t, diags.HasError(), "Must error on unsupported SLO types")
+
+	model = newTestSloModel()
+	model.Target[0].Type = types.StringValue("Unknown")
+	_, diags = rs.encode(context.Background(), &model)
+	assert.True(t, diags.HasError(), "Must error on unsupported target types")
+}
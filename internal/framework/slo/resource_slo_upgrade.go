@@ -0,0 +1,153 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UpgradeState migrates the state written by the SDK implementation of the resource,
+// which used the same `parameters` block for every alert rule type.
+func (rs *ResourceSlo) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeSDKState},
+		1: {StateUpgrader: upgradeSDKState},
+	}
+}
+
+// upgradeSDKState moves the `parameters` block of each rule into the typed
+// parameter block of its alert rule type, and converts the values the SDK
+// stored as zero values into the null values the framework expects.
+func upgradeSDKState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil {
+		resp.Diagnostics.AddError("Unable to Upgrade SLO State", "The prior state is missing.")
+		return
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade SLO State", err.Error())
+		return
+	}
+
+	for _, target := range objects(state["target"]) {
+		for _, ar := range objects(target["alert_rule"]) {
+			block, ok := parameterBlocks[stringOf(ar["type"])]
+			for _, rule := range objects(ar["rule"]) {
+				params := objects(rule["parameters"])
+				delete(rule, "parameters")
+				if !ok || len(params) == 0 {
+					continue
+				}
+				rule[block] = []any{upgradeParameters(block, params[0])}
+			}
+		}
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade SLO State", err.Error())
+		return
+	}
+
+	typ := resp.State.Schema.Type().TerraformType(ctx)
+	value, err := tftypes.ValueFromJSONWithOpts(raw, typ, tftypes.ValueFromJSONOpts{
+		IgnoreUndefinedAttributes: true,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade SLO State", err.Error())
+		return
+	}
+
+	value, err = tftypes.Transform(value, normalizeSDKValue)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade SLO State", err.Error())
+		return
+	}
+	resp.State.Raw = value
+}
+
+// upgradeParameters keeps only the parameters that apply to the typed block,
+// since the SDK stored every parameter for every alert rule type.
+func upgradeParameters(block string, params map[string]any) map[string]any {
+	var names []string
+	switch block {
+	case "breach_parameters":
+		names = []string{"fire_lasting", "percent_of_lasting"}
+	case "error_budget_left_parameters":
+		names = []string{"fire_lasting", "percent_of_lasting", "percent_error_budget_left"}
+	case "burn_rate_parameters":
+		names = []string{"short_window_1", "long_window_1", "short_window_2", "long_window_2", "burn_rate_threshold_1", "burn_rate_threshold_2"}
+	}
+
+	out := make(map[string]any, len(names))
+	for _, name := range names {
+		out[name] = params[name]
+	}
+	return out
+}
+
+// normalizeSDKValue converts empty strings and empty lists of primitives into null values,
+// and null lists of blocks into empty lists, which matches how the resource reads the API.
+func normalizeSDKValue(_ *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+	switch typ := v.Type().(type) {
+	case tftypes.List:
+		if _, ok := typ.ElementType.(tftypes.Object); ok {
+			if v.IsNull() {
+				return tftypes.NewValue(typ, []tftypes.Value{}), nil
+			}
+			return v, nil
+		}
+		if v.IsKnown() && !v.IsNull() {
+			var elems []tftypes.Value
+			if err := v.As(&elems); err != nil {
+				return v, err
+			}
+			if len(elems) == 0 {
+				return tftypes.NewValue(typ, nil), nil
+			}
+		}
+	case tftypes.Set:
+		if v.IsKnown() && !v.IsNull() {
+			var elems []tftypes.Value
+			if err := v.As(&elems); err != nil {
+				return v, err
+			}
+			if len(elems) == 0 {
+				return tftypes.NewValue(typ, nil), nil
+			}
+		}
+	default:
+		if v.Type().Is(tftypes.String) && v.IsKnown() && !v.IsNull() {
+			var s string
+			if err := v.As(&s); err != nil {
+				return v, err
+			}
+			if s == "" {
+				return tftypes.NewValue(tftypes.String, nil), nil
+			}
+		}
+	}
+	return v, nil
+}
+
+func objects(v any) []map[string]any {
+	items, _ := v.([]any)
+	out := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+func stringOf(v any) string {
+	s, _ := v.(string)
+	return s
+}
@@ -0,0 +1,119 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwslo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSDKSloState = `{
+	"id": "slo-01",
+	"name": "checkout availability",
+	"description": "",
+	"type": "RequestBased",
+	"input": [{
+		"program_text": "G = data('good').publish(label='G')\nT = data('total').publish(label='T')",
+		"good_events_label": "G",
+		"total_events_label": "T"
+	}],
+	"target": [{
+		"type": "RollingWindow",
+		"slo": 99.9,
+		"compliance_period": "30d",
+		"cycle_type": "",
+		"cycle_start": "",
+		"burn_rate_policy": [],
+		"alert_rule": [
+			{
+				"type": "BREACH",
+				"rule": [{
+					"severity": "Critical",
+					"description": "",
+					"detect_label": "",
+					"disabled": false,
+					"notifications": ["Email,oncall@example.com"],
+					"notification": [],
+					"parameterized_body": "",
+					"parameterized_subject": "",
+					"runbook_url": "",
+					"tip": "",
+					"skip_clear_notification_states": [],
+					"reminder_notification": [],
+					"parameters": [{
+						"fire_lasting": "10m",
+						"percent_of_lasting": 90,
+						"percent_error_budget_left": 0,
+						"short_window_1": "",
+						"long_window_1": "",
+						"short_window_2": "",
+						"long_window_2": "",
+						"burn_rate_threshold_1": 0,
+						"burn_rate_threshold_2": 0
+					}]
+				}]
+			},
+			{
+				"type": "ERROR_BUDGET_LEFT",
+				"rule": [{
+					"severity": "Warning",
+					"disabled": false,
+					"notifications": []
+				}]
+			}
+		]
+	}]
+}`
+
+func TestResourceSloUpgradeState(t *testing.T) {
+	t.Parallel()
+
+	s := newTestSloSchema(t).Schema
+	upgraders := NewResourceSlo().(resource.ResourceWithUpgradeState).UpgradeState(context.Background())
+
+	for _, version := range []int64{0, 1} {
+		upgrader, ok := upgraders[version]
+		require.True(t, ok, "Must upgrade version %d", version)
+
+		resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: s}}
+		upgrader.StateUpgrader(context.Background(), resource.UpgradeStateRequest{
+			RawState: &tfprotov6.RawState{JSON: []byte(testSDKSloState)},
+		}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "Must not error upgrading the state: %v", resp.Diagnostics)
+
+		var model resourceSloModel
+		diags := resp.State.Get(context.Background(), &model)
+		require.False(t, diags.HasError(), "Must not error reading the upgraded state: %v", diags)
+
+		assert.True(t, model.Description.IsNull(), "Must convert empty strings to null")
+		assert.True(t, model.Target[0].CycleType.IsNull(), "Must convert empty strings to null")
+
+		breach := model.Target[0].AlertRule[0].Rule[0]
+		assert.Equal(t, []breachParametersModel{{
+			FireLasting:      timeRangeValue("10m"),
+			PercentOfLasting: breach.BreachParameters[0].PercentOfLasting,
+		}}, breach.BreachParameters, "Must move the parameters into the typed block")
+		assert.Equal(t, 90.0, breach.BreachParameters[0].PercentOfLasting.ValueFloat64(), "Must keep the parameter values")
+		assert.Empty(t, breach.BurnRateParameters, "Must not set the parameters of other types")
+		assert.False(t, breach.Notification.IsNull(), "Must read the notification blocks as an empty list")
+		assert.Empty(t, breach.Notification.Elements(), "Must read the notification blocks as an empty list")
+
+		errorBudget := model.Target[0].AlertRule[1].Rule[0]
+		assert.Nil(t, errorBudget.Notifications, "Must convert empty lists to null")
+		assert.Empty(t, errorBudget.ErrorBudgetLeftParameters, "Must not add parameters that were not set")
+		assert.NotNil(t, errorBudget.Escalation, "Must read missing blocks as empty lists")
+	}
+
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: s}}
+	upgraders[1].StateUpgrader(context.Background(), resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{"target": "invalid"`)},
+	}, resp)
+	assert.True(t, resp.Diagnostics.HasError(), "Must error upgrading invalid state")
+}
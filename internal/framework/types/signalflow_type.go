@@ -0,0 +1,60 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SignalFlowType is a custom string type for SignalFlow programs so that
+// programs that only differ by formatting are not reported as a change.
+type SignalFlowType struct {
+	basetypes.StringType
+}
+
+var _ basetypes.StringTypable = (*SignalFlowType)(nil)
+
+func (t SignalFlowType) String() string {
+	return "fwtypes.SignalFlowType"
+}
+
+func (t SignalFlowType) ValueType(ctx context.Context) attr.Value {
+	return SignalFlow{}
+}
+
+func (t SignalFlowType) Equal(o attr.Type) bool {
+	other, ok := o.(SignalFlowType)
+	return ok && t.StringType.Equal(other.StringType)
+}
+
+func (t SignalFlowType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SignalFlow{
+		StringValue: in,
+	}, nil
+}
+
+func (t SignalFlowType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	strVal, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("expected basetypes.StringValue, got %T", attrValue)
+	}
+
+	valuable, diags := t.ValueFromString(ctx, strVal)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return valuable, nil
+}
@@ -0,0 +1,105 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignalFlowTypeString(t *testing.T) {
+	t.Parallel()
+
+	sft := SignalFlowType{}
+	assert.Equal(t, "fwtypes.SignalFlowType", sft.String(), "Must match the expected string representation")
+}
+
+func TestSignalFlowTypeValueType(t *testing.T) {
+	t.Parallel()
+
+	sft := SignalFlowType{}
+	assert.Equal(t, SignalFlow{}, sft.ValueType(context.Background()), "Must match the expected value type")
+}
+
+func TestSignalFlowTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name  string
+		match attr.Type
+		equal bool
+	}{
+		{
+			name:  "nil typed value",
+			match: attr.Type(nil),
+			equal: false,
+		},
+		{
+			name:  "exact same type",
+			match: SignalFlowType{},
+			equal: true,
+		},
+		{
+			name:  "different type",
+			match: TimeRangeType{},
+			equal: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sft := SignalFlowType{}
+			assert.Equal(t, tc.equal, sft.Equal(tc.match), "Must match the expected equality result")
+		})
+	}
+}
+
+func TestSignalFlowTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		in     tftypes.Value
+		expect attr.Value
+		errVal string
+	}{
+		{
+			name:   "wrong value type provided",
+			in:     tftypes.NewValue(tftypes.Bool, false),
+			expect: nil,
+			errVal: "can't unmarshal tftypes.Bool into *string, expected string",
+		},
+		{
+			name: "unknown value provided",
+			in:   tftypes.Value{},
+			expect: SignalFlow{
+				StringValue: basetypes.NewStringNull(),
+			},
+		},
+		{
+			name:   "expected string value",
+			in:     tftypes.NewValue(tftypes.String, "data('cpu.utilization').publish()"),
+			expect: NewSignalFlowValue("data('cpu.utilization').publish()"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sft := SignalFlowType{}
+			out, err := sft.ValueFromTerraform(context.Background(), tc.in)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must match the expected error")
+				assert.Nil(t, out, "The output value must be nil")
+			} else {
+				assert.NoError(t, err, "There must not be an error")
+				assert.Equal(t, tc.expect, out, "Must match the expected value")
+			}
+		})
+	}
+}
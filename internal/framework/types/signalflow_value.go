@@ -0,0 +1,51 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+)
+
+// SignalFlow is a custom string type for SignalFlow programs.
+// Use this within the model definitions for an associated usage of SignalFlowType.
+type SignalFlow struct {
+	basetypes.StringValue
+}
+
+var _ basetypes.StringValuableWithSemanticEquals = (*SignalFlow)(nil)
+
+// NewSignalFlowValue returns a known SignalFlow value of the program.
+func NewSignalFlowValue(program string) SignalFlow {
+	return SignalFlow{StringValue: basetypes.NewStringValue(program)}
+}
+
+func (sf SignalFlow) Type(_ context.Context) attr.Type {
+	return SignalFlowType{}
+}
+
+func (sf SignalFlow) Equal(o attr.Value) bool {
+	other, ok := o.(SignalFlow)
+	return ok && sf.StringValue.Equal(other.StringValue)
+}
+
+func (sf SignalFlow) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nv, ok := newValuable.(SignalFlow)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An expected value type was received while comparing semantic values",
+		)
+		return false, diags
+	}
+
+	return common.SignalFlowEqual(sf.ValueString(), nv.ValueString()), diags
+}
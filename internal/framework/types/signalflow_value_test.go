@@ -0,0 +1,90 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignalFlowType(t *testing.T) {
+	t.Parallel()
+
+	sf := SignalFlow{}
+	assert.Equal(t, SignalFlowType{}, sf.Type(context.Background()), "Must match the expected type")
+}
+
+func TestSignalFlowEqual(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name  string
+		val   attr.Value
+		equal bool
+	}{
+		{name: "nil typed value", val: attr.Value(nil), equal: false},
+		{name: "unmatched type", val: basetypes.StringValue{}, equal: false},
+		{name: "same value", val: SignalFlow{}, equal: true},
+		{name: "reformatted value", val: NewSignalFlowValue("A = data('cpu')"), equal: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sf := SignalFlow{}
+			assert.Equal(t, tc.equal, sf.Equal(tc.val), "Must match the expected equality result")
+		})
+	}
+}
+
+func TestSignalFlowStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		other  basetypes.StringValuable
+		equal  bool
+		issues diag.Diagnostics
+	}{
+		{
+			name:  "unmatched type",
+			other: basetypes.NewStringNull(),
+			equal: false,
+			issues: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An expected value type was received while comparing semantic values",
+				),
+			},
+		},
+		{
+			name:  "same program",
+			other: NewSignalFlowValue("A = data('cpu.utilization').publish(label='A')\nB = data('memory.utilization').publish(label='B')"),
+			equal: true,
+		},
+		{
+			name:  "reformatted program",
+			other: NewSignalFlowValue("# Memory\nB = data(\"memory.utilization\").publish(label=\"B\")\n\nA = data(\"cpu.utilization\").publish(label=\"A\")\n"),
+			equal: true,
+		},
+		{
+			name:  "changed program",
+			other: NewSignalFlowValue("A = data('cpu.utilization').publish(label='A')\nB = data('disk.utilization').publish(label='B')"),
+			equal: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sf := NewSignalFlowValue("A = data('cpu.utilization').publish(label='A')\nB = data('memory.utilization').publish(label='B')")
+			equal, issues := sf.StringSemanticEquals(context.Background(), tc.other)
+			assert.Equal(t, tc.equal, equal, "Must match the expected equality result")
+			assert.Equal(t, tc.issues, issues, "Must match the expected diagnostics")
+		})
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pmeta
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+const (
+	IdentityFieldID             = "id"
+	IdentityFieldRealm          = "realm"
+	IdentityFieldOrganizationID = "organization_id"
+)
+
+// defaultRealm is used when the API URL does not include a realm.
+const defaultRealm = "us0"
+
+var realmHostPattern = regexp.MustCompile(`^api\.(?:([a-z0-9-]+)\.)?(?:signalfx\.com|observability\.splunkcloud\.com)$`)
+
+// LoadRealm returns the realm that the provider is configured to use,
+// the configured realm is preferred otherwise it is based on the configured API URL.
+// An empty value is returned when the realm can not be determined such as when a proxy is used.
+func LoadRealm(ctx context.Context, meta any) string {
+	m, ok := meta.(*Meta)
+	if !ok {
+		return ""
+	}
+	if m.Realm != "" {
+		return m.Realm
+	}
+	u, err := url.ParseRequestURI(m.APIURL)
+	if err != nil {
+		tflog.Debug(ctx, "Unable to parse api url to determine realm", tfext.NewLogFields().Error(err))
+		return ""
+	}
+	match := realmHostPattern.FindStringSubmatch(u.Hostname())
+	switch {
+	case len(match) != 2:
+		return ""
+	case match[1] == "":
+		return defaultRealm
+	}
+	return match[1]
+}
+
+// LoadOrganizationID returns the configured organization id,
+// it is only known when it has been set within the provider configuration.
+func LoadOrganizationID(_ context.Context, meta any) string {
+	if m, ok := meta.(*Meta); ok {
+		return m.OrganizationID
+	}
+	return ""
+}
+
+// ValidateIdentity ensures that the identity realm and organization id
+// matches the configured provider so that a resource can not be read from a different organization.
+// Empty values are not validated since they could not be determined when the identity was set.
+func ValidateIdentity(ctx context.Context, meta any, realm, orgID string) error {
+	if expect := LoadRealm(ctx, meta); realm != "" && expect != "" && realm != expect {
+		return fmt.Errorf("resource identity realm %q does not match the provider realm %q", realm, expect)
+	}
+	if expect := LoadOrganizationID(ctx, meta); orgID != "" && expect != "" && orgID != expect {
+		return fmt.Errorf("resource identity organization %q does not match the provider organization %q", orgID, expect)
+	}
+	return nil
+}
+
+// NewResourceIdentity returns the identity schema shared by all resources.
+func NewResourceIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		Version: 0,
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				IdentityFieldID: {
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+					Description:       "The ID of the resource",
+				},
+				IdentityFieldRealm: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The realm the resource belongs to",
+				},
+				IdentityFieldOrganizationID: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The organization ID the resource belongs to",
+				},
+			}
+		},
+	}
+}
+
+// ResourceIdentityDecorator adds the shared identity schema to the resource
+// and wraps the existing operations so that the identity is validated before
+// the resource is read and set once the operation has completed.
+func ResourceIdentityDecorator(res *schema.Resource) *schema.Resource {
+	if res == nil || res.Identity != nil {
+		return res
+	}
+	res.Identity = NewResourceIdentity()
+
+	if res.Importer != nil {
+		res.Importer = wrapImporter(res.Importer)
+	}
+
+	if res.Create != nil {
+		res.Create = wrapIdentityFunc(res.Create, false)
+	}
+	if res.Read != nil {
+		res.Read = wrapIdentityFunc(res.Read, true)
+	}
+	if res.Update != nil {
+		res.Update = wrapIdentityFunc(res.Update, true)
+	}
+
+	if res.CreateContext != nil {
+		res.CreateContext = wrapIdentityContextFunc(res.CreateContext, false)
+	}
+	if res.ReadContext != nil {
+		res.ReadContext = wrapIdentityContextFunc(res.ReadContext, true)
+	}
+	if res.UpdateContext != nil {
+		res.UpdateContext = wrapIdentityContextFunc(res.UpdateContext, true)
+	}
+	if res.CreateWithoutTimeout != nil {
+		res.CreateWithoutTimeout = wrapIdentityContextFunc(res.CreateWithoutTimeout, false)
+	}
+	if res.ReadWithoutTimeout != nil {
+		res.ReadWithoutTimeout = wrapIdentityContextFunc(res.ReadWithoutTimeout, true)
+	}
+	if res.UpdateWithoutTimeout != nil {
+		res.UpdateWithoutTimeout = wrapIdentityContextFunc(res.UpdateWithoutTimeout, true)
+	}
+
+	return res
+}
+
+// validateResourceIdentity checks the stored identity against the provider configuration.
+func validateResourceIdentity(ctx context.Context, rd *schema.ResourceData, meta any) error {
+	identity, err := rd.Identity()
+	if err != nil {
+		return err
+	}
+	return ValidateIdentity(
+		ctx,
+		meta,
+		identity.Get(IdentityFieldRealm).(string),
+		identity.Get(IdentityFieldOrganizationID).(string),
+	)
+}
+
+// setResourceIdentity stores the identity of the resource once it is known.
+func setResourceIdentity(ctx context.Context, rd *schema.ResourceData, meta any) error {
+	if rd.Id() == "" {
+		return nil
+	}
+	identity, err := rd.Identity()
+	if err != nil {
+		return err
+	}
+	if err := identity.Set(IdentityFieldID, rd.Id()); err != nil {
+		return err
+	}
+	// The stored realm is kept when it can not be determined
+	// so that changing the form of the API URL does not change the identity.
+	if realm := LoadRealm(ctx, meta); realm != "" {
+		if err := identity.Set(IdentityFieldRealm, realm); err != nil {
+			return err
+		}
+	}
+	return identity.Set(IdentityFieldOrganizationID, LoadOrganizationID(ctx, meta))
+}
+
+func wrapIdentityFunc[Func schema.CreateFunc | schema.ReadFunc | schema.UpdateFunc](fn Func, validate bool) Func {
+	return func(rd *schema.ResourceData, meta any) error {
+		ctx := context.Background()
+		if validate {
+			if err := validateResourceIdentity(ctx, rd, meta); err != nil {
+				return err
+			}
+		}
+		if err := fn(rd, meta); err != nil {
+			return err
+		}
+		return setResourceIdentity(ctx, rd, meta)
+	}
+}
+
+func wrapIdentityContextFunc[Func schema.CreateContextFunc | schema.ReadContextFunc | schema.UpdateContextFunc](fn Func, validate bool) Func {
+	return func(ctx context.Context, rd *schema.ResourceData, meta any) diag.Diagnostics {
+		if validate {
+			if err := validateResourceIdentity(ctx, rd, meta); err != nil {
+				return tfext.AsErrorDiagnostics(err)
+			}
+		}
+		issues := fn(ctx, rd, meta)
+		if issues.HasError() {
+			return issues
+		}
+		return tfext.AppendDiagnostics(issues, tfext.AsErrorDiagnostics(setResourceIdentity(ctx, rd, meta))...)
+	}
+}
+
+// wrapImporter allows the resource to be imported using the identity
+// by setting the resource id from the identity before calling the original importer.
+func wrapImporter(importer *schema.ResourceImporter) *schema.ResourceImporter {
+	state := importer.State
+	stateContext := importer.StateContext
+
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, rd *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+			if rd.Id() == "" {
+				identity, err := rd.Identity()
+				if err != nil {
+					return nil, err
+				}
+				if err := ValidateIdentity(
+					ctx,
+					meta,
+					identity.Get(IdentityFieldRealm).(string),
+					identity.Get(IdentityFieldOrganizationID).(string),
+				); err != nil {
+					return nil, err
+				}
+				rd.SetId(identity.Get(IdentityFieldID).(string))
+			}
+			switch {
+			case stateContext != nil:
+				return stateContext(ctx, rd, meta)
+			case state != nil:
+				return state(rd, meta)
+			}
+			return []*schema.ResourceData{rd}, nil
+		},
+	}
+}
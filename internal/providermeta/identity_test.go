@@ -0,0 +1,169 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pmeta
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRealm(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		meta   any
+		expect string
+	}{
+		{name: "no meta", meta: nil, expect: ""},
+		{name: "invalid url", meta: &Meta{APIURL: "not a url"}, expect: ""},
+		{name: "default realm", meta: &Meta{APIURL: "https://api.signalfx.com"}, expect: "us0"},
+		{name: "signalfx realm", meta: &Meta{APIURL: "https://api.us1.signalfx.com"}, expect: "us1"},
+		{name: "splunk cloud realm", meta: &Meta{APIURL: "https://api.eu0.observability.splunkcloud.com"}, expect: "eu0"},
+		{name: "proxied url", meta: &Meta{APIURL: "http://localhost:8080"}, expect: ""},
+		{name: "configured realm", meta: &Meta{APIURL: "http://localhost:8080", Realm: "us1"}, expect: "us1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, LoadRealm(context.Background(), tc.meta), "Must match the expected realm")
+		})
+	}
+}
+
+func TestValidateIdentity(t *testing.T) {
+	t.Parallel()
+
+	meta := &Meta{APIURL: "https://api.us1.signalfx.com", OrganizationID: "org-01"}
+
+	for _, tc := range []struct {
+		name   string
+		meta   any
+		realm  string
+		orgID  string
+		expect error
+	}{
+		{name: "empty identity", meta: meta},
+		{name: "matching identity", meta: meta, realm: "us1", orgID: "org-01"},
+		{name: "unknown provider values", meta: &Meta{}, realm: "us1", orgID: "org-01"},
+		{
+			name:   "different realm",
+			meta:   meta,
+			realm:  "eu0",
+			expect: errors.New(`resource identity realm "eu0" does not match the provider realm "us1"`),
+		},
+		{
+			name:   "different organization",
+			meta:   meta,
+			realm:  "us1",
+			orgID:  "org-02",
+			expect: errors.New(`resource identity organization "org-02" does not match the provider organization "org-01"`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, ValidateIdentity(context.Background(), tc.meta, tc.realm, tc.orgID), "Must match the expected error")
+		})
+	}
+}
+
+func TestResourceIdentityDecorator(t *testing.T) {
+	t.Parallel()
+
+	newResource := func() *schema.Resource {
+		return ResourceIdentityDecorator(&schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {Type: schema.TypeString, Optional: true, ForceNew: true},
+			},
+			CreateContext: func(_ context.Context, rd *schema.ResourceData, _ any) diag.Diagnostics {
+				rd.SetId("id-01")
+				return nil
+			},
+			ReadContext: func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+				return nil
+			},
+			DeleteContext: func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+				return nil
+			},
+			Importer: &schema.ResourceImporter{
+				StateContext: schema.ImportStatePassthroughContext,
+			},
+		})
+	}
+	meta := &Meta{APIURL: "https://api.us1.signalfx.com", OrganizationID: "org-01"}
+
+	t.Run("identity set on create", func(t *testing.T) {
+		t.Parallel()
+
+		res := newResource()
+		require.NoError(t, res.InternalValidate(nil, true), "Must be a valid resource")
+
+		rd := res.Data(nil)
+		require.Empty(t, res.CreateContext(context.Background(), rd, meta), "Must not error creating resource")
+
+		identity, err := rd.Identity()
+		require.NoError(t, err, "Must have an identity")
+		assert.Equal(t, "id-01", identity.Get(IdentityFieldID), "Must match the resource id")
+		assert.Equal(t, "us1", identity.Get(IdentityFieldRealm), "Must match the provider realm")
+		assert.Equal(t, "org-01", identity.Get(IdentityFieldOrganizationID), "Must match the provider organization")
+	})
+
+	t.Run("keeps realm when it can not be determined", func(t *testing.T) {
+		t.Parallel()
+
+		res := newResource()
+		rd := res.Data(&terraform.InstanceState{
+			ID: "id-01",
+			Identity: map[string]string{
+				IdentityFieldID:    "id-01",
+				IdentityFieldRealm: "us1",
+			},
+		})
+		require.Empty(t, res.ReadContext(context.Background(), rd, &Meta{APIURL: "http://localhost:8080"}), "Must not error reading resource")
+
+		identity, err := rd.Identity()
+		require.NoError(t, err, "Must have an identity")
+		assert.Equal(t, "us1", identity.Get(IdentityFieldRealm), "Must keep the stored realm")
+	})
+
+	t.Run("read from a different realm", func(t *testing.T) {
+		t.Parallel()
+
+		res := newResource()
+		rd := res.Data(&terraform.InstanceState{
+			ID: "id-01",
+			Identity: map[string]string{
+				IdentityFieldID:    "id-01",
+				IdentityFieldRealm: "eu0",
+			},
+		})
+		assert.Equal(t, diag.Diagnostics{
+			{Severity: diag.Error, Summary: `resource identity realm "eu0" does not match the provider realm "us1"`},
+		}, res.ReadContext(context.Background(), rd, meta), "Must error reading resource from a different realm")
+	})
+
+	t.Run("import by identity", func(t *testing.T) {
+		t.Parallel()
+
+		res := newResource()
+		rd := res.Data(&terraform.InstanceState{
+			Identity: map[string]string{
+				IdentityFieldID:    "id-01",
+				IdentityFieldRealm: "us1",
+			},
+		})
+		imported, err := res.Importer.StateContext(context.Background(), rd, meta)
+		require.NoError(t, err, "Must not error importing resource")
+		require.Len(t, imported, 1, "Must import a single resource")
+		assert.Equal(t, "id-01", imported[0].Id(), "Must set the id from the identity")
+	})
+}
@@ -36,6 +36,9 @@ var (
 type Meta struct {
 	Registry *feature.Registry `json:"-"`
 	Client   *signalfx.Client  `json:"-"`
+	// HTTPClient is the client used by [signalfx.Client],
+	// so that requests made outside of the go-sdk use the same timeout and retries.
+	HTTPClient *http.Client `json:"-"`
 
 	AuthToken      string   `json:"auth_token"`
 	APIURL         string   `json:"api_url"`
@@ -43,6 +46,7 @@ type Meta struct {
 	Email          string   `json:"email"`
 	Password       string   `json:"password"`
 	OrganizationID string   `json:"org_id"`
+	Realm          string   `json:"realm"`
 	Tags           []string `json:"tags"`
 	Teams          []string `json:"teams"`
 }
@@ -139,28 +143,73 @@ func (m *Meta) LoadSessionToken(ctx context.Context) (string, error) {
 	return resp.AccessToken, nil
 }
 
-// MergeProviderTeams will prepend the provider set teams to the resource level teams.
+// LoadProviderTeams fetches all the configured teams set by the provider.
 //
-// Note: This currently requires the feature preview `feature.PreviewProviderTeam` to be enabled.
-func MergeProviderTeams(ctx context.Context, meta any, teams []string) []string {
+// Requires preview to be enabled in order to return values.
+func LoadProviderTeams(ctx context.Context, meta any) []string {
 	if g, ok := LoadPreviewRegistry(ctx, meta).Get(feature.PreviewProviderTeams); !ok || !g.Enabled() {
 		tflog.Debug(
 			ctx,
 			"Feature Preview is not enabled, using default value",
 			feature.NewPreviewLogFields(feature.PreviewProviderTeams, g),
 		)
-		return teams
+		return nil
 	}
 
-	os := common.NewOrderedSet[string]()
 	if m, ok := meta.(*Meta); ok {
-		os.Append(m.Teams...)
+		return m.Teams
 	}
 
+	// Technically dead code until the preview is removed
+	return nil
+}
+
+// MergeProviderTeams will prepend the provider set teams to the resource level teams.
+//
+// Note: This currently requires the feature preview `feature.PreviewProviderTeam` to be enabled.
+func MergeProviderTeams(ctx context.Context, meta any, teams []string) []string {
+	provider := LoadProviderTeams(ctx, meta)
+	if len(provider) == 0 {
+		return teams
+	}
+
+	os := common.NewOrderedSet[string]()
+	os.Append(provider...)
 	os.Append(teams...)
 	return slices.Collect(os.All())
 }
 
+// MergeProviderWriterTeams will prepend the provider set teams to the authorized writer teams
+// only when the resource already restricts write access to a set of teams or users.
+// An unrestricted resource is left unrestricted so that provider teams never lock out other writers.
+//
+// Note: This currently requires the feature preview `feature.PreviewProviderTeam` to be enabled.
+func MergeProviderWriterTeams(ctx context.Context, meta any, teams, users []string) []string {
+	if len(teams) == 0 && len(users) == 0 {
+		return teams
+	}
+	return MergeProviderTeams(ctx, meta, teams)
+}
+
+// RemoveProviderTeams filters the provider set teams from the teams returned by the API,
+// unless the team was also explicitly configured on the resource.
+// This avoids the provider teams showing up as a perpetual diff on the resource level teams.
+func RemoveProviderTeams(ctx context.Context, meta any, teams, configured []string) []string {
+	provider := LoadProviderTeams(ctx, meta)
+	if len(provider) == 0 {
+		return teams
+	}
+
+	var filtered []string
+	for _, t := range teams {
+		if slices.Contains(provider, t) && !slices.Contains(configured, t) {
+			continue
+		}
+		filtered = append(filtered, t)
+	}
+	return filtered
+}
+
 func (m *Meta) Validate() (errs error) {
 	if m.AuthToken == "" && (m.Email == "" || m.Password == "") {
 		errs = multierr.Append(errs, errors.New("missing auth token or email and password"))
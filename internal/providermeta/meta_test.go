@@ -381,6 +381,166 @@ func TestMergeProviderTeams(t *testing.T) {
 	}
 }
 
+func TestLoadProviderTeams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		meta   any
+		expect []string
+	}{
+		{
+			name:   "no provider set",
+			meta:   nil,
+			expect: nil,
+		},
+		{
+			name:   "incorrect provider type set",
+			meta:   "provider",
+			expect: nil,
+		},
+		{
+			name: "disabled provider",
+			meta: &Meta{
+				Registry: func() *feature.Registry {
+					r := feature.NewRegistry()
+					r.MustRegister(feature.PreviewProviderTeams)
+					return r
+				}(),
+				Teams: []string{"team-00"},
+			},
+			expect: nil,
+		},
+		{
+			name: "enabled provider",
+			meta: &Meta{
+				Registry: func() *feature.Registry {
+					r := feature.NewRegistry()
+					r.MustRegister(feature.PreviewProviderTeams, feature.WithPreviewGlobalAvailable())
+					return r
+				}(),
+				Teams: []string{"team-00"},
+			},
+			expect: []string{"team-00"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, LoadProviderTeams(t.Context(), tc.meta))
+		})
+	}
+}
+
+func TestMergeProviderWriterTeams(t *testing.T) {
+	t.Parallel()
+
+	meta := &Meta{
+		Registry: func() *feature.Registry {
+			r := feature.NewRegistry()
+			_ = r.MustRegister(feature.PreviewProviderTeams, feature.WithPreviewGlobalAvailable())
+			return r
+		}(),
+		Teams: []string{"team-00"},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		teams  []string
+		users  []string
+		expect []string
+	}{
+		{
+			name:   "unrestricted writers",
+			teams:  nil,
+			users:  nil,
+			expect: nil,
+		},
+		{
+			name:   "restricted to users",
+			teams:  nil,
+			users:  []string{"user-01"},
+			expect: []string{"team-00"},
+		},
+		{
+			name:   "restricted to teams",
+			teams:  []string{"team-01"},
+			users:  nil,
+			expect: []string{"team-00", "team-01"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(
+				t,
+				tc.expect,
+				MergeProviderWriterTeams(t.Context(), meta, tc.teams, tc.users),
+				"Must match the expected details",
+			)
+		})
+	}
+}
+
+func TestRemoveProviderTeams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		meta       any
+		teams      []string
+		configured []string
+		expect     []string
+	}{
+		{
+			name:       "no provider set",
+			meta:       nil,
+			teams:      []string{"team-00", "team-01"},
+			configured: []string{"team-01"},
+			expect:     []string{"team-00", "team-01"},
+		},
+		{
+			name: "provider teams removed",
+			meta: &Meta{
+				Registry: func() *feature.Registry {
+					r := feature.NewRegistry()
+					_ = r.MustRegister(feature.PreviewProviderTeams, feature.WithPreviewGlobalAvailable())
+					return r
+				}(),
+				Teams: []string{"team-00"},
+			},
+			teams:      []string{"team-00", "team-01"},
+			configured: []string{"team-01"},
+			expect:     []string{"team-01"},
+		},
+		{
+			name: "provider teams explicitly configured",
+			meta: &Meta{
+				Registry: func() *feature.Registry {
+					r := feature.NewRegistry()
+					_ = r.MustRegister(feature.PreviewProviderTeams, feature.WithPreviewGlobalAvailable())
+					return r
+				}(),
+				Teams: []string{"team-00"},
+			},
+			teams:      []string{"team-00", "team-01"},
+			configured: []string{"team-00", "team-01"},
+			expect:     []string{"team-00", "team-01"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(
+				t,
+				tc.expect,
+				RemoveProviderTeams(t.Context(), tc.meta, tc.teams, tc.configured),
+				"Must match the expected details",
+			)
+		})
+	}
+}
+
 func TestDetectCustomAPPURL(t *testing.T) {
 	t.Parallel()
 
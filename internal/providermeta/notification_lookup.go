@@ -0,0 +1,134 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pmeta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/notification"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+// unknownValue is the placeholder the SDK uses for values that are not known until apply.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// notificationLookups holds the lookup cache for each configured provider,
+// so that each referenced ID is only requested once per run.
+var notificationLookups sync.Map
+
+type notificationLookup struct {
+	mu      sync.Mutex
+	missing map[string]bool
+}
+
+// ValidateNotificationReference returns an error when the notification references
+// an integration credential or team that does not exist.
+//
+// Requires preview to be enabled in order to perform any lookups,
+// and failures other than the reference not being found are logged and ignored
+// so that plans are not blocked by the check itself.
+func ValidateNotificationReference(ctx context.Context, meta any, n *notification.Notification) error {
+	if g, ok := LoadPreviewRegistry(ctx, meta).Get(feature.PreviewNotificationValidation); !ok || !g.Enabled() {
+		return nil
+	}
+
+	m, ok := meta.(*Meta)
+	if !ok || m.Client == nil {
+		tflog.Debug(ctx, "Provider is not configured, skipping notification validation")
+		return nil
+	}
+
+	kind, id := notificationReference(n)
+	if id == "" || id == unknownValue {
+		return nil
+	}
+
+	cached, _ := notificationLookups.LoadOrStore(m, &notificationLookup{missing: make(map[string]bool)})
+	if cached.(*notificationLookup).isMissing(ctx, m.Client, kind, id) {
+		return fmt.Errorf("%s %q does not exist", kind, id)
+	}
+	return nil
+}
+
+func (nl *notificationLookup) isMissing(ctx context.Context, client *signalfx.Client, kind, id string) bool {
+	nl.mu.Lock()
+	defer nl.mu.Unlock()
+
+	key := kind + "/" + id
+	if missing, ok := nl.missing[key]; ok {
+		return missing
+	}
+
+	var err error
+	switch kind {
+	case "team":
+		_, err = client.GetTeam(ctx, id)
+	default:
+		_, err = client.GetIntegration(ctx, id)
+	}
+
+	var re *signalfx.ResponseError
+	switch {
+	case err == nil:
+		nl.missing[key] = false
+	case errors.As(err, &re) && re.Code() == http.StatusNotFound:
+		nl.missing[key] = true
+	default:
+		// The result is not cached so the next reference can retry the lookup.
+		tflog.Warn(ctx, "Unable to validate notification reference", tfext.NewLogFields().
+			Field("kind", kind).
+			Field("id", id).
+			Error(err),
+		)
+		return false
+	}
+	return nl.missing[key]
+}
+
+// notificationReference returns the kind and ID of the object referenced by the notification,
+// or an empty ID when the notification does not reference one.
+func notificationReference(n *notification.Notification) (kind, id string) {
+	if n == nil {
+		return "", ""
+	}
+	switch v := n.Value.(type) {
+	case *notification.AmazonEventBrigeNotification:
+		return "integration credential", v.CredentialId
+	case *notification.BigPandaNotification:
+		return "integration credential", v.CredentialId
+	case *notification.JiraNotification:
+		return "integration credential", v.CredentialId
+	case *notification.Office365Notification:
+		return "integration credential", v.CredentialId
+	case *notification.OpsgenieNotification:
+		return "integration credential", v.CredentialId
+	case *notification.PagerDutyNotification:
+		return "integration credential", v.CredentialId
+	case *notification.ServiceNowNotification:
+		return "integration credential", v.CredentialId
+	case *notification.SlackNotification:
+		return "integration credential", v.CredentialId
+	case *notification.SplunkPlatformNotification:
+		return "integration credential", v.CredentialId
+	case *notification.VictorOpsNotification:
+		return "integration credential", v.CredentialId
+	case *notification.WebhookNotification:
+		return "integration credential", v.CredentialId
+	case *notification.XMattersNotification:
+		return "integration credential", v.CredentialId
+	case *notification.TeamNotification:
+		return "team", v.Team
+	case *notification.TeamEmailNotification:
+		return "team", v.Team
+	}
+	return "", ""
+}
@@ -0,0 +1,157 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pmeta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/signalfx/signalfx-go"
+	"github.com/signalfx/signalfx-go/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+)
+
+func newTestNotificationMeta(t *testing.T, enabled bool, requests *atomic.Int32) *Meta {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/integration/{id}", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		switch r.PathValue("id") {
+		case "cred":
+			_, _ = w.Write([]byte(`{"id":"cred"}`))
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	mux.HandleFunc("GET /v2/team/{id}", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.PathValue("id") == "team-01" {
+			_, _ = w.Write([]byte(`{"id":"team-01"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	client, err := signalfx.NewClient(t.Name(), signalfx.HTTPClient(s.Client()), signalfx.APIUrl(s.URL))
+	require.NoError(t, err, "Must not error creating client")
+
+	r := feature.NewRegistry()
+	preview := r.MustRegister(feature.PreviewNotificationValidation)
+	preview.SetEnabled(enabled)
+
+	return &Meta{Registry: r, Client: client}
+}
+
+func newTestNotifications(t *testing.T, values ...string) []*notification.Notification {
+	t.Helper()
+
+	raw := make([]any, 0, len(values))
+	for _, v := range values {
+		raw = append(raw, v)
+	}
+	items, err := common.NewNotificationList(raw)
+	require.NoError(t, err, "Must not error parsing notifications")
+	return items
+}
+
+func TestValidateNotificationReference(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		enabled  bool
+		values   []string
+		errs     []string
+		requests int32
+	}{
+		{
+			name:     "preview disabled",
+			enabled:  false,
+			values:   []string{"PagerDuty,typo"},
+			errs:     nil,
+			requests: 0,
+		},
+		{
+			name:     "existing references",
+			enabled:  true,
+			values:   []string{"Email,oncall@example.com", "Slack,cred,alerts", "Team,team-01"},
+			errs:     nil,
+			requests: 2,
+		},
+		{
+			name:    "missing references",
+			enabled: true,
+			values:  []string{"Slack,cred,alerts", "PagerDuty,typo", "TeamEmail,team-02"},
+			errs: []string{
+				`integration credential "typo" does not exist`,
+				`team "team-02" does not exist`,
+			},
+			requests: 3,
+		},
+		{
+			name:     "lookups are cached",
+			enabled:  true,
+			values:   []string{"PagerDuty,typo", "Jira,typo", "PagerDuty,cred", "Slack,cred,alerts"},
+			errs:     []string{`integration credential "typo" does not exist`, `integration credential "typo" does not exist`},
+			requests: 2,
+		},
+		{
+			name:     "unknown values are skipped",
+			enabled:  true,
+			values:   []string{"PagerDuty," + unknownValue},
+			errs:     nil,
+			requests: 0,
+		},
+		{
+			name:     "lookup failures are ignored",
+			enabled:  true,
+			values:   []string{"PagerDuty,broken", "Jira,broken"},
+			errs:     nil,
+			requests: 2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			meta := newTestNotificationMeta(t, tc.enabled, &requests)
+
+			var errs []string
+			for _, n := range newTestNotifications(t, tc.values...) {
+				if err := ValidateNotificationReference(t.Context(), meta, n); err != nil {
+					errs = append(errs, err.Error())
+				}
+			}
+			assert.Equal(t, tc.errs, errs, "Must match the expected errors")
+			assert.Equal(t, tc.requests, requests.Load(), "Must match the expected number of requests")
+		})
+	}
+}
+
+func TestValidateNotificationReferenceCached(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	meta := newTestNotificationMeta(t, true, &requests)
+	n := newTestNotifications(t, "PagerDuty,typo")[0]
+
+	for range 3 {
+		assert.Error(t, ValidateNotificationReference(t.Context(), meta, n), "Must report the missing credential")
+	}
+	assert.Equal(t, int32(1), requests.Load(), "Must only look up the credential once")
+
+	assert.NoError(t, ValidateNotificationReference(t.Context(), "invalid meta", n), "Must skip validation without a configured provider")
+}
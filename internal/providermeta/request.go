@@ -0,0 +1,60 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pmeta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetJSON reads the response of the API route into v, for routes that are not yet supported by the go-sdk.
+// The route is joined onto the path of the API URL, and the request is sent using the provider's
+// HTTP client so that it has the same timeout and retries as the go-sdk.
+//
+// The error uses the same message as the go-sdk so it reads the same as other API errors.
+func (m *Meta) GetJSON(ctx context.Context, route string, params url.Values, v any) error {
+	u, err := url.ParseRequestURI(m.APIURL)
+	if err != nil {
+		return err
+	}
+	u.Path = path.Join(u.Path, route)
+	u.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-SF-Token", m.AuthToken)
+	req.Header.Set("Accept", "application/json")
+
+	client := m.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		tflog.Debug(ctx, "Failed API request", map[string]any{
+			"route":       route,
+			"status_code": resp.StatusCode,
+			"body":        string(body),
+		})
+		return fmt.Errorf("route %q had issues with status code %d", route, resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,74 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pmeta
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaGetJSON(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/example", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-SF-Token") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"` + r.URL.Query().Get("name") + `"}`))
+	})
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	var v struct {
+		Name string `json:"name"`
+	}
+
+	m := &Meta{APIURL: s.URL, AuthToken: "token"}
+	require.NoError(t, m.GetJSON(t.Context(), "/v2/example", url.Values{"name": {"example"}}, &v), "Must not error reading route")
+	assert.Equal(t, "example", v.Name, "Must decode the response")
+
+	m = &Meta{APIURL: s.URL}
+	assert.EqualError(t, m.GetJSON(t.Context(), "/v2/example", nil, &v), `route "/v2/example" had issues with status code 401`, "Must report the status code")
+	assert.EqualError(t, m.GetJSON(t.Context(), "/v2/missing", nil, &v), `route "/v2/missing" had issues with status code 404`, "Must report the status code")
+
+	m = &Meta{APIURL: "not a url"}
+	assert.Error(t, m.GetJSON(t.Context(), "/v2/example", nil, &v), "Must error with an invalid api url")
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (ct *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ct.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestMetaGetJSONClient(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /proxy/v2/example", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"example"}`))
+	})
+	s := httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+
+	var v struct {
+		Name string `json:"name"`
+	}
+
+	transport := &countingTransport{}
+	m := &Meta{APIURL: s.URL + "/proxy", HTTPClient: &http.Client{Transport: transport}}
+	require.NoError(t, m.GetJSON(t.Context(), "/v2/example", nil, &v), "Must not error reading route")
+	assert.Equal(t, "example", v.Name, "Must keep the path of the api url")
+	assert.Equal(t, 1, transport.requests, "Must use the provider's http client")
+}
@@ -0,0 +1,64 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pmeta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
+)
+
+// SetProviderTeams stores the teams returned by the API into the computed field,
+// and only keeps the provider set teams in the configured field when they were explicitly set.
+// The configured field is read before being updated so it must contain the current configured value.
+func SetProviderTeams(ctx context.Context, meta any, rd *schema.ResourceData, field, computed string, teams []string) error {
+	configured := convert.SchemaListAll(rd.Get(field), convert.ToString)
+	if err := rd.Set(computed, tfext.NewSchemaSet(schema.HashString, teams)); err != nil {
+		return err
+	}
+	return rd.Set(field, tfext.NewSchemaSet(schema.HashString, RemoveProviderTeams(ctx, meta, teams, configured)))
+}
+
+// CustomizeDiffProviderTeams plans the computed field with the teams
+// that will be sent to the API once the provider set teams have been merged.
+// This allows changes to the provider teams to be shown as an update to the resource.
+func CustomizeDiffProviderTeams(field, computed string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		if !diff.NewValueKnown(field) {
+			return diff.SetNewComputed(computed)
+		}
+		return setDiffTeams(diff, computed, MergeProviderTeams(
+			ctx,
+			meta,
+			convert.SchemaListAll(diff.Get(field), convert.ToString),
+		))
+	}
+}
+
+// CustomizeDiffProviderWriterTeams plans the computed field with the authorized writer teams
+// that will be sent to the API once the provider set teams have been merged.
+func CustomizeDiffProviderWriterTeams(teams, users, computed string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+		if !diff.NewValueKnown(teams) || !diff.NewValueKnown(users) {
+			return diff.SetNewComputed(computed)
+		}
+		return setDiffTeams(diff, computed, MergeProviderWriterTeams(
+			ctx,
+			meta,
+			convert.SchemaListAll(diff.Get(teams), convert.ToString),
+			convert.SchemaListAll(diff.Get(users), convert.ToString),
+		))
+	}
+}
+
+func setDiffTeams(diff *schema.ResourceDiff, computed string, teams []string) error {
+	expect := tfext.NewSchemaSet(schema.HashString, teams)
+	if current, ok := diff.Get(computed).(*schema.Set); ok && current.Equal(expect) {
+		return nil
+	}
+	return diff.SetNew(computed, expect)
+}
@@ -0,0 +1,233 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pmeta
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+)
+
+func newTestTeamsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"teams": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"teams_all": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"writer_teams": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"writer_users": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"writer_teams_all": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: customdiff.All(
+			CustomizeDiffProviderTeams("teams", "teams_all"),
+			CustomizeDiffProviderWriterTeams("writer_teams", "writer_users", "writer_teams_all"),
+		),
+	}
+}
+
+func newTestTeamsMeta() *Meta {
+	r := feature.NewRegistry()
+	_ = r.MustRegister(feature.PreviewProviderTeams, feature.WithPreviewGlobalAvailable())
+	return &Meta{
+		Registry: r,
+		Teams:    []string{"team-00"},
+	}
+}
+
+func TestSetProviderTeams(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name       string
+		meta       any
+		configured []any
+		teams      []string
+		expect     []string
+		expectAll  []string
+	}{
+		{
+			name:       "no provider teams",
+			meta:       nil,
+			configured: []any{"team-01"},
+			teams:      []string{"team-01"},
+			expect:     []string{"team-01"},
+			expectAll:  []string{"team-01"},
+		},
+		{
+			name:       "provider teams are only computed",
+			meta:       newTestTeamsMeta(),
+			configured: []any{"team-01"},
+			teams:      []string{"team-00", "team-01"},
+			expect:     []string{"team-01"},
+			expectAll:  []string{"team-00", "team-01"},
+		},
+		{
+			name:       "provider teams explicitly configured",
+			meta:       newTestTeamsMeta(),
+			configured: []any{"team-00", "team-01"},
+			teams:      []string{"team-00", "team-01"},
+			expect:     []string{"team-00", "team-01"},
+			expectAll:  []string{"team-00", "team-01"},
+		},
+		{
+			name:       "imported resource",
+			meta:       newTestTeamsMeta(),
+			configured: nil,
+			teams:      []string{"team-00", "team-01"},
+			expect:     []string{"team-01"},
+			expectAll:  []string{"team-00", "team-01"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rd := schema.TestResourceDataRaw(t, newTestTeamsResource().Schema, map[string]any{
+				"teams": tc.configured,
+			})
+
+			require.NoError(t, SetProviderTeams(t.Context(), tc.meta, rd, "teams", "teams_all", tc.teams), "Must not error setting teams")
+			assert.ElementsMatch(t, tc.expect, convert.SchemaListAll(rd.Get("teams"), convert.ToString), "Must match the expected teams")
+			assert.ElementsMatch(t, tc.expectAll, convert.SchemaListAll(rd.Get("teams_all"), convert.ToString), "Must match the expected computed teams")
+		})
+	}
+}
+
+func TestCustomizeDiffProviderTeams(t *testing.T) {
+	t.Parallel()
+
+	setKey := func(field, value string) string {
+		hash := schema.HashSchema(&schema.Schema{Type: schema.TypeString})
+		return fmt.Sprintf("%s.%d", field, hash(value))
+	}
+
+	for _, tc := range []struct {
+		name          string
+		meta          any
+		state         *terraform.InstanceState
+		config        map[string]any
+		expectTeams   []string
+		expectWriters []string
+	}{
+		{
+			name: "new resource without provider teams",
+			meta: nil,
+			config: map[string]any{
+				"teams": []any{"team-01"},
+			},
+			expectTeams: []string{"team-01"},
+		},
+		{
+			name: "new resource with provider teams",
+			meta: newTestTeamsMeta(),
+			config: map[string]any{
+				"teams":        []any{"team-01"},
+				"writer_users": []any{"user-01"},
+			},
+			expectTeams:   []string{"team-00", "team-01"},
+			expectWriters: []string{"team-00"},
+		},
+		{
+			name: "existing resource without changes",
+			meta: newTestTeamsMeta(),
+			state: &terraform.InstanceState{
+				ID: "id-01",
+				Attributes: map[string]string{
+					"id":                           "id-01",
+					"teams.#":                      "1",
+					setKey("teams", "team-01"):     "team-01",
+					"teams_all.#":                  "2",
+					setKey("teams_all", "team-00"): "team-00",
+					setKey("teams_all", "team-01"): "team-01",
+					"writer_teams.#":               "0",
+					"writer_users.#":               "0",
+					"writer_teams_all.#":           "0",
+				},
+			},
+			config: map[string]any{
+				"teams": []any{"team-01"},
+			},
+			expectTeams: nil,
+		},
+		{
+			name: "existing resource with new provider teams",
+			meta: newTestTeamsMeta(),
+			state: &terraform.InstanceState{
+				ID: "id-01",
+				Attributes: map[string]string{
+					"id":                           "id-01",
+					"teams.#":                      "1",
+					setKey("teams", "team-01"):     "team-01",
+					"teams_all.#":                  "1",
+					setKey("teams_all", "team-01"): "team-01",
+					"writer_teams.#":               "0",
+					"writer_users.#":               "0",
+					"writer_teams_all.#":           "0",
+				},
+			},
+			config: map[string]any{
+				"teams": []any{"team-01"},
+			},
+			expectTeams: []string{"team-00", "team-01"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			diff, err := newTestTeamsResource().Diff(
+				t.Context(),
+				tc.state,
+				terraform.NewResourceConfigRaw(tc.config),
+				tc.meta,
+			)
+			require.NoError(t, err, "Must not error when creating diff")
+
+			for field, expect := range map[string][]string{
+				"teams_all":        tc.expectTeams,
+				"writer_teams_all": tc.expectWriters,
+			} {
+				if len(expect) == 0 {
+					// Computed values on new resources are always planned as unknown.
+					if diff != nil && tc.state != nil {
+						assert.NotContains(t, diff.Attributes, field+".#", "Must not plan any changes to %s", field)
+					}
+					continue
+				}
+				require.NotNil(t, diff, "Must have a planned diff")
+				require.Contains(t, diff.Attributes, field+".#", "Must plan changes to %s", field)
+				assert.Equal(t, strconv.Itoa(len(expect)), diff.Attributes[field+".#"].New, "Must match the expected number of teams")
+				for _, team := range expect {
+					assert.Contains(t, diff.Attributes, setKey(field, team), "Must plan team %q", team)
+				}
+			}
+		})
+	}
+}
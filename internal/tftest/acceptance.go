@@ -4,12 +4,15 @@
 package tftest
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"go.uber.org/multierr"
@@ -109,3 +112,41 @@ func (ah *AcceptanceHandler) Test(t *testing.T, steps []resource.TestStep) {
 
 	resource.Test(t, tc)
 }
+
+// MockTest runs the steps against the mock server created by newMeta,
+// see [NewTestHTTPMockMeta], so the test does not require access to the API.
+// It only requires the terraform binary, and is skipped when it is not available.
+func (ah *AcceptanceHandler) MockTest(t *testing.T, newMeta func(testing.TB) any, steps []resource.TestStep) {
+	if _, set := os.LookupEnv("TF_ACC_TERRAFORM_PATH"); !set {
+		if _, err := exec.LookPath("terraform"); err != nil {
+			t.Skip("Missing terraform binary to run mock tests, set TF_ACC_TERRAFORM_PATH or add terraform to the PATH")
+			return
+		}
+	}
+	if err := ah.Validate(); err != nil {
+		t.Log("Validation had the following errors:", err)
+		t.Fail()
+		return
+	}
+
+	meta := newMeta(t)
+	provider := &schema.Provider{
+		Schema:         ah.provider.Schema,
+		ResourcesMap:   ah.provider.ResourcesMap,
+		DataSourcesMap: ah.provider.DataSourcesMap,
+		ConfigureContextFunc: func(context.Context, *schema.ResourceData) (any, diag.Diagnostics) {
+			return meta, nil
+		},
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		IsUnitTest: true,
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"signalfx": func() (*schema.Provider, error) { //nolint:unparam // Required signature
+				return provider, nil
+			},
+		},
+		PreCheck: ah.beforeAll,
+		Steps:    steps,
+	})
+}
@@ -48,6 +48,7 @@ func NewTestHTTPMockMeta(routes map[string]http.HandlerFunc) func(testing.TB) an
 			APIURL:       s.URL,
 			CustomAppURL: s.URL,
 			Client:       sfx,
+			HTTPClient:   s.Client(),
 		}
 	}
 }
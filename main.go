@@ -7,6 +7,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -30,9 +31,22 @@ var (
 func main() {
 	flag.Parse()
 
+	if flag.Arg(0) == GenerateCommand {
+		if err := runGenerate(context.Background(), flag.Args()[1:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	sfx := signalfx.Provider()
+
 	providers := []func() tfprotov5.ProviderServer{
-		providerserver.NewProtocol5(internalframework.NewProvider(Version)),
-		signalfx.Provider().GRPCProvider, // Provider to be sunset during the migration of 10.x
+		providerserver.NewProtocol5(internalframework.NewProvider(
+			Version,
+			internalframework.WithProviderLegacyResources(sfx.ResourcesMap),
+			internalframework.WithProviderDashboardExportDecoder(signalfx.DecodeDashboardExport),
+		)),
+		sfx.GRPCProvider, // Provider to be sunset during the migration of 10.x
 	}
 
 	mux, err := tf5muxserver.NewMuxServer(context.Background(), providers...)
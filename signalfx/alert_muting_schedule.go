@@ -0,0 +1,478 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/signalfx/signalfx-go/alertmuting"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/check"
+)
+
+const (
+	alertMutingScheduleDateFormat = time.DateOnly
+	alertMutingScheduleTimeFormat = "15:04"
+)
+
+var alertMutingScheduleWeekdays = map[string]time.Weekday{
+	"MON": time.Monday,
+	"TUE": time.Tuesday,
+	"WED": time.Wednesday,
+	"THU": time.Thursday,
+	"FRI": time.Friday,
+	"SAT": time.Saturday,
+	"SUN": time.Sunday,
+}
+
+// alertMutingSchedule is a recurring maintenance window that is defined
+// in the wall clock time of a time zone.
+type alertMutingSchedule struct {
+	location *time.Location
+	start    time.Duration // offset from midnight
+	end      time.Duration // offset from midnight, ends the next day when not after start
+	weekdays []time.Weekday
+	week     int // week of the month, -1 is the last week
+	monthly  bool
+	exclude  map[string]bool
+	horizon  time.Duration
+}
+
+// alertMutingWindow is a single muting period, managed as its own muting rule.
+type alertMutingWindow struct {
+	id    string
+	start time.Time
+	stop  time.Time
+}
+
+func alertMutingScheduleSchema() *schema.Schema {
+	weekdays := make([]string, 0, len(alertMutingScheduleWeekdays))
+	for day := range alertMutingScheduleWeekdays {
+		weekdays = append(weekdays, day)
+	}
+	slices.Sort(weekdays)
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"start_time", "stop_time", "recurrence"},
+		Description:   "Recurring maintenance window in a time zone, which is expanded into a muting rule for each window within `horizon_days`",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timezone": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: check.TimeZoneLocation(),
+					Description:      "The time zone the start and end times are in, for example `Europe/London`",
+				},
+				"start": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateAlertMutingScheduleTime,
+					Description:  "Local time the window starts, in the format `HH:MM`",
+				},
+				"end": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateAlertMutingScheduleTime,
+					Description:  "Local time the window ends, in the format `HH:MM`. The window ends the next day when the end is not after the start",
+				},
+				"weekly": {
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"schedule.0.weekly", "schedule.0.monthly"},
+					Description:  "Repeats the window every week",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"days": {
+								Type:        schema.TypeSet,
+								Required:    true,
+								MinItems:    1,
+								Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(weekdays, false)},
+								Description: "Days of the week the window starts on, one of: " + strings.Join(weekdays, ", "),
+							},
+						},
+					},
+				},
+				"monthly": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Repeats the window every month on the Nth weekday",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"week": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntInSlice([]int{-1, 1, 2, 3, 4}),
+								Description:  "Week of the month the window starts in, from `1` to `4`, or `-1` for the last week",
+							},
+							"day": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(weekdays, false),
+								Description:  "Day of the week the window starts on, one of: " + strings.Join(weekdays, ", "),
+							},
+						},
+					},
+				},
+				"exclude_dates": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateAlertMutingScheduleDate},
+					Description: "Local dates, in the format `YYYY-MM-DD`, that a window must not start on",
+				},
+				"horizon_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      28,
+					ValidateFunc: validation.IntBetween(1, 366),
+					Description:  "Number of days ahead that muting rules are created for. Defaults to `28`",
+				},
+			},
+		},
+	}
+}
+
+func alertMutingOccurrenceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The muting rules managed for the `schedule`",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "ID of the muting rule",
+				},
+				"start_time": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Start time of the window as a Unix timestamp, in seconds",
+				},
+				"stop_time": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Stop time of the window as a Unix timestamp, in seconds",
+				},
+			},
+		},
+	}
+}
+
+func validateAlertMutingScheduleTime(v any, k string) (ws []string, errs []error) {
+	if _, err := time.Parse(alertMutingScheduleTimeFormat, v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be in the format HH:MM, got %q", k, v))
+	}
+	return ws, errs
+}
+
+func validateAlertMutingScheduleDate(v any, k string) (ws []string, errs []error) {
+	if _, err := time.Parse(alertMutingScheduleDateFormat, v.(string)); err != nil {
+		errs = append(errs, fmt.Errorf("%s must be in the format YYYY-MM-DD, got %q", k, v))
+	}
+	return ws, errs
+}
+
+func getAlertMutingSchedule(tfSchedule map[string]any) (*alertMutingSchedule, error) {
+	loc, err := time.LoadLocation(tfSchedule["timezone"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &alertMutingSchedule{
+		location: loc,
+		exclude:  make(map[string]bool),
+		horizon:  time.Duration(tfSchedule["horizon_days"].(int)) * 24 * time.Hour,
+	}
+
+	for field, target := range map[string]*time.Duration{"start": &s.start, "end": &s.end} {
+		t, err := time.Parse(alertMutingScheduleTimeFormat, tfSchedule[field].(string))
+		if err != nil {
+			return nil, fmt.Errorf("%s must be in the format HH:MM: %w", field, err)
+		}
+		*target = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+
+	if weekly, ok := tfSchedule["weekly"].([]any); ok && len(weekly) > 0 && weekly[0] != nil {
+		for _, day := range weekly[0].(map[string]any)["days"].(*schema.Set).List() {
+			s.weekdays = append(s.weekdays, alertMutingScheduleWeekdays[day.(string)])
+		}
+	}
+	if monthly, ok := tfSchedule["monthly"].([]any); ok && len(monthly) > 0 && monthly[0] != nil {
+		tfMonthly := monthly[0].(map[string]any)
+		s.monthly = true
+		s.week = tfMonthly["week"].(int)
+		s.weekdays = []time.Weekday{alertMutingScheduleWeekdays[tfMonthly["day"].(string)]}
+	}
+	if len(s.weekdays) == 0 {
+		return nil, errors.New("schedule must set one of weekly or monthly")
+	}
+
+	if dates, ok := tfSchedule["exclude_dates"].(*schema.Set); ok {
+		for _, date := range dates.List() {
+			if _, err := time.Parse(alertMutingScheduleDateFormat, date.(string)); err != nil {
+				return nil, fmt.Errorf("exclude_dates must be in the format YYYY-MM-DD, got %q", date)
+			}
+			s.exclude[date.(string)] = true
+		}
+	}
+
+	return s, nil
+}
+
+// windows returns the windows that have not ended by now and start before the schedule's horizon.
+func (s *alertMutingSchedule) windows(now time.Time) []alertMutingWindow {
+	var (
+		local = now.In(s.location)
+		until = now.Add(s.horizon)
+		// Start a day early to include a window that started yesterday and is still active.
+		date = time.Date(local.Year(), local.Month(), local.Day()-1, 0, 0, 0, 0, time.UTC)
+	)
+
+	var windows []alertMutingWindow
+	for ; ; date = date.AddDate(0, 0, 1) {
+		start := s.localTime(date, s.start)
+		if !start.Before(until) {
+			return windows
+		}
+		if !s.matches(date) {
+			continue
+		}
+		end := date
+		if s.end <= s.start {
+			end = end.AddDate(0, 0, 1)
+		}
+		stop := s.localTime(end, s.end)
+		if stop.After(now) {
+			windows = append(windows, alertMutingWindow{start: start, stop: stop})
+		}
+	}
+}
+
+// matches reports if a window starts on the date, which is represented in UTC.
+func (s *alertMutingSchedule) matches(date time.Time) bool {
+	if s.exclude[date.Format(alertMutingScheduleDateFormat)] || !slices.Contains(s.weekdays, date.Weekday()) {
+		return false
+	}
+	if !s.monthly {
+		return true
+	}
+	if s.week == -1 {
+		return date.AddDate(0, 0, 7).Month() != date.Month()
+	}
+	return (date.Day()-1)/7+1 == s.week
+}
+
+// localTime returns the wall clock time on the date within the schedule's time zone.
+// A time that does not exist due to daylight saving is moved forward by the length of the gap,
+// and a time that happens twice uses the first occurrence.
+func (s *alertMutingSchedule) localTime(date time.Time, offset time.Duration) time.Time {
+	wall := date.Add(offset)
+	t := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), 0, 0, s.location)
+	if t.Hour() == wall.Hour() && t.Minute() == wall.Minute() {
+		return t
+	}
+	// Interpret the wall clock time using the offset before the transition.
+	_, before := t.Add(-12 * time.Hour).Zone()
+	return wall.Add(-time.Duration(before) * time.Second).In(s.location)
+}
+
+// getAlertMutingWindows returns the windows currently stored in state.
+func getAlertMutingWindows(d interface{ Get(string) any }) []alertMutingWindow {
+	var windows []alertMutingWindow
+	for _, raw := range d.Get("occurrence").([]any) {
+		tfWindow := raw.(map[string]any)
+		windows = append(windows, alertMutingWindow{
+			id:    tfWindow["id"].(string),
+			start: time.Unix(int64(tfWindow["start_time"].(int)), 0),
+			stop:  time.Unix(int64(tfWindow["stop_time"].(int)), 0),
+		})
+	}
+	return windows
+}
+
+func setAlertMutingWindows(d *schema.ResourceData, windows []alertMutingWindow) error {
+	occurrences := make([]map[string]any, 0, len(windows))
+	for _, w := range windows {
+		occurrences = append(occurrences, map[string]any{
+			"id":         w.id,
+			"start_time": int(w.start.Unix()),
+			"stop_time":  int(w.stop.Unix()),
+		})
+	}
+	return d.Set("occurrence", occurrences)
+}
+
+func sameAlertMutingWindow(a, b alertMutingWindow) bool {
+	return a.start.Equal(b.start) && a.stop.Equal(b.stop)
+}
+
+// customizeDiffAlertMutingSchedule plans a change to the managed muting rules
+// once the expanded windows no longer match the ones in state.
+func customizeDiffAlertMutingSchedule(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	tfSchedule, ok := d.Get("schedule").([]any)
+	if !ok || len(tfSchedule) == 0 || tfSchedule[0] == nil {
+		return nil
+	}
+	if !d.NewValueKnown("schedule") {
+		return d.SetNewComputed("occurrence")
+	}
+
+	s, err := getAlertMutingSchedule(tfSchedule[0].(map[string]any))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	current := slices.DeleteFunc(getAlertMutingWindows(d), func(w alertMutingWindow) bool {
+		return !w.stop.After(now)
+	})
+	if d.HasChanges("description", "filter", "detectors") || !slices.EqualFunc(current, s.windows(now), sameAlertMutingWindow) {
+		return d.SetNewComputed("occurrence")
+	}
+	return nil
+}
+
+func alertMutingScheduleCreate(d *schema.ResourceData, meta any) error {
+	d.SetId(id.PrefixedUniqueId("schedule-"))
+	return alertMutingScheduleUpdate(d, meta)
+}
+
+// alertMutingScheduleRead refreshes the managed muting rules,
+// and removes the windows that have ended or were deleted outside of terraform.
+func alertMutingScheduleRead(d *schema.ResourceData, meta any) error {
+	config := meta.(*signalfxConfig)
+
+	var (
+		now     = time.Now()
+		windows []alertMutingWindow
+		last    *alertmuting.AlertMutingRule
+	)
+	for _, w := range getAlertMutingWindows(d) {
+		if !w.stop.After(now) {
+			continue
+		}
+		amr, err := config.Client.GetAlertMutingRule(context.TODO(), w.id)
+		if err != nil {
+			if isNotFoundError(err) {
+				continue
+			}
+			return err
+		}
+		windows = append(windows, w)
+		last = amr
+	}
+
+	if last != nil {
+		if err := alertMutingRuleFiltersToTF(d, last); err != nil {
+			return err
+		}
+	}
+	return setAlertMutingWindows(d, windows)
+}
+
+// alertMutingScheduleUpdate creates a muting rule for each expanded window,
+// updates the existing rules that still match a window, and deletes the rest.
+// When a request fails, the windows handled so far and the existing ones not yet deleted
+// are kept in state so that the rules already created are not lost.
+func alertMutingScheduleUpdate(d *schema.ResourceData, meta any) error {
+	config := meta.(*signalfxConfig)
+
+	s, err := getAlertMutingSchedule(d.Get("schedule").([]any)[0].(map[string]any))
+	if err != nil {
+		return err
+	}
+	payload, err := getPayloadAlertMutingRule(d)
+	if err != nil {
+		return fmt.Errorf("failed creating json payload: %s", err.Error())
+	}
+	payload.Recurrence = nil
+
+	var (
+		now      = time.Now()
+		existing = getAlertMutingWindows(d)
+		changed  = d.HasChanges("description", "filter", "detectors")
+		windows  []alertMutingWindow
+	)
+	failed := func(err error) error {
+		return errors.Join(err, setAlertMutingWindows(d, slices.Concat(windows, existing)))
+	}
+	for _, w := range s.windows(now) {
+		payload.StartTime = w.start.UnixMilli()
+		payload.StopTime = w.stop.UnixMilli()
+
+		idx := slices.IndexFunc(existing, func(e alertMutingWindow) bool { return sameAlertMutingWindow(e, w) })
+		switch {
+		case idx == -1:
+			amr, err := config.Client.CreateAlertMutingRule(context.TODO(), payload)
+			if err != nil {
+				return failed(err)
+			}
+			w.id = amr.Id
+		case changed:
+			w.id = existing[idx].id
+			if _, err := config.Client.UpdateAlertMutingRule(context.TODO(), w.id, payload); err != nil {
+				return failed(err)
+			}
+		default:
+			w.id = existing[idx].id
+		}
+		if idx != -1 {
+			existing = slices.Delete(existing, idx, idx+1)
+		}
+		windows = append(windows, w)
+	}
+
+	for len(existing) > 0 {
+		if err := deleteAlertMutingWindow(config, existing[0], now); err != nil {
+			return failed(err)
+		}
+		existing = existing[1:]
+	}
+
+	if err := setAlertMutingWindows(d, windows); err != nil {
+		return err
+	}
+	return alertMutingScheduleRead(d, meta)
+}
+
+func alertMutingScheduleDelete(d *schema.ResourceData, meta any) error {
+	config := meta.(*signalfxConfig)
+
+	now := time.Now()
+	for _, w := range getAlertMutingWindows(d) {
+		if err := deleteAlertMutingWindow(config, w, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteAlertMutingWindow(config *signalfxConfig, w alertMutingWindow, now time.Time) error {
+	if !w.stop.After(now) {
+		return nil
+	}
+	err := config.Client.DeleteAlertMutingRule(context.TODO(), w.id)
+	switch {
+	case err == nil, isNotFoundError(err):
+		return nil
+	case strings.Contains(err.Error(), "400"):
+		log.Printf("[DEBUG] SignalFx: Ignoring Delete Alert Muting Rule error 400 for alert muting in the past")
+		return nil
+	}
+	return err
+}
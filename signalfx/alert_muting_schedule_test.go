@@ -0,0 +1,393 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/signalfx/signalfx-go/alertmuting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func newTestAlertMutingSchedule(t *testing.T, tfSchedule map[string]any) *alertMutingSchedule {
+	t.Helper()
+
+	for _, field := range []string{"weekly", "monthly"} {
+		if _, ok := tfSchedule[field]; !ok {
+			tfSchedule[field] = []any{}
+		}
+	}
+	if weekly := tfSchedule["weekly"].([]any); len(weekly) > 0 {
+		days := weekly[0].(map[string]any)["days"].([]any)
+		weekly[0].(map[string]any)["days"] = schema.NewSet(schema.HashString, days)
+	}
+	exclude, _ := tfSchedule["exclude_dates"].([]any)
+	tfSchedule["exclude_dates"] = schema.NewSet(schema.HashString, exclude)
+	if _, ok := tfSchedule["horizon_days"]; !ok {
+		tfSchedule["horizon_days"] = 28
+	}
+
+	s, err := getAlertMutingSchedule(tfSchedule)
+	require.NoError(t, err, "Must not error reading schedule")
+	return s
+}
+
+func TestAlertMutingScheduleWindows(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		schedule map[string]any
+		now      string
+		expect   [][2]string
+	}{
+		{
+			name: "spring forward shortens the window",
+			schedule: map[string]any{
+				"timezone":     "America/New_York",
+				"start":        "01:00",
+				"end":          "04:00",
+				"weekly":       []any{map[string]any{"days": []any{"SUN"}}},
+				"horizon_days": 1,
+			},
+			now:    "2026-03-07T12:00:00Z",
+			expect: [][2]string{{"2026-03-08T06:00:00Z", "2026-03-08T08:00:00Z"}},
+		},
+		{
+			name: "start within the spring forward gap is moved forward",
+			schedule: map[string]any{
+				"timezone":     "America/New_York",
+				"start":        "02:30",
+				"end":          "04:00",
+				"weekly":       []any{map[string]any{"days": []any{"SUN"}}},
+				"horizon_days": 1,
+			},
+			now:    "2026-03-07T12:00:00Z",
+			expect: [][2]string{{"2026-03-08T07:30:00Z", "2026-03-08T08:00:00Z"}},
+		},
+		{
+			name: "fall back lengthens the window",
+			schedule: map[string]any{
+				"timezone":     "America/New_York",
+				"start":        "00:30",
+				"end":          "03:00",
+				"weekly":       []any{map[string]any{"days": []any{"SUN"}}},
+				"horizon_days": 1,
+			},
+			now:    "2026-10-31T12:00:00Z",
+			expect: [][2]string{{"2026-11-01T04:30:00Z", "2026-11-01T08:00:00Z"}},
+		},
+		{
+			name: "ambiguous start uses the first occurrence",
+			schedule: map[string]any{
+				"timezone":     "America/New_York",
+				"start":        "01:30",
+				"end":          "03:00",
+				"weekly":       []any{map[string]any{"days": []any{"SUN"}}},
+				"horizon_days": 1,
+			},
+			now:    "2026-10-31T12:00:00Z",
+			expect: [][2]string{{"2026-11-01T05:30:00Z", "2026-11-01T08:00:00Z"}},
+		},
+		{
+			name: "start within the gap in another time zone",
+			schedule: map[string]any{
+				"timezone":     "Europe/London",
+				"start":        "01:30",
+				"end":          "03:00",
+				"weekly":       []any{map[string]any{"days": []any{"SUN"}}},
+				"horizon_days": 1,
+			},
+			now:    "2026-03-28T12:00:00Z",
+			expect: [][2]string{{"2026-03-29T01:30:00Z", "2026-03-29T02:00:00Z"}},
+		},
+		{
+			name: "windows keep the local time across daylight saving",
+			schedule: map[string]any{
+				"timezone":     "Europe/London",
+				"start":        "09:00",
+				"end":          "10:00",
+				"weekly":       []any{map[string]any{"days": []any{"MON"}}},
+				"horizon_days": 14,
+			},
+			now: "2026-10-19T00:00:00Z",
+			expect: [][2]string{
+				{"2026-10-19T08:00:00Z", "2026-10-19T09:00:00Z"},
+				{"2026-10-26T09:00:00Z", "2026-10-26T10:00:00Z"},
+			},
+		},
+		{
+			name: "monthly on the second weekday with an overnight window",
+			schedule: map[string]any{
+				"timezone":     "UTC",
+				"start":        "22:00",
+				"end":          "02:00",
+				"monthly":      []any{map[string]any{"week": 2, "day": "TUE"}},
+				"horizon_days": 60,
+			},
+			now: "2026-10-01T00:00:00Z",
+			expect: [][2]string{
+				{"2026-10-13T22:00:00Z", "2026-10-14T02:00:00Z"},
+				{"2026-11-10T22:00:00Z", "2026-11-11T02:00:00Z"},
+			},
+		},
+		{
+			name: "monthly on the last weekday",
+			schedule: map[string]any{
+				"timezone":     "UTC",
+				"start":        "18:00",
+				"end":          "20:00",
+				"monthly":      []any{map[string]any{"week": -1, "day": "FRI"}},
+				"horizon_days": 60,
+			},
+			now: "2026-10-01T00:00:00Z",
+			expect: [][2]string{
+				{"2026-10-30T18:00:00Z", "2026-10-30T20:00:00Z"},
+				{"2026-11-27T18:00:00Z", "2026-11-27T20:00:00Z"},
+			},
+		},
+		{
+			name: "excluded dates",
+			schedule: map[string]any{
+				"timezone":      "UTC",
+				"start":         "18:00",
+				"end":           "20:00",
+				"monthly":       []any{map[string]any{"week": -1, "day": "FRI"}},
+				"exclude_dates": []any{"2026-11-27"},
+				"horizon_days":  60,
+			},
+			now:    "2026-10-01T00:00:00Z",
+			expect: [][2]string{{"2026-10-30T18:00:00Z", "2026-10-30T20:00:00Z"}},
+		},
+		{
+			name: "active window from the previous day",
+			schedule: map[string]any{
+				"timezone":     "UTC",
+				"start":        "22:00",
+				"end":          "02:00",
+				"weekly":       []any{map[string]any{"days": []any{"SUN"}}},
+				"horizon_days": 1,
+			},
+			now:    "2026-10-19T01:00:00Z",
+			expect: [][2]string{{"2026-10-18T22:00:00Z", "2026-10-19T02:00:00Z"}},
+		},
+		{
+			name: "horizon excludes later windows",
+			schedule: map[string]any{
+				"timezone":     "UTC",
+				"start":        "09:00",
+				"end":          "17:00",
+				"weekly":       []any{map[string]any{"days": []any{"MON"}}},
+				"horizon_days": 7,
+			},
+			now:    "2026-10-19T08:00:00Z",
+			expect: [][2]string{{"2026-10-19T09:00:00Z", "2026-10-19T17:00:00Z"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			now, err := time.Parse(time.RFC3339, tc.now)
+			require.NoError(t, err, "Must not error parsing now")
+
+			var actual [][2]string
+			for _, w := range newTestAlertMutingSchedule(t, tc.schedule).windows(now) {
+				actual = append(actual, [2]string{
+					w.start.UTC().Format(time.RFC3339),
+					w.stop.UTC().Format(time.RFC3339),
+				})
+			}
+			assert.Equal(t, tc.expect, actual, "Must match the expected windows")
+		})
+	}
+}
+
+func newTestAlertMutingStore() (map[string]http.HandlerFunc, map[string]*alertmuting.AlertMutingRule) {
+	var (
+		mu    sync.Mutex
+		rules = make(map[string]*alertmuting.AlertMutingRule)
+		next  int
+	)
+
+	save := func(w http.ResponseWriter, r *http.Request) {
+		var req alertmuting.CreateUpdateAlertMutingRuleRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		amr := &alertmuting.AlertMutingRule{
+			Id:          r.PathValue("id"),
+			Description: req.Description,
+			Filters:     req.Filters,
+			StartTime:   req.StartTime,
+			StopTime:    req.StopTime,
+		}
+		if amr.Id == "" {
+			next++
+			amr.Id = fmt.Sprintf("amr-%02d", next)
+			w.WriteHeader(http.StatusCreated)
+		} else if _, ok := rules[amr.Id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		rules[amr.Id] = amr
+		_ = json.NewEncoder(w).Encode(amr)
+	}
+
+	return map[string]http.HandlerFunc{
+		"POST /v2/alertmuting":     save,
+		"PUT /v2/alertmuting/{id}": save,
+		"GET /v2/alertmuting/{id}": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			amr, ok := rules[r.PathValue("id")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(amr)
+		},
+		"DELETE /v2/alertmuting/{id}": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			if _, ok := rules[r.PathValue("id")]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(rules, r.PathValue("id"))
+			w.WriteHeader(http.StatusNoContent)
+		},
+	}, rules
+}
+
+func TestAlertMutingScheduleLifecycle(t *testing.T) {
+	t.Parallel()
+
+	routes, rules := newTestAlertMutingStore()
+	meta := tftest.NewTestHTTPMockMeta(routes)(t)
+
+	d := schema.TestResourceDataRaw(t, alertMutingRuleResource().Schema, map[string]any{
+		"description": "Nightly maintenance",
+		"detectors":   []any{"detector-01"},
+		"schedule": []any{map[string]any{
+			"timezone":     "Europe/London",
+			"start":        "23:00",
+			"end":          "01:00",
+			"weekly":       []any{map[string]any{"days": []any{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}}},
+			"horizon_days": 3,
+		}},
+	})
+
+	require.NoError(t, alertMutingRuleCreate(d, meta), "Must not error creating schedule")
+	assert.Regexp(t, "^schedule-", d.Id(), "Must set a generated id")
+
+	occurrences := d.Get("occurrence").([]any)
+	require.GreaterOrEqual(t, len(occurrences), 3, "Must create a muting rule for each window")
+	require.Len(t, rules, len(occurrences), "Must create a muting rule for each window")
+	for _, raw := range occurrences {
+		o := raw.(map[string]any)
+		amr, ok := rules[o["id"].(string)]
+		require.True(t, ok, "Must reference a created muting rule")
+		assert.Equal(t, int64(o["start_time"].(int))*1000, amr.StartTime, "Must create the rule for the window")
+		assert.Equal(t, int64(o["stop_time"].(int))*1000, amr.StopTime, "Must create the rule for the window")
+		assert.Equal(t, "Nightly maintenance", amr.Description, "Must set the description")
+		assert.Nil(t, amr.Recurrence, "Must not set a recurrence")
+	}
+	assert.Equal(t, []any{"detector-01"}, d.Get("detectors"), "Must read the detectors")
+
+	// Deleting a muting rule outside of terraform removes it from state, and it is recreated on update.
+	removed := occurrences[len(occurrences)-1].(map[string]any)["id"].(string)
+	delete(rules, removed)
+	require.NoError(t, alertMutingRuleRead(d, meta), "Must not error reading schedule")
+	assert.Len(t, d.Get("occurrence"), len(occurrences)-1, "Must drop the deleted muting rule")
+
+	require.NoError(t, alertMutingRuleUpdate(d, meta), "Must not error updating schedule")
+	assert.Len(t, d.Get("occurrence"), len(occurrences), "Must recreate the deleted muting rule")
+	assert.Len(t, rules, len(occurrences), "Must recreate the deleted muting rule")
+
+	require.NoError(t, alertMutingRuleDelete(d, meta), "Must not error deleting schedule")
+	assert.Empty(t, rules, "Must delete every muting rule")
+}
+
+func TestCustomizeDiffAlertMutingSchedule(t *testing.T) {
+	t.Parallel()
+
+	r := alertMutingRuleResource()
+	config, err := ctyjson.Unmarshal([]byte(`{
+		"description": "Weekly maintenance",
+		"detectors": ["detector-01"],
+		"schedule": [{
+			"timezone": "America/New_York",
+			"start": "01:00",
+			"end": "03:00",
+			"weekly": [{"days": ["SAT", "SUN"]}],
+			"exclude_dates": ["2026-12-26"]
+		}]
+	}`), r.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err, "Must not error creating config")
+
+	diff, err := r.Diff(
+		context.Background(),
+		&terraform.InstanceState{RawConfig: config},
+		terraform.NewResourceConfigShimmed(config, r.CoreConfigSchema()),
+		nil,
+	)
+	require.NoError(t, err, "Must not error planning schedule")
+	require.NotNil(t, diff, "Must plan the schedule")
+	assert.True(t, diff.Attributes["occurrence.#"].NewComputed, "Must plan the muting rules to be created")
+}
+
+func TestAlertMutingScheduleFailedCreate(t *testing.T) {
+	t.Parallel()
+
+	routes, rules := newTestAlertMutingStore()
+	var created int
+	save := routes["POST /v2/alertmuting"]
+	routes["POST /v2/alertmuting"] = func(w http.ResponseWriter, r *http.Request) {
+		if created++; created > 2 {
+			http.Error(w, "too many requests", http.StatusBadRequest)
+			return
+		}
+		save(w, r)
+	}
+	meta := tftest.NewTestHTTPMockMeta(routes)(t)
+
+	d := schema.TestResourceDataRaw(t, alertMutingRuleResource().Schema, map[string]any{
+		"description": "Nightly maintenance",
+		"detectors":   []any{"detector-01"},
+		"schedule": []any{map[string]any{
+			"timezone":     "Europe/London",
+			"start":        "23:00",
+			"end":          "01:00",
+			"weekly":       []any{map[string]any{"days": []any{"MON", "TUE", "WED", "THU", "FRI", "SAT", "SUN"}}},
+			"horizon_days": 3,
+		}},
+	})
+
+	require.Error(t, alertMutingRuleCreate(d, meta), "Must report the failed muting rule")
+	require.Len(t, rules, 2, "Must have created the first muting rules")
+
+	var ids []string
+	for _, raw := range d.Get("occurrence").([]any) {
+		ids = append(ids, raw.(map[string]any)["id"].(string))
+	}
+	assert.ElementsMatch(t, []string{"amr-01", "amr-02"}, ids, "Must keep the created muting rules in state")
+
+	require.NoError(t, alertMutingRuleDelete(d, meta), "Must not error deleting schedule")
+	assert.Empty(t, rules, "Must delete the created muting rules")
+}
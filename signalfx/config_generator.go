@@ -0,0 +1,431 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/zclconf/go-cty/cty"
+
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+// chartGenerators maps the chart type returned by the API
+// to the resource that manages it, and the method used to read it into state.
+var chartGenerators = map[string]struct {
+	resource string
+	apiToTF  func(d *schema.ResourceData, c *chart.Chart) error
+}{
+	"TimeSeriesChart":     {resource: "signalfx_time_chart", apiToTF: timechartAPIToTF},
+	"List":                {resource: "signalfx_list_chart", apiToTF: listchartAPIToTF},
+	"SingleValue":         {resource: "signalfx_single_value_chart", apiToTF: singlevaluechartAPIToTF},
+	"Heatmap":             {resource: "signalfx_heatmap_chart", apiToTF: heatmapchartAPIToTF},
+	"Text":                {resource: "signalfx_text_chart", apiToTF: textchartAPIToTF},
+	"TableChart":          {resource: "signalfx_table_chart", apiToTF: tablechartAPIToTF},
+	"Event":               {resource: "signalfx_event_feed_chart", apiToTF: eventfeedchartAPIToTF},
+	"LogsChart":           {resource: "signalfx_log_view", apiToTF: logViewAPIToTF},
+	"LogsTimeSeriesChart": {resource: "signalfx_log_timeline", apiToTF: logTimelineAPIToTF},
+	"SloChart":            {resource: "signalfx_slo_chart", apiToTF: slochartAPIToTF},
+}
+
+// referenceAttributes maps the attributes that hold the ID of another object
+// to the kind of resource they refer to, so that other values are never written as references.
+var referenceAttributes = map[string]string{
+	"dashboard_group":    "signalfx_dashboard_group",
+	"dashboard_id":       "signalfx_dashboard",
+	"parent_detector_id": "signalfx_detector",
+	"signal":             "signalfx_detector",
+	"chart_id":           "chart",
+	"chart_ids":          "chart",
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type generatedResource struct {
+	kind string
+	name string
+	data *schema.ResourceData
+}
+
+// ConfigGenerator reads existing objects from the API and writes them
+// as terraform configuration, along with the import blocks required to adopt them.
+// Objects that refer to other generated objects are written using references
+// instead of the ID so that terraform can determine the order of operations.
+type ConfigGenerator struct {
+	meta      *pmeta.Meta
+	resources map[string]*schema.Resource
+
+	generated []*generatedResource
+	refs      map[string]*generatedResource
+	names     map[string]int
+}
+
+func NewConfigGenerator(meta *pmeta.Meta) *ConfigGenerator {
+	return &ConfigGenerator{
+		meta:      meta,
+		resources: Provider().ResourcesMap,
+		refs:      make(map[string]*generatedResource),
+		names:     make(map[string]int),
+	}
+}
+
+// AddDashboardGroup generates the dashboard group along with the dashboards it contains,
+// the charts within those dashboards, and any detectors that are used as event overlays.
+func (g *ConfigGenerator) AddDashboardGroup(ctx context.Context, id string) error {
+	if _, exist := g.refs[id]; exist {
+		return nil
+	}
+
+	dg, err := g.meta.Client.GetDashboardGroup(ctx, id)
+	if err != nil {
+		return fmt.Errorf("dashboard group %q: %w", id, err)
+	}
+
+	err = g.add("signalfx_dashboard_group", dg.Id, dg.Name, func(d *schema.ResourceData) error {
+		return dashboardGroupAPIToTF(d, dg, g.meta)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, dashID := range dg.Dashboards {
+		if _, exist := g.refs[dashID]; exist {
+			continue
+		}
+
+		dash, err := g.meta.Client.GetDashboard(ctx, dashID)
+		if err != nil {
+			return fmt.Errorf("dashboard %q: %w", dashID, err)
+		}
+		// Dashboards that are mirrored from another group are skipped
+		// since they are managed as part of the group that owns them.
+		if dash.GroupId != dg.Id {
+			continue
+		}
+		if err := g.addDashboard(ctx, dash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddDashboard generates the dashboard, the charts within it,
+// and any detectors that are used as event overlays.
+func (g *ConfigGenerator) AddDashboard(ctx context.Context, id string) error {
+	if _, exist := g.refs[id]; exist {
+		return nil
+	}
+
+	dash, err := g.meta.Client.GetDashboard(ctx, id)
+	if err != nil {
+		return fmt.Errorf("dashboard %q: %w", id, err)
+	}
+	return g.addDashboard(ctx, dash)
+}
+
+func (g *ConfigGenerator) addDashboard(ctx context.Context, dash *dashboard.Dashboard) error {
+	// The charts are added first so the dashboard can reference them.
+	for _, c := range dash.Charts {
+		if err := g.AddChart(ctx, c.ChartId); err != nil {
+			return err
+		}
+	}
+
+	for _, overlay := range slices.Concat(dash.EventOverlays, dash.SelectedEventOverlays) {
+		if overlay == nil || overlay.EventSignal == nil || overlay.EventSignal.DetectorId == "" {
+			continue
+		}
+		if err := g.AddDetector(ctx, overlay.EventSignal.DetectorId); err != nil {
+			return err
+		}
+	}
+
+	return g.add("signalfx_dashboard", dash.Id, dash.Name, func(d *schema.ResourceData) error {
+		return dashboardAPIToTF(d, dash, g.meta)
+	})
+}
+
+// AddChart generates the chart using the resource that matches the chart type.
+func (g *ConfigGenerator) AddChart(ctx context.Context, id string) error {
+	if _, exist := g.refs[id]; exist {
+		return nil
+	}
+
+	c, err := g.meta.Client.GetChart(ctx, id)
+	if err != nil {
+		return fmt.Errorf("chart %q: %w", id, err)
+	}
+
+	var chartType string
+	if c.Options != nil {
+		chartType = c.Options.Type
+	}
+
+	gen, ok := chartGenerators[chartType]
+	if !ok {
+		return fmt.Errorf("chart %q has unsupported type %q", id, chartType)
+	}
+
+	return g.add(gen.resource, c.Id, c.Name, func(d *schema.ResourceData) error {
+		return gen.apiToTF(d, c)
+	})
+}
+
+// AddDetector generates the detector.
+func (g *ConfigGenerator) AddDetector(ctx context.Context, id string) error {
+	if _, exist := g.refs[id]; exist {
+		return nil
+	}
+
+	det, err := g.meta.Client.GetDetector(ctx, id)
+	if err != nil {
+		return fmt.Errorf("detector %q: %w", id, err)
+	}
+
+	return g.add("signalfx_detector", det.Id, det.Name, func(d *schema.ResourceData) error {
+		return detectorAPIToTF(d, det, g.meta)
+	})
+}
+
+func (g *ConfigGenerator) add(kind, id, name string, apiToTF func(d *schema.ResourceData) error) error {
+	res, ok := g.resources[kind]
+	if !ok {
+		return fmt.Errorf("unknown resource %q", kind)
+	}
+
+	d := res.Data(nil)
+	d.SetId(id)
+	if err := apiToTF(d); err != nil {
+		return fmt.Errorf("%s %q: %w", kind, id, err)
+	}
+
+	gen := &generatedResource{
+		kind: kind,
+		name: g.resourceName(kind, name),
+		data: d,
+	}
+	g.generated = append(g.generated, gen)
+	g.refs[id] = gen
+	return nil
+}
+
+// resourceName converts the object name into a valid, unique terraform identifier.
+func (g *ConfigGenerator) resourceName(kind, name string) string {
+	name = strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = strings.TrimPrefix(kind, "signalfx_") + "_" + name
+	}
+	name = strings.TrimSuffix(name, "_")
+
+	key := kind + "." + name
+	g.names[key]++
+	if n := g.names[key]; n > 1 {
+		return fmt.Sprintf("%s_%d", name, n)
+	}
+	return name
+}
+
+// WriteTo writes all of the generated resources as HCL.
+func (g *ConfigGenerator) WriteTo(w io.Writer) (int64, error) {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	for i, gen := range g.generated {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		imp := body.AppendNewBlock("import", nil).Body()
+		imp.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: gen.kind},
+			hcl.TraverseAttr{Name: gen.name},
+		})
+		imp.SetAttributeValue("id", cty.StringVal(gen.data.Id()))
+		body.AppendNewline()
+
+		block := body.AppendNewBlock("resource", []string{gen.kind, gen.name})
+		g.writeBody(block.Body(), g.resources[gen.kind].SchemaMap(), func(key string) any {
+			return gen.data.Get(key)
+		})
+	}
+
+	return f.WriteTo(w)
+}
+
+func (g *ConfigGenerator) writeBody(body *hclwrite.Body, sm map[string]*schema.Schema, get func(key string) any) {
+	keys := make([]string, 0, len(sm))
+	for k := range sm {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var blocks []string
+	for _, key := range keys {
+		s := sm[key]
+		if s.Computed && !s.Optional {
+			continue
+		}
+
+		v := get(key)
+		if omitGeneratedValue(s, v) {
+			continue
+		}
+
+		if _, nested := s.Elem.(*schema.Resource); nested && s.ConfigMode != schema.SchemaConfigModeAttr {
+			blocks = append(blocks, key)
+			continue
+		}
+		body.SetAttributeRaw(key, g.valueTokens(key, v))
+	}
+
+	// Blocks are written after the attributes to match how configuration is typically written.
+	if len(blocks) > 0 && len(body.Attributes()) > 0 {
+		body.AppendNewline()
+	}
+	for _, key := range blocks {
+		s := sm[key]
+		for _, item := range listValues(get(key)) {
+			values, _ := item.(map[string]any)
+			g.writeBody(body.AppendNewBlock(key, nil).Body(), s.Elem.(*schema.Resource).SchemaMap(), func(key string) any {
+				return values[key]
+			})
+		}
+	}
+}
+
+func (g *ConfigGenerator) valueTokens(key string, v any) hclwrite.Tokens {
+	switch v := v.(type) {
+	case string:
+		if ref, ok := g.refs[v]; ok && isReference(key, ref) {
+			return hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: ref.kind},
+				hcl.TraverseAttr{Name: ref.name},
+				hcl.TraverseAttr{Name: "id"},
+			})
+		}
+		if key == "program_text" || strings.Contains(v, "\n") {
+			return heredocTokens(v)
+		}
+		return hclwrite.TokensForValue(cty.StringVal(v))
+	case int:
+		return hclwrite.TokensForValue(cty.NumberIntVal(int64(v)))
+	case float64:
+		return hclwrite.TokensForValue(cty.NumberFloatVal(v))
+	case bool:
+		return hclwrite.TokensForValue(cty.BoolVal(v))
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		attrs := make([]hclwrite.ObjectAttrTokens, 0, len(keys))
+		for _, k := range keys {
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(k)),
+				Value: g.valueTokens(k, v[k]),
+			})
+		}
+		return hclwrite.TokensForObject(attrs)
+	}
+
+	values := listValues(v)
+	tuple := make([]hclwrite.Tokens, 0, len(values))
+	for _, item := range values {
+		tuple = append(tuple, g.valueTokens(key, item))
+	}
+	return hclwrite.TokensForTuple(tuple)
+}
+
+// isReference returns true when the attribute holds the ID of the generated resource.
+func isReference(key string, ref *generatedResource) bool {
+	kind, ok := referenceAttributes[key]
+	if kind == "chart" {
+		for _, gen := range chartGenerators {
+			if gen.resource == ref.kind {
+				return true
+			}
+		}
+		return false
+	}
+	return ok && kind == ref.kind
+}
+
+// heredocTokens writes the string as a heredoc so that multi-line values
+// such as SignalFlow programs remain readable.
+// The heredoc always ends with a newline, so values without one are wrapped with chomp.
+func heredocTokens(v string) hclwrite.Tokens {
+	marker := "EOF"
+	for slices.ContainsFunc(strings.Split(v, "\n"), func(line string) bool {
+		return strings.TrimSpace(line) == marker
+	}) {
+		marker = "_" + marker
+	}
+
+	content := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(v)
+	chomp := !strings.HasSuffix(content, "\n")
+	if chomp {
+		content += "\n"
+	}
+
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<" + marker + "\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(content)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte(marker)},
+	}
+	if chomp {
+		// The closing marker must be followed by a newline before the function call can be closed.
+		tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")})
+		return hclwrite.TokensForFunctionCall("chomp", tokens)
+	}
+	return tokens
+}
+
+func listValues(v any) []any {
+	switch v := v.(type) {
+	case []any:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return nil
+}
+
+// omitGeneratedValue reports if the value does not need to be written
+// since it would be the same value as what terraform would use if it was not set.
+func omitGeneratedValue(s *schema.Schema, v any) bool {
+	if s.Required {
+		return false
+	}
+	if s.Default != nil {
+		return fmt.Sprint(s.Default) == fmt.Sprint(v)
+	}
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case map[string]any:
+		return len(v) == 0
+	}
+	return len(listValues(v)) == 0
+}
@@ -0,0 +1,212 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/signalfx/signalfx-go/dashboard_group"
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func newJSONHandler(v any) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(v)
+	}
+}
+
+func TestConfigGenerator(t *testing.T) {
+	t.Parallel()
+
+	const program = "A = data('cpu.utilization', filter=filter('host', '${host}')).mean().publish(label='A')\nB = (A).max()"
+
+	density := dashboard.DEFAULT
+	meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/dashboardgroup/group-01": newJSONHandler(&dashboard_group.DashboardGroup{
+			Id:         "group-01",
+			Name:       "Infrastructure",
+			Dashboards: []string{"dash-01", "dash-02"},
+		}),
+		"GET /v2/dashboard/dash-01": newJSONHandler(&dashboard.Dashboard{
+			Id:           "dash-01",
+			Name:         "Hosts",
+			GroupId:      "group-01",
+			ChartDensity: &density,
+			Charts: []*dashboard.DashboardChart{
+				{ChartId: "chart-01", Width: 6, Height: 1},
+				{ChartId: "chart-02", Column: 6, Width: 6, Height: 1},
+				{ChartId: "chart-03", Row: 1, Width: 6, Height: 1},
+			},
+			EventOverlays: []*dashboard.ChartEventOverlay{
+				{EventSignal: &dashboard.DashboardEventSignal{EventType: "detectorEvents", EventSearchText: "detector-01", DetectorId: "detector-01"}},
+			},
+		}),
+		"GET /v2/dashboard/dash-02": newJSONHandler(&dashboard.Dashboard{
+			Id:           "dash-02",
+			Name:         "Mirrored",
+			GroupId:      "group-02",
+			ChartDensity: &density,
+		}),
+		"GET /v2/chart/chart-01": newJSONHandler(&chart.Chart{
+			Id:          "chart-01",
+			Name:        "CPU",
+			Description: "detector-01",
+			ProgramText: program,
+			Options:     &chart.Options{Type: "TimeSeriesChart"},
+		}),
+		"GET /v2/chart/chart-02": newJSONHandler(&chart.Chart{
+			Id:      "chart-02",
+			Name:    "CPU",
+			Options: &chart.Options{Type: "Text", Markdown: "# Notes"},
+		}),
+		"GET /v2/chart/chart-03": newJSONHandler(&chart.Chart{
+			Id:      "chart-03",
+			Name:    "Availability",
+			SloId:   "slo-01",
+			Options: &chart.Options{Type: "SloChart"},
+		}),
+		"GET /v2/detector/detector-01": newJSONHandler(&detector.Detector{
+			Id:          "detector-01",
+			Name:        "High CPU",
+			ProgramText: "detect(when(data('cpu.utilization') > 90)).publish('high')",
+		}),
+	})(t).(*pmeta.Meta)
+
+	gen := NewConfigGenerator(meta)
+	require.NoError(t, gen.AddDashboardGroup(t.Context(), "group-01"), "Must not error generating the group")
+
+	var buf bytes.Buffer
+	_, err := gen.WriteTo(&buf)
+	require.NoError(t, err, "Must not error writing the configuration")
+
+	file, diags := hclsyntax.ParseConfig(buf.Bytes(), "generated.tf", hcl.InitialPos)
+	require.False(t, diags.HasErrors(), "Must generate valid HCL: %s\n%s", diags, buf.String())
+
+	resources := make(map[string]*hclsyntax.Block)
+	var imports int
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		switch block.Type {
+		case "import":
+			imports++
+		case "resource":
+			resources[block.Labels[0]+"."+block.Labels[1]] = block
+		}
+	}
+
+	assert.Equal(t, 6, imports, "Must have an import block for each resource")
+	assert.ElementsMatch(t, []string{
+		"signalfx_dashboard_group.infrastructure",
+		"signalfx_dashboard.hosts",
+		"signalfx_time_chart.cpu",
+		"signalfx_text_chart.cpu",
+		"signalfx_slo_chart.availability",
+		"signalfx_detector.high_cpu",
+	}, keys(resources), "Must generate the expected resources without the mirrored dashboard")
+
+	dash := resources["signalfx_dashboard.hosts"].Body
+	group := dash.Attributes["dashboard_group"].Expr.Variables()
+	require.Len(t, group, 1, "Must reference the dashboard group")
+	assert.Equal(t, "signalfx_dashboard_group", group[0].RootName(), "Must reference the dashboard group")
+
+	var charts []string
+	for _, block := range dash.Blocks {
+		if block.Type == "chart" {
+			for _, v := range block.Body.Attributes["chart_id"].Expr.Variables() {
+				charts = append(charts, v.RootName())
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"signalfx_time_chart", "signalfx_text_chart", "signalfx_slo_chart"}, charts, "Must reference the charts")
+
+	var overlays []string
+	for _, block := range dash.Blocks {
+		if block.Type == "event_overlay" {
+			for _, v := range block.Body.Attributes["signal"].Expr.Variables() {
+				overlays = append(overlays, v.RootName())
+			}
+		}
+	}
+	assert.Equal(t, []string{"signalfx_detector"}, overlays, "Must reference the overlay detector")
+
+	sloID, diags := resources["signalfx_slo_chart.availability"].Body.Attributes["slo_id"].Expr.Value(nil)
+	require.False(t, diags.HasErrors(), "Must evaluate the SLO ID: %s", diags)
+	assert.Equal(t, cty.StringVal("slo-01"), sloID, "Must match the SLO ID")
+
+	description := resources["signalfx_time_chart.cpu"].Body.Attributes["description"].Expr
+	assert.Empty(t, description.Variables(), "Must not reference values that are not IDs")
+
+	value, diags := resources["signalfx_time_chart.cpu"].Body.Attributes["program_text"].Expr.Value(&hcl.EvalContext{
+		Functions: map[string]function.Function{"chomp": stdlib.ChompFunc},
+	})
+	require.False(t, diags.HasErrors(), "Must evaluate the program text: %s", diags)
+	assert.Equal(t, cty.StringVal(program), value, "Must match the original program text")
+	assert.Contains(t, buf.String(), "<<EOF", "Must write the program text as a heredoc")
+}
+
+func TestConfigGeneratorUnsupportedChart(t *testing.T) {
+	t.Parallel()
+
+	meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/chart/chart-01": newJSONHandler(&chart.Chart{
+			Id:      "chart-01",
+			Options: &chart.Options{Type: "Unknown"},
+		}),
+	})(t).(*pmeta.Meta)
+
+	err := NewConfigGenerator(meta).AddChart(t.Context(), "chart-01")
+	assert.EqualError(t, err, `chart "chart-01" has unsupported type "Unknown"`, "Must report the unsupported chart type")
+}
+
+func TestHeredocTokens(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name  string
+		value string
+	}{
+		{name: "trailing newline", value: "A = data('x').publish()\n"},
+		{name: "no trailing newline", value: "A = data('x').publish()"},
+		{name: "template sequences", value: "A = data('${x}').publish(label='%{y}')"},
+		{name: "contains marker", value: "A = data('x')\nEOF\nA.publish()"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			src := append([]byte("v = "), heredocTokens(tc.value).Bytes()...)
+			src = append(src, '\n')
+
+			file, diags := hclsyntax.ParseConfig(src, "test.tf", hcl.InitialPos)
+			require.False(t, diags.HasErrors(), "Must be valid HCL: %s\n%s", diags, src)
+
+			value, diags := file.Body.(*hclsyntax.Body).Attributes["v"].Expr.Value(&hcl.EvalContext{
+				Functions: map[string]function.Function{"chomp": stdlib.ChompFunc},
+			})
+			require.False(t, diags.HasErrors(), "Must evaluate: %s", diags)
+			assert.Equal(t, tc.value, value.AsString(), "Must match the original value")
+		})
+	}
+}
+
+func keys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
@@ -0,0 +1,463 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+// inlineChartTypes are the chart resources that can be defined within a dashboard
+// using the `chart_definition` block, the block name matches the resource name without the provider prefix.
+// The payload builders and state readers of the chart resources are reused so both stay in sync.
+var inlineChartTypes = map[string]struct {
+	resource func() *schema.Resource
+	payload  func(d *schema.ResourceData) (*chart.CreateUpdateChartRequest, error)
+	apiToTF  func(d *schema.ResourceData, c *chart.Chart) error
+}{
+	"time_chart": {
+		resource: timeChartResource,
+		payload: func(d *schema.ResourceData) (*chart.CreateUpdateChartRequest, error) {
+			return getPayloadTimeChart(d), nil
+		},
+		apiToTF: timechartAPIToTF,
+	},
+	"list_chart": {
+		resource: listChartResource,
+		payload:  getPayloadListChart,
+		apiToTF:  listchartAPIToTF,
+	},
+	"single_value_chart": {
+		resource: singleValueChartResource,
+		payload: func(d *schema.ResourceData) (*chart.CreateUpdateChartRequest, error) {
+			return getPayloadSingleValueChart(d), nil
+		},
+		apiToTF: singlevaluechartAPIToTF,
+	},
+	"heatmap_chart": {
+		resource: heatmapChartResource,
+		payload:  getPayloadHeatmapChart,
+		apiToTF:  heatmapchartAPIToTF,
+	},
+	"table_chart": {
+		resource: tableChartResource,
+		payload:  getPayloadTableChart,
+		apiToTF:  tablechartAPIToTF,
+	},
+	"event_feed_chart": {
+		resource: eventFeedChartResource,
+		payload: func(d *schema.ResourceData) (*chart.CreateUpdateChartRequest, error) {
+			return getPayloadEventFeedChart(d), nil
+		},
+		apiToTF: eventfeedchartAPIToTF,
+	},
+	"text_chart": {
+		resource: textChartResource,
+		payload: func(d *schema.ResourceData) (*chart.CreateUpdateChartRequest, error) {
+			return getPayloadTextChart(d), nil
+		},
+		apiToTF: textchartAPIToTF,
+	},
+}
+
+// dashboardChartDefinitionSchema returns the schema for the `chart_definition` block.
+func dashboardChartDefinitionSchema() *schema.Schema {
+	definition := map[string]*schema.Schema{
+		"key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "Unique key of the definition within the dashboard, used to match the definition to its chart when definitions are added, removed or reordered",
+		},
+		"chart_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the chart created for the definition",
+		},
+		"row": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The row to show the chart in (zero-based); if height > 1, this value represents the topmost row of the chart. (greater than or equal to 0)",
+		},
+		"column": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 11),
+			Description:  "The column to show the chart in (zero-based); this value always represents the leftmost column of the chart. (between 0 and 11)",
+		},
+		"width": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      12,
+			ValidateFunc: validation.IntBetween(1, 12),
+			Description:  "How many columns (out of a total of 12, one-based) the chart should take up. (between 1 and 12)",
+		},
+		"height": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "How many rows the chart should take up. (greater than or equal to 1)",
+		},
+	}
+	for name, inline := range inlineChartTypes {
+		definition[name] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: fmt.Sprintf("Definition of the chart, accepts the same arguments as `signalfx_%s`", name),
+			Elem:        &schema.Resource{Schema: inlineChartSchema(inline.resource())},
+		}
+	}
+
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"grid", "column"},
+		Description:   "Chart defined as part of the dashboard, the chart is created, updated and deleted along with the dashboard",
+		Elem:          &schema.Resource{Schema: definition},
+	}
+}
+
+// inlineChartSchema copies the chart resource schema so it can be nested within the dashboard.
+// Computed only and deprecated attributes are omitted, and the conflicts are removed
+// since they refer to the top level attributes of the chart resource.
+func inlineChartSchema(res *schema.Resource) map[string]*schema.Schema {
+	attrs := make(map[string]*schema.Schema, len(res.Schema))
+	for name, s := range res.Schema {
+		if (s.Computed && !s.Optional) || s.Deprecated != "" {
+			continue
+		}
+		copied := *s
+		copied.ConflictsWith = nil
+		attrs[name] = &copied
+	}
+	return attrs
+}
+
+// dashboardChartDefinition is a single `chart_definition` entry.
+type dashboardChartDefinition struct {
+	key     string
+	chartID string
+	kind    string
+	layout  *dashboard.DashboardChart
+	values  map[string]any
+}
+
+// dashboardChartDefinitionKind returns the name of the chart block set within the definition.
+func dashboardChartDefinitionKind(tf map[string]any) string {
+	for name := range inlineChartTypes {
+		if block, ok := tf[name].([]any); ok && len(block) > 0 {
+			return name
+		}
+	}
+	return ""
+}
+
+// priorDashboardChartDefinitions returns the chart IDs of the previously applied definitions by their key,
+// a chart is only kept when the definition still has the same chart type.
+func priorDashboardChartDefinitions(d *schema.ResourceData) map[string]*dashboardChartDefinition {
+	prior, _ := d.GetChange("chart_definition")
+
+	definitions := make(map[string]*dashboardChartDefinition)
+	for _, v := range prior.([]any) {
+		if tf, ok := v.(map[string]any); ok && tf["chart_id"] != "" {
+			definitions[tf["key"].(string)] = &dashboardChartDefinition{
+				key:     tf["key"].(string),
+				chartID: tf["chart_id"].(string),
+				kind:    dashboardChartDefinitionKind(tf),
+			}
+		}
+	}
+	return definitions
+}
+
+// getDashboardChartDefinitions reads the configured definitions,
+// the chart ID is taken from the prior definition with the same key and chart type
+// so that adding, removing or reordering definitions does not update the wrong chart.
+func getDashboardChartDefinitions(d *schema.ResourceData) ([]*dashboardChartDefinition, error) {
+	var (
+		definitions []*dashboardChartDefinition
+		prior       = priorDashboardChartDefinitions(d)
+		keys        = make(map[string]bool)
+	)
+	for i, v := range d.Get("chart_definition").([]any) {
+		tf, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("chart_definition.%d must not be empty", i)
+		}
+
+		key, _ := tf["key"].(string)
+		if keys[key] {
+			return nil, fmt.Errorf("chart_definition.%d has duplicate key %q", i, key)
+		}
+		keys[key] = true
+
+		def := &dashboardChartDefinition{
+			key: key,
+			layout: &dashboard.DashboardChart{
+				Row:    int32(tf["row"].(int)),
+				Column: int32(tf["column"].(int)),
+				Width:  int32(tf["width"].(int)),
+				Height: int32(tf["height"].(int)),
+			},
+		}
+		for name := range inlineChartTypes {
+			block, ok := tf[name].([]any)
+			if !ok || len(block) == 0 {
+				continue
+			}
+			if def.kind != "" {
+				return nil, fmt.Errorf("chart_definition.%d must only define one of %q or %q", i, def.kind, name)
+			}
+			def.kind = name
+			def.values, _ = block[0].(map[string]any)
+		}
+		if def.kind == "" {
+			return nil, fmt.Errorf("chart_definition.%d must define a chart", i)
+		}
+		if p, ok := prior[key]; ok && p.kind == def.kind {
+			def.chartID = p.chartID
+		}
+		definitions = append(definitions, def)
+	}
+	return definitions, nil
+}
+
+// payload builds the chart request using the payload builder of the chart resource.
+func (def *dashboardChartDefinition) payload(meta any) (*chart.CreateUpdateChartRequest, error) {
+	inline := inlineChartTypes[def.kind]
+	res := inline.resource()
+
+	d := res.Data(nil)
+	for name, value := range def.values {
+		if _, ok := res.Schema[name]; !ok || value == nil {
+			continue
+		}
+		if err := d.Set(name, value); err != nil {
+			return nil, fmt.Errorf("chart_definition %s: %w", def.kind, err)
+		}
+	}
+
+	payload, err := inline.payload(d)
+	if err != nil {
+		return nil, err
+	}
+	payload.Tags = common.Unique(
+		pmeta.LoadProviderTags(context.Background(), meta),
+		payload.Tags,
+	)
+	return payload, nil
+}
+
+// saveDashboardChartDefinitions creates or updates the charts defined within the dashboard,
+// and returns the layout of the charts to include in the dashboard.
+// The layout of the charts saved so far is returned on error, so that the caller can remove them.
+func saveDashboardChartDefinitions(ctx context.Context, d *schema.ResourceData, meta any) ([]*dashboard.DashboardChart, error) {
+	config := meta.(*signalfxConfig)
+
+	definitions, err := getDashboardChartDefinitions(d)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		charts = make([]*dashboard.DashboardChart, 0, len(definitions))
+		tf     = d.Get("chart_definition").([]any)
+	)
+	for i, def := range definitions {
+		var payload *chart.CreateUpdateChartRequest
+		if payload, err = def.payload(meta); err != nil {
+			break
+		}
+
+		debugOutput, _ := json.Marshal(payload)
+		log.Printf("[DEBUG] SignalFx: Dashboard Chart Definition Payload: %s", string(debugOutput))
+
+		var c *chart.Chart
+		if def.chartID == "" {
+			c, err = config.Client.CreateChart(ctx, payload)
+		} else {
+			c, err = config.Client.UpdateChart(ctx, def.chartID, payload)
+		}
+		if err != nil {
+			err = fmt.Errorf("chart_definition.%d: %w", i, err)
+			break
+		}
+
+		def.layout.ChartId = c.Id
+		charts = append(charts, def.layout)
+		tf[i].(map[string]any)["chart_id"] = c.Id
+	}
+
+	// The chart IDs are stored straight away so that an update that fails
+	// to save the dashboard still tracks the created charts within the state.
+	// A failed create discards the state, so the caller removes the charts instead.
+	return charts, errors.Join(err, d.Set("chart_definition", tf))
+}
+
+// deleteDashboardChartDefinitions removes the charts that were created by chart definitions,
+// or for the layout section headers, charts that have already been removed are ignored.
+func deleteDashboardChartDefinitions(ctx context.Context, meta any, ids []string) error {
+	config := meta.(*signalfxConfig)
+
+	var errs []error
+	for _, id := range ids {
+		if err := config.Client.DeleteChart(ctx, id); err != nil && !isNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("chart %q: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// removedDashboardChartDefinitions returns the chart IDs that are no longer defined,
+// which includes the charts of definitions that changed the chart type.
+func removedDashboardChartDefinitions(d *schema.ResourceData) []string {
+	definitions, err := getDashboardChartDefinitions(d)
+	if err != nil {
+		return nil
+	}
+
+	var kept []string
+	for _, def := range definitions {
+		kept = append(kept, def.chartID)
+	}
+
+	var removed []string
+	for _, def := range priorDashboardChartDefinitions(d) {
+		if !slices.Contains(kept, def.chartID) {
+			removed = append(removed, def.chartID)
+		}
+	}
+	slices.Sort(removed)
+	return removed
+}
+
+// dashboardChartIDs returns the IDs of the charts within the layout.
+func dashboardChartIDs(charts []*dashboard.DashboardChart) []string {
+	ids := make([]string, 0, len(charts))
+	for _, c := range charts {
+		ids = append(ids, c.ChartId)
+	}
+	return ids
+}
+
+// customizeDiffDashboardChartDefinitions checks that the keys of the chart definitions are unique,
+// since the key is used to match each definition to its chart.
+func customizeDiffDashboardChartDefinitions(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	keys := make(map[string]bool)
+	for i, v := range diff.Get("chart_definition").([]any) {
+		tf, ok := v.(map[string]any)
+		if !ok || tf["key"] == "" {
+			continue
+		}
+		if keys[tf["key"].(string)] {
+			return fmt.Errorf("chart_definition.%d has duplicate key %q", i, tf["key"])
+		}
+		keys[tf["key"].(string)] = true
+	}
+	return nil
+}
+
+// dashboardChartDefinitionsAPIToTF refreshes the charts defined within the dashboard,
+// and returns the chart IDs so they can be excluded from the `chart` blocks.
+// Charts that no longer exist have their definition cleared so that they are recreated.
+func dashboardChartDefinitionsAPIToTF(ctx context.Context, d *schema.ResourceData, dash *dashboard.Dashboard, meta any) ([]string, error) {
+	tf, ok := d.Get("chart_definition").([]any)
+	if !ok || len(tf) == 0 {
+		return nil, nil
+	}
+
+	config, ok := meta.(*signalfxConfig)
+	if !ok {
+		return nil, errors.New("chart definitions require a configured provider")
+	}
+
+	layouts := make(map[string]*dashboard.DashboardChart, len(dash.Charts))
+	for _, c := range dash.Charts {
+		layouts[c.ChartId] = c
+	}
+
+	var ids []string
+	for i, v := range tf {
+		def, ok := v.(map[string]any)
+		if !ok || def["chart_id"] == "" {
+			continue
+		}
+		id := def["chart_id"].(string)
+		ids = append(ids, id)
+
+		c, err := config.Client.GetChart(ctx, id)
+		if isNotFoundError(err) {
+			def["chart_id"] = ""
+			for name := range inlineChartTypes {
+				def[name] = []any{}
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("chart_definition.%d: %w", i, err)
+		}
+
+		kind, err := readDashboardChartDefinition(def, c)
+		if err != nil {
+			return nil, fmt.Errorf("chart_definition.%d: %w", i, err)
+		}
+		for name := range inlineChartTypes {
+			if name != kind {
+				def[name] = []any{}
+			}
+		}
+
+		if layout, ok := layouts[id]; ok {
+			def["row"] = int(layout.Row)
+			def["column"] = int(layout.Column)
+			def["width"] = int(layout.Width)
+			def["height"] = int(layout.Height)
+		}
+	}
+
+	return ids, d.Set("chart_definition", tf)
+}
+
+// readDashboardChartDefinition reads the chart into the definition using the state reader of the chart resource,
+// it returns the name of the chart block that was set.
+func readDashboardChartDefinition(def map[string]any, c *chart.Chart) (string, error) {
+	if c.Options == nil {
+		return "", fmt.Errorf("chart %q is missing options", c.Id)
+	}
+	kind := strings.TrimPrefix(chartGenerators[c.Options.Type].resource, "signalfx_")
+	if _, ok := inlineChartTypes[kind]; !ok {
+		return "", fmt.Errorf("chart %q has unsupported type %q", c.Id, c.Options.Type)
+	}
+
+	inline := inlineChartTypes[kind]
+	res := inline.resource()
+	d := res.Data(nil)
+	d.SetId(c.Id)
+	if err := inline.apiToTF(d, c); err != nil {
+		return "", err
+	}
+
+	values := make(map[string]any)
+	for name := range inlineChartSchema(res) {
+		values[name] = d.Get(name)
+	}
+	def[kind] = []any{values}
+	return kind, nil
+}
@@ -0,0 +1,327 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+// newTestChartStore mimics the chart and dashboard API using an in memory store.
+func newTestChartStore() (map[string]http.HandlerFunc, map[string]*chart.Chart) {
+	var (
+		mu     sync.Mutex
+		charts = make(map[string]*chart.Chart)
+		dash   *dashboard.Dashboard
+	)
+
+	saveDashboard := func(w http.ResponseWriter, r *http.Request) {
+		var req dashboard.CreateUpdateDashboardRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		dash = &dashboard.Dashboard{
+			Id:           "dash-01",
+			Name:         req.Name,
+			GroupId:      req.GroupId,
+			ChartDensity: &req.ChartDensity,
+			Charts:       req.Charts,
+		}
+		_ = json.NewEncoder(w).Encode(dash)
+	}
+	saveChart := func(w http.ResponseWriter, r *http.Request) {
+		var c chart.Chart
+		if err := json.NewDecoder(r.Body).Decode(&c); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		c.Id = r.PathValue("id")
+		if c.Id == "" {
+			c.Id = fmt.Sprintf("chart-%02d", len(charts)+1)
+		}
+		charts[c.Id] = &c
+		_ = json.NewEncoder(w).Encode(c)
+	}
+
+	return map[string]http.HandlerFunc{
+		"POST /v2/dashboard":        saveDashboard,
+		"PUT /v2/dashboard/dash-01": saveDashboard,
+		"GET /v2/dashboard/dash-01": func(w http.ResponseWriter, _ *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			_ = json.NewEncoder(w).Encode(dash)
+		},
+		"DELETE /v2/dashboard/dash-01": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		},
+		"POST /v2/chart":     saveChart,
+		"PUT /v2/chart/{id}": saveChart,
+		"GET /v2/chart/{id}": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			c, ok := charts[r.PathValue("id")]
+			if !ok {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(c)
+		},
+		"DELETE /v2/chart/{id}": func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			if _, ok := charts[r.PathValue("id")]; !ok {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			delete(charts, r.PathValue("id"))
+			w.WriteHeader(http.StatusOK)
+		},
+	}, charts
+}
+
+func TestDashboardChartDefinitionSchema(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, dashboardResource().InternalValidate(nil, true), "Must be a valid resource schema")
+
+	elem := dashboardChartDefinitionSchema().Elem.(*schema.Resource)
+	for name := range inlineChartTypes {
+		assert.Contains(t, elem.Schema, name, "Must define a block for each inline chart type")
+	}
+
+	inline := inlineChartSchema(timeChartResource())
+	assert.NotContains(t, inline, "url", "Must not include computed only attributes")
+	assert.NotContains(t, inline, "legend_fields_to_hide", "Must not include deprecated attributes")
+	assert.Empty(t, inline["time_range"].ConflictsWith, "Must not include conflicts")
+	assert.NotEmpty(t, timeChartResource().Schema["time_range"].ConflictsWith, "Must not modify the chart resource schema")
+}
+
+func TestGetDashboardChartDefinitions(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		raw    map[string]any
+		expect []*dashboardChartDefinition
+		errVal string
+	}{
+		{
+			name: "no definitions",
+			raw:  map[string]any{},
+		},
+		{
+			name: "text chart",
+			raw: map[string]any{
+				"chart_definition": []any{
+					map[string]any{
+						"key":        "notes",
+						"row":        1,
+						"width":      6,
+						"text_chart": []any{map[string]any{"name": "Notes", "markdown": "# Notes"}},
+					},
+				},
+			},
+			expect: []*dashboardChartDefinition{
+				{
+					key:    "notes",
+					kind:   "text_chart",
+					layout: &dashboard.DashboardChart{Row: 1, Width: 6, Height: 1},
+					values: map[string]any{"name": "Notes", "markdown": "# Notes"},
+				},
+			},
+		},
+		{
+			name: "missing chart",
+			raw: map[string]any{
+				"chart_definition": []any{
+					map[string]any{"key": "empty", "row": 1},
+				},
+			},
+			errVal: "chart_definition.0 must define a chart",
+		},
+		{
+			name: "duplicate key",
+			raw: map[string]any{
+				"chart_definition": []any{
+					map[string]any{"key": "notes", "text_chart": []any{map[string]any{"name": "Notes", "markdown": "# Notes"}}},
+					map[string]any{"key": "notes", "text_chart": []any{map[string]any{"name": "More", "markdown": "# More"}}},
+				},
+			},
+			errVal: "chart_definition.1 has duplicate key \"notes\"",
+		},
+		{
+			name: "multiple charts",
+			raw: map[string]any{
+				"chart_definition": []any{
+					map[string]any{
+						"key":              "notes",
+						"text_chart":       []any{map[string]any{"name": "Notes", "markdown": "# Notes"}},
+						"event_feed_chart": []any{map[string]any{"name": "Events", "program_text": "A = events('deploy').publish()"}},
+					},
+				},
+			},
+			errVal: "chart_definition.0 must only define one of",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, dashboardResource().Schema, tc.raw)
+			actual, err := getDashboardChartDefinitions(d)
+			if tc.errVal != "" {
+				assert.ErrorContains(t, err, tc.errVal, "Must return the expected error")
+				return
+			}
+			require.NoError(t, err, "Must not error reading chart definitions")
+			require.Len(t, actual, len(tc.expect), "Must match the expected number of definitions")
+			for i, expect := range tc.expect {
+				assert.Equal(t, expect.key, actual[i].key, "Must match the key")
+				assert.Equal(t, expect.kind, actual[i].kind, "Must match the chart type")
+				assert.Equal(t, expect.layout, actual[i].layout, "Must match the chart layout")
+				for k, v := range expect.values {
+					assert.Equal(t, v, actual[i].values[k], "Must match the chart value %q", k)
+				}
+			}
+		})
+	}
+}
+
+func TestDashboardChartDefinitionLifecycle(t *testing.T) {
+	t.Parallel()
+
+	routes, charts := newTestChartStore()
+	meta := tftest.NewTestHTTPMockMeta(routes)(t)
+
+	d := schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]any{
+		"name":            "Hosts",
+		"dashboard_group": "group-01",
+		"chart_definition": []any{
+			map[string]any{
+				"key":   "cpu",
+				"width": 6,
+				"time_chart": []any{map[string]any{
+					"name":         "CPU",
+					"program_text": "A = data('cpu.utilization').publish(label='A')",
+					"plot_type":    "LineChart",
+				}},
+			},
+			map[string]any{
+				"key":    "notes",
+				"column": 6,
+				"width":  6,
+				"text_chart": []any{map[string]any{
+					"name":     "Notes",
+					"markdown": "# Notes",
+				}},
+			},
+		},
+	})
+
+	require.NoError(t, dashboardCreate(d, meta), "Must not error creating dashboard")
+	assert.Equal(t, "dash-01", d.Id(), "Must set the dashboard id")
+	require.Len(t, charts, 2, "Must create the defined charts")
+	assert.Equal(t, "TimeSeriesChart", charts["chart-01"].Options.Type, "Must create the time chart")
+	assert.Equal(t, "Text", charts["chart-02"].Options.Type, "Must create the text chart")
+
+	assert.Equal(t, "chart-01", d.Get("chart_definition.0.chart_id"), "Must expose the chart id")
+	assert.Equal(t, "chart-02", d.Get("chart_definition.1.chart_id"), "Must expose the chart id")
+	assert.Equal(t, 6, d.Get("chart_definition.1.column"), "Must read the chart layout")
+	assert.Equal(t, "CPU", d.Get("chart_definition.0.time_chart.0.name"), "Must read the chart definition")
+	assert.Empty(t, d.Get("chart_definition.0.text_chart"), "Must only set the chart type that matches")
+	assert.Equal(t, 0, d.Get("chart.#"), "Must not include inline charts in the chart layout")
+
+	// Removing a chart from the API causes the definition to be cleared so it is recreated.
+	delete(charts, "chart-02")
+	require.NoError(t, dashboardRead(d, meta), "Must not error reading dashboard")
+	assert.Equal(t, "", d.Get("chart_definition.1.chart_id"), "Must clear the removed chart")
+	assert.Empty(t, d.Get("chart_definition.1.text_chart"), "Must clear the removed chart definition")
+
+	require.NoError(t, dashboardDelete(d, meta), "Must not error deleting dashboard")
+	assert.Empty(t, charts, "Must delete the defined charts")
+}
+
+func TestRemovedDashboardChartDefinitions(t *testing.T) {
+	t.Parallel()
+
+	r := dashboardResource()
+	state := r.Data(nil)
+	state.SetId("dash-01")
+	require.NoError(t, state.Set("chart_definition", []any{
+		map[string]any{"key": "first", "chart_id": "chart-01", "text_chart": []any{map[string]any{"name": "First", "markdown": "1"}}},
+		map[string]any{"key": "second", "chart_id": "chart-02", "text_chart": []any{map[string]any{"name": "Second", "markdown": "2"}}},
+	}))
+
+	newData := func(definitions ...any) *schema.ResourceData {
+		diff, err := r.Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(map[string]any{
+			"name":             "Hosts",
+			"dashboard_group":  "group-01",
+			"chart_definition": definitions,
+		}), nil)
+		require.NoError(t, err, "Must not error creating diff")
+
+		d, err := schema.InternalMap(r.Schema).Data(state.State(), diff)
+		require.NoError(t, err, "Must not error creating resource data")
+		return d
+	}
+
+	first := map[string]any{"key": "first", "text_chart": []any{map[string]any{"name": "First", "markdown": "1"}}}
+	second := map[string]any{"key": "second", "text_chart": []any{map[string]any{"name": "Second", "markdown": "2"}}}
+	changed := map[string]any{"key": "first", "event_feed_chart": []any{map[string]any{"name": "First", "program_text": "A = events('deploy').publish()"}}}
+
+	assert.Empty(t, removedDashboardChartDefinitions(newData(first, second)), "Must not report unchanged definitions")
+	assert.Empty(t, removedDashboardChartDefinitions(newData(second, first)), "Must not report reordered definitions")
+	assert.Equal(t, []string{"chart-01"}, removedDashboardChartDefinitions(newData(second)), "Must report the removed chart")
+	assert.Equal(t, []string{"chart-01"}, removedDashboardChartDefinitions(newData(changed, second)), "Must report the chart that changed type")
+	assert.Equal(t, []string{"chart-01", "chart-02"}, removedDashboardChartDefinitions(newData()), "Must report all removed charts")
+
+	definitions, err := getDashboardChartDefinitions(newData(second, first))
+	require.NoError(t, err, "Must not error reading chart definitions")
+	assert.Equal(t, "chart-02", definitions[0].chartID, "Must match the chart by key")
+	assert.Equal(t, "chart-01", definitions[1].chartID, "Must match the chart by key")
+
+	definitions, err = getDashboardChartDefinitions(newData(changed, second))
+	require.NoError(t, err, "Must not error reading chart definitions")
+	assert.Empty(t, definitions[0].chartID, "Must create a new chart when the type changes")
+}
+
+func TestDashboardChartDefinitionFailedCreate(t *testing.T) {
+	t.Parallel()
+
+	routes, charts := newTestChartStore()
+	routes["POST /v2/dashboard"] = func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "invalid dashboard", http.StatusBadRequest)
+	}
+	meta := tftest.NewTestHTTPMockMeta(routes)(t)
+
+	d := schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]any{
+		"name":            "Hosts",
+		"dashboard_group": "group-01",
+		"chart_definition": []any{
+			map[string]any{"key": "first", "text_chart": []any{map[string]any{"name": "First", "markdown": "1"}}},
+			map[string]any{"key": "second", "text_chart": []any{map[string]any{"name": "Second", "markdown": "2"}}},
+		},
+	})
+
+	require.Error(t, dashboardCreate(d, meta), "Must report the failed dashboard")
+	assert.Empty(t, d.Id(), "Must not set the dashboard id")
+	assert.Empty(t, charts, "Must remove the charts created for the dashboard")
+}
@@ -0,0 +1,152 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+)
+
+// dashboardExport is the format used by the UI when exporting a dashboard as JSON.
+type dashboardExport struct {
+	PackageType     string `json:"packageType"`
+	DashboardExport struct {
+		Dashboard *dashboard.Dashboard `json:"dashboard"`
+	} `json:"dashboardExport"`
+	ChartExports []struct {
+		Chart *chart.Chart `json:"chart"`
+	} `json:"chartExports"`
+}
+
+// DecodeDashboardExport converts the JSON exported from the UI into the arguments
+// of the `signalfx_dashboard` resource and the chart resources it contains.
+// The charts are keyed by their exported ID, which is also how the dashboard refers to them,
+// and each chart includes the resource type that manages it along with its arguments.
+func DecodeDashboardExport(data []byte) (map[string]any, error) {
+	var export dashboardExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("invalid dashboard export: %w", err)
+	}
+	if export.PackageType != "" && export.PackageType != "DASHBOARD" {
+		return nil, fmt.Errorf("invalid dashboard export: unsupported package type %q", export.PackageType)
+	}
+
+	dash := export.DashboardExport.Dashboard
+	if dash == nil {
+		return nil, errors.New("invalid dashboard export: missing dashboard")
+	}
+	if dash.ChartDensity == nil {
+		density := dashboard.DEFAULT
+		dash.ChartDensity = &density
+	}
+
+	resources := Provider().ResourcesMap
+
+	dashArgs, err := exportedArguments(resources["signalfx_dashboard"], func(d *schema.ResourceData) error {
+		return dashboardAPIToTF(d, dash, nil)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dashboard %q: %w", dash.Id, err)
+	}
+
+	charts := make(map[string]any, len(export.ChartExports))
+	for _, ce := range export.ChartExports {
+		c := ce.Chart
+		if c == nil {
+			continue
+		}
+
+		var chartType string
+		if c.Options != nil {
+			chartType = c.Options.Type
+		}
+
+		gen, ok := chartGenerators[chartType]
+		if !ok {
+			return nil, fmt.Errorf("chart %q has unsupported type %q", c.Id, chartType)
+		}
+
+		args, err := exportedArguments(resources[gen.resource], func(d *schema.ResourceData) error {
+			return gen.apiToTF(d, c)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("chart %q: %w", c.Id, err)
+		}
+		charts[c.Id] = map[string]any{
+			"resource":  gen.resource,
+			"arguments": args,
+		}
+	}
+
+	return map[string]any{
+		"dashboard": dashArgs,
+		"charts":    charts,
+	}, nil
+}
+
+// exportedArguments reads the object into a resource's data using its API to TF mapper,
+// then returns the values that would need to be set within the configuration.
+func exportedArguments(res *schema.Resource, apiToTF func(d *schema.ResourceData) error) (map[string]any, error) {
+	d := res.Data(nil)
+	if err := apiToTF(d); err != nil {
+		return nil, err
+	}
+	return configuredValues(res.SchemaMap(), d.Get), nil
+}
+
+// configuredValues follows the same rules as the config generator so that
+// only values that differ from what terraform would use by default are included.
+// Sets are converted into lists and nested blocks into lists of objects.
+// Empty strings and numbers are also omitted, since the export leaves out options
+// that were never changed and the mappers read those as zero values instead of the default.
+func configuredValues(sm map[string]*schema.Schema, get func(key string) any) map[string]any {
+	values := make(map[string]any, len(sm))
+	for key, s := range sm {
+		if s.Computed && !s.Optional {
+			continue
+		}
+
+		v := get(key)
+		if omitGeneratedValue(s, v) || (!s.Required && isMissingValue(v)) {
+			continue
+		}
+
+		if nested, ok := s.Elem.(*schema.Resource); ok {
+			items := listValues(v)
+			blocks := make([]any, 0, len(items))
+			for _, item := range items {
+				tf, _ := item.(map[string]any)
+				blocks = append(blocks, configuredValues(nested.SchemaMap(), func(key string) any {
+					return tf[key]
+				}))
+			}
+			values[key] = blocks
+			continue
+		}
+
+		if _, ok := v.(*schema.Set); ok {
+			v = listValues(v)
+		}
+		values[key] = v
+	}
+	return values
+}
+
+// isMissingValue reports if the value is the zero value that is read when the option is not set.
+func isMissingValue(v any) bool {
+	switch v := v.(type) {
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"testing"
+
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	fwexport "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework/export"
+)
+
+const testDashboardExport = `{
+	"packageType": "DASHBOARD",
+	"modelVersion": 1,
+	"dashboardExport": {
+		"dashboard": {
+			"id": "dash-01",
+			"name": "Hosts",
+			"description": "Host overview",
+			"groupId": "group-01",
+			"chartDensity": "HIGH",
+			"charts": [
+				{"chartId": "chart-01", "row": 0, "column": 0, "width": 6, "height": 1},
+				{"chartId": "chart-02", "row": 0, "column": 6, "width": 6, "height": 1}
+			]
+		}
+	},
+	"chartExports": [
+		{
+			"chart": {
+				"id": "chart-01",
+				"name": "CPU",
+				"programText": "A = data('cpu.utilization').publish(label='A')",
+				"tags": ["team-a"],
+				"options": {"type": "TimeSeriesChart", "defaultPlotType": "LineChart"}
+			}
+		},
+		{
+			"chart": {
+				"id": "chart-02",
+				"name": "Notes",
+				"options": {"type": "Text", "markdown": "# Notes"}
+			}
+		}
+	]
+}`
+
+func TestDecodeDashboardExport(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		data   string
+		errVal string
+	}{
+		{name: "invalid json", data: `{`, errVal: "invalid dashboard export: unexpected end of JSON input"},
+		{name: "missing dashboard", data: `{"chartExports": []}`, errVal: "invalid dashboard export: missing dashboard"},
+		{name: "unsupported package", data: `{"packageType": "GROUP"}`, errVal: `invalid dashboard export: unsupported package type "GROUP"`},
+		{
+			name:   "unsupported chart",
+			data:   `{"dashboardExport": {"dashboard": {"name": "Hosts"}}, "chartExports": [{"chart": {"id": "chart-01", "options": {"type": "Unknown"}}}]}`,
+			errVal: `chart "chart-01" has unsupported type "Unknown"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := DecodeDashboardExport([]byte(tc.data))
+			assert.EqualError(t, err, tc.errVal, "Must return the expected error")
+		})
+	}
+}
+
+func TestDecodeDashboardExportValues(t *testing.T) {
+	t.Parallel()
+
+	actual, err := DecodeDashboardExport([]byte(testDashboardExport))
+	require.NoError(t, err, "Must not error decoding the export")
+
+	dash, ok := actual["dashboard"].(map[string]any)
+	require.True(t, ok, "Must include the dashboard arguments")
+	assert.Equal(t, "Hosts", dash["name"], "Must include the dashboard name")
+	assert.Equal(t, "group-01", dash["dashboard_group"], "Must include the dashboard group")
+	assert.Equal(t, "high", dash["charts_resolution"], "Must convert the chart density")
+	assert.NotContains(t, dash, "url", "Must not include computed only attributes")
+	assert.ElementsMatch(t, []any{
+		map[string]any{"chart_id": "chart-01", "width": 6},
+		map[string]any{"chart_id": "chart-02", "column": 6, "width": 6},
+	}, dash["chart"], "Must include the chart layout without default values")
+
+	charts, ok := actual["charts"].(map[string]any)
+	require.True(t, ok, "Must include the charts")
+	assert.Equal(t, map[string]any{
+		"resource": "signalfx_time_chart",
+		"arguments": map[string]any{
+			"name":         "CPU",
+			"program_text": "A = data('cpu.utilization').publish(label='A')",
+			"tags":         []any{"team-a"},
+		},
+	}, charts["chart-01"], "Must convert the time chart")
+	assert.Equal(t, map[string]any{
+		"resource": "signalfx_text_chart",
+		"arguments": map[string]any{
+			"name":     "Notes",
+			"markdown": "# Notes",
+		},
+	}, charts["chart-02"], "Must convert the text chart")
+}
+
+func TestDecodeDashboardSnapshot(t *testing.T) {
+	t.Parallel()
+
+	density := dashboard.DEFAULT
+	doc, _, err := fwexport.NewDashboardSnapshot(
+		&dashboard.Dashboard{
+			Id:           "dash-01",
+			Name:         "Hosts",
+			GroupId:      "group-01",
+			ChartDensity: &density,
+			Charts: []*dashboard.DashboardChart{
+				{ChartId: "chart-01", Width: 6, Height: 1},
+				{ChartId: "chart-02", Column: 6, Width: 6, Height: 1},
+			},
+		},
+		map[string]*chart.Chart{
+			"chart-01": {Id: "chart-01", Name: "Notes", Options: &chart.Options{Type: "Text", Markdown: "# Notes"}},
+			"chart-02": {Id: "chart-02", Name: "Availability", SloId: "slo-01", Options: &chart.Options{Type: "SloChart"}},
+		},
+	)
+	require.NoError(t, err, "Must not error creating the snapshot")
+
+	actual, err := DecodeDashboardExport(doc)
+	require.NoError(t, err, "Must decode the snapshot")
+	assert.Equal(t, "dashboard_group", actual["dashboard"].(map[string]any)["dashboard_group"], "Must use the symbolic dashboard group")
+	assert.Equal(t, map[string]any{
+		"chart_1": map[string]any{
+			"resource":  "signalfx_text_chart",
+			"arguments": map[string]any{"name": "Notes", "markdown": "# Notes"},
+		},
+		"chart_2": map[string]any{
+			"resource":  "signalfx_slo_chart",
+			"arguments": map[string]any{"slo_id": "slo_1"},
+		},
+	}, actual["charts"], "Must key the charts by their symbolic reference")
+}
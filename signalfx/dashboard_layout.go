@@ -0,0 +1,453 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/signalfx/signalfx-go/chart"
+	"github.com/signalfx/signalfx-go/dashboard"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+// dashboardColumns is the number of columns available within a dashboard.
+const dashboardColumns = 12
+
+// dashboardLayoutSchema returns the schema for the `layout` block.
+func dashboardLayoutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"chart", "grid", "column", "chart_definition"},
+		Description:   "Automatic dashboard layout. Sections are placed below each other in the order they are defined",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"section": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: "Group of charts, the rows of the section are placed first followed by the flow charts",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Name of the section, when set a text chart with the name is placed above the section",
+							},
+							"header_height": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      1,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "How many rows the section header should take up. (greater than or equal to 1)",
+							},
+							"header_chart_id": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "ID of the text chart created for the section name",
+							},
+							"row": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Charts placed next to each other, sharing the 12 columns using the relative widths",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"chart_ids": {
+											Type:        schema.TypeList,
+											Required:    true,
+											MinItems:    1,
+											MaxItems:    dashboardColumns,
+											Elem:        &schema.Schema{Type: schema.TypeString},
+											Description: "Charts to place within the row",
+										},
+										"widths": {
+											Type:        schema.TypeList,
+											Optional:    true,
+											Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntAtLeast(1)},
+											Description: "Relative width of each chart, the charts share the columns equally by default",
+										},
+										"height": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      1,
+											ValidateFunc: validation.IntAtLeast(1),
+											Description:  "How many rows each chart should take up. (greater than or equal to 1)",
+										},
+									},
+								},
+							},
+							"flow": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Description: "Charts that are packed into the first available space, in the order they are defined",
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"chart_id": {
+											Type:        schema.TypeString,
+											Required:    true,
+											Description: "ID of the chart to display",
+										},
+										"width": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      dashboardColumns,
+											ValidateFunc: validation.IntBetween(1, dashboardColumns),
+											Description:  "How many columns (out of a total of 12, one-based) the chart should take up. (between 1 and 12)",
+										},
+										"height": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Default:      1,
+											ValidateFunc: validation.IntAtLeast(1),
+											Description:  "How many rows the chart should take up. (greater than or equal to 1)",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type layoutSection struct {
+	name         string
+	headerID     string
+	headerHeight int
+	rows         []layoutRow
+	flow         []layoutItem
+}
+
+type layoutRow struct {
+	chartIDs []string
+	widths   []int
+	height   int
+}
+
+type layoutItem struct {
+	chartID string
+	width   int
+	height  int
+}
+
+func getDashboardLayoutSections(d *schema.ResourceData) []*layoutSection {
+	layout, ok := d.Get("layout").([]any)
+	if !ok || len(layout) == 0 || layout[0] == nil {
+		return nil
+	}
+
+	var sections []*layoutSection
+	for _, v := range layout[0].(map[string]any)["section"].([]any) {
+		tf, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		section := &layoutSection{
+			name:         tf["name"].(string),
+			headerID:     tf["header_chart_id"].(string),
+			headerHeight: tf["header_height"].(int),
+		}
+		for _, r := range tf["row"].([]any) {
+			row := r.(map[string]any)
+			section.rows = append(section.rows, layoutRow{
+				chartIDs: convert.SliceAll(row["chart_ids"].([]any), convert.ToString),
+				widths:   convert.SliceAll(row["widths"].([]any), func(v any) int { return v.(int) }),
+				height:   row["height"].(int),
+			})
+		}
+		for _, f := range tf["flow"].([]any) {
+			item := f.(map[string]any)
+			section.flow = append(section.flow, layoutItem{
+				chartID: item["chart_id"].(string),
+				width:   item["width"].(int),
+				height:  item["height"].(int),
+			})
+		}
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+// computeDashboardLayout places all the sections below each other starting from the first row.
+// The result only depends on the order of the provided sections so that the computed layout is stable between plans.
+func computeDashboardLayout(sections []*layoutSection) ([]*dashboard.DashboardChart, error) {
+	var (
+		charts []*dashboard.DashboardChart
+		top    int
+	)
+	for i, section := range sections {
+		if section.name != "" {
+			charts = append(charts, &dashboard.DashboardChart{
+				ChartId: section.headerID,
+				Row:     int32(top),
+				Width:   dashboardColumns,
+				Height:  int32(section.headerHeight),
+			})
+			top += section.headerHeight
+		}
+
+		for j, row := range section.rows {
+			placed, err := placeLayoutRow(row, top)
+			if err != nil {
+				return nil, fmt.Errorf("layout section %d row %d: %w", i, j, err)
+			}
+			charts = append(charts, placed...)
+			top += row.height
+		}
+
+		placed, bottom := packLayoutFlow(section.flow, top)
+		charts = append(charts, placed...)
+		top = bottom
+	}
+	return charts, nil
+}
+
+// placeLayoutRow places the charts next to each other on the same row.
+func placeLayoutRow(row layoutRow, top int) ([]*dashboard.DashboardChart, error) {
+	weights := row.widths
+	if len(weights) == 0 {
+		weights = make([]int, len(row.chartIDs))
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+	if len(weights) != len(row.chartIDs) {
+		return nil, fmt.Errorf("expected %d widths to match the charts, got %d", len(row.chartIDs), len(weights))
+	}
+
+	widths, err := distributeColumns(weights)
+	if err != nil {
+		return nil, err
+	}
+
+	charts := make([]*dashboard.DashboardChart, len(row.chartIDs))
+	column := 0
+	for i, id := range row.chartIDs {
+		charts[i] = &dashboard.DashboardChart{
+			ChartId: id,
+			Row:     int32(top),
+			Column:  int32(column),
+			Width:   int32(widths[i]),
+			Height:  int32(row.height),
+		}
+		column += widths[i]
+	}
+	return charts, nil
+}
+
+// distributeColumns splits the dashboard columns using the relative weights.
+// Each value is given at least one column, and any columns left over from rounding
+// are given to the values with the largest remainder, preferring the earliest value on ties.
+func distributeColumns(weights []int) ([]int, error) {
+	if len(weights) == 0 || len(weights) > dashboardColumns {
+		return nil, fmt.Errorf("expected between 1 and %d charts, got %d", dashboardColumns, len(weights))
+	}
+
+	total := 0
+	for _, w := range weights {
+		if w < 1 {
+			return nil, fmt.Errorf("expected relative widths to be at least 1, got %d", w)
+		}
+		total += w
+	}
+
+	var (
+		widths     = make([]int, len(weights))
+		remainders = make([]int, len(weights))
+		sum        = 0
+	)
+	for i, w := range weights {
+		widths[i] = dashboardColumns * w / total
+		remainders[i] = dashboardColumns * w % total
+		if widths[i] == 0 {
+			widths[i], remainders[i] = 1, 0
+		}
+		sum += widths[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+
+	// Columns left over from rounding down are given to the largest remainders.
+	slices.SortStableFunc(order, func(a, b int) int { return remainders[b] - remainders[a] })
+	for i := 0; sum < dashboardColumns; i = (i + 1) % len(order) {
+		widths[order[i]]++
+		sum++
+	}
+
+	// Columns taken by the minimum width are removed from the widest values.
+	slices.SortStableFunc(order, func(a, b int) int { return widths[b] - widths[a] })
+	for sum > dashboardColumns {
+		widths[order[0]]--
+		sum--
+		slices.SortStableFunc(order, func(a, b int) int { return widths[b] - widths[a] })
+	}
+	return widths, nil
+}
+
+// packLayoutFlow places each chart at the highest available row, using the leftmost column on ties.
+// Charts are never placed within gaps left beneath already placed charts,
+// which keeps the order of the charts when reading the dashboard from top to bottom.
+// It returns the placed charts along with the first row below all of them.
+func packLayoutFlow(items []layoutItem, top int) ([]*dashboard.DashboardChart, int) {
+	var skyline [dashboardColumns]int
+	for i := range skyline {
+		skyline[i] = top
+	}
+
+	charts := make([]*dashboard.DashboardChart, 0, len(items))
+	for _, item := range items {
+		width := min(max(item.width, 1), dashboardColumns)
+
+		row, column := -1, 0
+		for c := 0; c+width <= dashboardColumns; c++ {
+			r := slices.Max(skyline[c : c+width])
+			if row == -1 || r < row {
+				row, column = r, c
+			}
+		}
+
+		for c := column; c < column+width; c++ {
+			skyline[c] = row + item.height
+		}
+		charts = append(charts, &dashboard.DashboardChart{
+			ChartId: item.chartID,
+			Row:     int32(row),
+			Column:  int32(column),
+			Width:   int32(width),
+			Height:  int32(item.height),
+		})
+	}
+	return charts, slices.Max(skyline[:])
+}
+
+// saveDashboardLayout creates or updates the text charts used as section headers,
+// and returns the computed layout of the charts to include in the dashboard.
+// The header chart IDs are stored even when saving fails, so that the caller can remove them.
+func saveDashboardLayout(ctx context.Context, d *schema.ResourceData, meta any) ([]*dashboard.DashboardChart, error) {
+	sections := getDashboardLayoutSections(d)
+	if len(sections) == 0 {
+		return nil, nil
+	}
+
+	// The layout is checked before any headers are created,
+	// so an invalid layout does not leave behind any charts.
+	if _, err := computeDashboardLayout(sections); err != nil {
+		return nil, err
+	}
+
+	var (
+		config = meta.(*signalfxConfig)
+		layout = d.Get("layout").([]any)
+		tf     = layout[0].(map[string]any)["section"].([]any)
+		err    error
+	)
+	for i, section := range sections {
+		if section.name == "" {
+			section.headerID = ""
+			tf[i].(map[string]any)["header_chart_id"] = ""
+			continue
+		}
+
+		payload := &chart.CreateUpdateChartRequest{
+			Name: section.name,
+			Tags: pmeta.LoadProviderTags(ctx, meta),
+			Options: &chart.Options{
+				Type:     "Text",
+				Markdown: "## " + section.name,
+			},
+		}
+		payload.Tags = common.Unique(payload.Tags)
+
+		debugOutput, _ := json.Marshal(payload)
+		log.Printf("[DEBUG] SignalFx: Dashboard Layout Header Payload: %s", string(debugOutput))
+
+		var c *chart.Chart
+		if section.headerID == "" {
+			c, err = config.Client.CreateChart(ctx, payload)
+		} else {
+			c, err = config.Client.UpdateChart(ctx, section.headerID, payload)
+		}
+		if err != nil {
+			err = fmt.Errorf("layout section %d: %w", i, err)
+			break
+		}
+		section.headerID = c.Id
+		tf[i].(map[string]any)["header_chart_id"] = c.Id
+	}
+
+	if err = errors.Join(err, d.Set("layout", layout)); err != nil {
+		return nil, err
+	}
+	return computeDashboardLayout(sections)
+}
+
+// dashboardLayoutHeaders returns the IDs of the section header charts.
+func dashboardLayoutHeaders(layout any) []string {
+	var ids []string
+	if l, ok := layout.([]any); ok && len(l) > 0 && l[0] != nil {
+		for _, v := range l[0].(map[string]any)["section"].([]any) {
+			if section, ok := v.(map[string]any); ok && section["header_chart_id"] != "" {
+				ids = append(ids, section["header_chart_id"].(string))
+			}
+		}
+	}
+	return ids
+}
+
+// customizeDiffDashboardLayout checks that every row sets a width for each of its charts,
+// so that a mismatch is reported when planning instead of when applying the changes.
+func customizeDiffDashboardLayout(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	layout, ok := diff.Get("layout").([]any)
+	if !ok || len(layout) == 0 || layout[0] == nil {
+		return nil
+	}
+
+	for i, v := range layout[0].(map[string]any)["section"].([]any) {
+		section, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		for j, r := range section["row"].([]any) {
+			key := fmt.Sprintf("layout.0.section.%d.row.%d", i, j)
+			if !diff.NewValueKnown(key+".chart_ids") || !diff.NewValueKnown(key+".widths") {
+				continue
+			}
+			row := r.(map[string]any)
+			charts, widths := len(row["chart_ids"].([]any)), len(row["widths"].([]any))
+			if widths > 0 && widths != charts {
+				return fmt.Errorf("layout section %d row %d: expected %d widths to match the charts, got %d", i, j, charts, widths)
+			}
+		}
+	}
+	return nil
+}
+
+// removedDashboardLayoutHeaders returns the section header charts that are no longer used.
+func removedDashboardLayoutHeaders(d *schema.ResourceData, current []string) []string {
+	prior, _ := d.GetChange("layout")
+
+	var removed []string
+	for _, id := range dashboardLayoutHeaders(prior) {
+		if !slices.Contains(current, id) {
+			removed = append(removed, id)
+		}
+	}
+	return removed
+}
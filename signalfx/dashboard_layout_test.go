@@ -0,0 +1,325 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func TestDistributeColumns(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name    string
+		weights []int
+		expect  []int
+		errVal  string
+	}{
+		{name: "single chart", weights: []int{1}, expect: []int{12}},
+		{name: "equal halves", weights: []int{1, 1}, expect: []int{6, 6}},
+		{name: "one to three", weights: []int{1, 3}, expect: []int{3, 9}},
+		{name: "one to two", weights: []int{1, 2}, expect: []int{4, 8}},
+		{name: "remainder to earliest", weights: []int{1, 1, 1, 1, 1}, expect: []int{3, 3, 2, 2, 2}},
+		{name: "largest remainder", weights: []int{2, 3, 2}, expect: []int{4, 5, 3}},
+		{name: "minimum width", weights: []int{1, 100}, expect: []int{1, 11}},
+		{name: "minimum width for many", weights: []int{1, 1, 50, 50}, expect: []int{1, 1, 5, 5}},
+		{name: "twelve charts", weights: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, expect: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{name: "no charts", weights: []int{}, errVal: "expected between 1 and 12 charts, got 0"},
+		{name: "too many charts", weights: make([]int, 13), errVal: "expected between 1 and 12 charts, got 13"},
+		{name: "invalid weight", weights: []int{1, 0}, errVal: "expected relative widths to be at least 1, got 0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := distributeColumns(tc.weights)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must return the expected error")
+				return
+			}
+			require.NoError(t, err, "Must not error distributing columns")
+			assert.Equal(t, tc.expect, actual, "Must match the expected widths")
+		})
+	}
+}
+
+func TestPackLayoutFlow(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		items  []layoutItem
+		top    int
+		expect []*dashboard.DashboardChart
+		bottom int
+	}{
+		{
+			name:   "no charts",
+			top:    3,
+			expect: []*dashboard.DashboardChart{},
+			bottom: 3,
+		},
+		{
+			name: "fills a row",
+			items: []layoutItem{
+				{chartID: "a", width: 4, height: 1},
+				{chartID: "b", width: 4, height: 1},
+				{chartID: "c", width: 4, height: 1},
+				{chartID: "d", width: 4, height: 1},
+			},
+			expect: []*dashboard.DashboardChart{
+				{ChartId: "a", Row: 0, Column: 0, Width: 4, Height: 1},
+				{ChartId: "b", Row: 0, Column: 4, Width: 4, Height: 1},
+				{ChartId: "c", Row: 0, Column: 8, Width: 4, Height: 1},
+				{ChartId: "d", Row: 1, Column: 0, Width: 4, Height: 1},
+			},
+			bottom: 2,
+		},
+		{
+			name: "mixed heights fill the lowest space",
+			items: []layoutItem{
+				{chartID: "a", width: 6, height: 2},
+				{chartID: "b", width: 6, height: 1},
+				{chartID: "c", width: 6, height: 1},
+				{chartID: "d", width: 12, height: 1},
+			},
+			top: 2,
+			expect: []*dashboard.DashboardChart{
+				{ChartId: "a", Row: 2, Column: 0, Width: 6, Height: 2},
+				{ChartId: "b", Row: 2, Column: 6, Width: 6, Height: 1},
+				{ChartId: "c", Row: 3, Column: 6, Width: 6, Height: 1},
+				{ChartId: "d", Row: 4, Column: 0, Width: 12, Height: 1},
+			},
+			bottom: 5,
+		},
+		{
+			name: "wide chart waits for space",
+			items: []layoutItem{
+				{chartID: "a", width: 8, height: 1},
+				{chartID: "b", width: 8, height: 1},
+				{chartID: "c", width: 4, height: 2},
+			},
+			expect: []*dashboard.DashboardChart{
+				{ChartId: "a", Row: 0, Column: 0, Width: 8, Height: 1},
+				{ChartId: "b", Row: 1, Column: 0, Width: 8, Height: 1},
+				{ChartId: "c", Row: 0, Column: 8, Width: 4, Height: 2},
+			},
+			bottom: 2,
+		},
+		{
+			name: "does not place beneath taller charts",
+			items: []layoutItem{
+				{chartID: "a", width: 4, height: 1},
+				{chartID: "b", width: 4, height: 3},
+				{chartID: "c", width: 8, height: 1},
+			},
+			expect: []*dashboard.DashboardChart{
+				{ChartId: "a", Row: 0, Column: 0, Width: 4, Height: 1},
+				{ChartId: "b", Row: 0, Column: 4, Width: 4, Height: 3},
+				{ChartId: "c", Row: 3, Column: 0, Width: 8, Height: 1},
+			},
+			bottom: 4,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, bottom := packLayoutFlow(tc.items, tc.top)
+			assert.Equal(t, tc.expect, actual, "Must match the expected placement")
+			assert.Equal(t, tc.bottom, bottom, "Must match the expected bottom row")
+
+			again, _ := packLayoutFlow(tc.items, tc.top)
+			assert.Equal(t, actual, again, "Must produce the same layout each time")
+		})
+	}
+}
+
+func TestComputeDashboardLayout(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		sections []*layoutSection
+		expect   []*dashboard.DashboardChart
+		errVal   string
+	}{
+		{
+			name: "rows only",
+			sections: []*layoutSection{
+				{rows: []layoutRow{
+					{chartIDs: []string{"a", "b"}, widths: []int{1, 3}, height: 2},
+					{chartIDs: []string{"c", "d", "e"}, height: 1},
+				}},
+			},
+			expect: []*dashboard.DashboardChart{
+				{ChartId: "a", Row: 0, Column: 0, Width: 3, Height: 2},
+				{ChartId: "b", Row: 0, Column: 3, Width: 9, Height: 2},
+				{ChartId: "c", Row: 2, Column: 0, Width: 4, Height: 1},
+				{ChartId: "d", Row: 2, Column: 4, Width: 4, Height: 1},
+				{ChartId: "e", Row: 2, Column: 8, Width: 4, Height: 1},
+			},
+		},
+		{
+			name: "named sections",
+			sections: []*layoutSection{
+				{
+					name:         "Overview",
+					headerID:     "header-01",
+					headerHeight: 1,
+					rows:         []layoutRow{{chartIDs: []string{"a"}, height: 2}},
+					flow:         []layoutItem{{chartID: "b", width: 6, height: 1}},
+				},
+				{
+					name:         "Details",
+					headerID:     "header-02",
+					headerHeight: 2,
+					flow: []layoutItem{
+						{chartID: "c", width: 6, height: 2},
+						{chartID: "d", width: 6, height: 1},
+					},
+				},
+				{
+					rows: []layoutRow{{chartIDs: []string{"e"}, height: 1}},
+				},
+			},
+			expect: []*dashboard.DashboardChart{
+				{ChartId: "header-01", Row: 0, Column: 0, Width: 12, Height: 1},
+				{ChartId: "a", Row: 1, Column: 0, Width: 12, Height: 2},
+				{ChartId: "b", Row: 3, Column: 0, Width: 6, Height: 1},
+				{ChartId: "header-02", Row: 4, Column: 0, Width: 12, Height: 2},
+				{ChartId: "c", Row: 6, Column: 0, Width: 6, Height: 2},
+				{ChartId: "d", Row: 6, Column: 6, Width: 6, Height: 1},
+				{ChartId: "e", Row: 8, Column: 0, Width: 12, Height: 1},
+			},
+		},
+		{
+			name: "mismatched widths",
+			sections: []*layoutSection{
+				{rows: []layoutRow{{chartIDs: []string{"a", "b"}, widths: []int{1}, height: 1}}},
+			},
+			errVal: "layout section 0 row 0: expected 2 widths to match the charts, got 1",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := computeDashboardLayout(tc.sections)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must return the expected error")
+				return
+			}
+			require.NoError(t, err, "Must not error computing the layout")
+			assert.Equal(t, tc.expect, actual, "Must match the expected layout")
+		})
+	}
+}
+
+func TestDashboardLayoutLifecycle(t *testing.T) {
+	t.Parallel()
+
+	routes, charts := newTestChartStore()
+	meta := tftest.NewTestHTTPMockMeta(routes)(t)
+
+	d := schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]any{
+		"name":            "Hosts",
+		"dashboard_group": "group-01",
+		"layout": []any{map[string]any{
+			"section": []any{
+				map[string]any{
+					"name": "Overview",
+					"row": []any{map[string]any{
+						"chart_ids": []any{"cpu", "memory"},
+						"widths":    []any{2, 1},
+					}},
+				},
+				map[string]any{
+					"flow": []any{
+						map[string]any{"chart_id": "disk", "width": 6},
+						map[string]any{"chart_id": "network", "width": 6},
+					},
+				},
+			},
+		}},
+	})
+
+	require.NoError(t, dashboardCreate(d, meta), "Must not error creating dashboard")
+	require.Len(t, charts, 1, "Must create the section header chart")
+	assert.Equal(t, "Text", charts["chart-01"].Options.Type, "Must create a text chart")
+	assert.Equal(t, "## Overview", charts["chart-01"].Options.Markdown, "Must use the section name")
+	assert.Equal(t, "chart-01", d.Get("layout.0.section.0.header_chart_id"), "Must expose the header chart id")
+	assert.Equal(t, "", d.Get("layout.0.section.1.header_chart_id"), "Must not create a header for unnamed sections")
+	assert.Equal(t, 0, d.Get("chart.#"), "Must not set the chart layout")
+
+	require.NoError(t, dashboardDelete(d, meta), "Must not error deleting dashboard")
+	assert.Empty(t, charts, "Must delete the section header charts")
+}
+
+func TestDashboardLayoutFailedCreate(t *testing.T) {
+	t.Parallel()
+
+	routes, charts := newTestChartStore()
+	routes["POST /v2/dashboard"] = func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "invalid dashboard", http.StatusBadRequest)
+	}
+	meta := tftest.NewTestHTTPMockMeta(routes)(t)
+
+	d := schema.TestResourceDataRaw(t, dashboardResource().Schema, map[string]any{
+		"name":            "Hosts",
+		"dashboard_group": "group-01",
+		"layout": []any{map[string]any{
+			"section": []any{map[string]any{
+				"name": "Overview",
+				"row":  []any{map[string]any{"chart_ids": []any{"cpu", "memory"}}},
+			}},
+		}},
+	})
+
+	require.Error(t, dashboardCreate(d, meta), "Must report the failed dashboard")
+	assert.Empty(t, d.Id(), "Must not set the dashboard id")
+	assert.Empty(t, charts, "Must remove the section header charts")
+}
+
+func TestCustomizeDiffDashboardLayout(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		widths []any
+		errVal string
+	}{
+		{name: "no widths", widths: nil},
+		{name: "matching widths", widths: []any{2, 1}},
+		{name: "missing width", widths: []any{2}, errVal: "layout section 0 row 0: expected 2 widths to match the charts, got 1"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			row := map[string]any{"chart_ids": []any{"cpu", "memory"}}
+			if tc.widths != nil {
+				row["widths"] = tc.widths
+			}
+			_, err := dashboardResource().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]any{
+				"name":            "Hosts",
+				"dashboard_group": "group-01",
+				"layout": []any{map[string]any{
+					"section": []any{map[string]any{"name": "Overview", "row": []any{row}}},
+				}},
+			}), nil)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must report the mismatch when planning")
+			} else {
+				assert.NoError(t, err, "Must not error planning the layout")
+			}
+		})
+	}
+}
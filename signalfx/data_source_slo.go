@@ -0,0 +1,235 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go/slo"
+)
+
+const (
+	tagsLabel                 = "tags"
+	statusLabel               = "status"
+	currentComplianceLabel    = "current_compliance"
+	remainingErrorBudgetLabel = "remaining_error_budget"
+
+	// sloSearchLimit is the page size used when searching SLOs.
+	sloSearchLimit = 100
+)
+
+// sloTargetStatus is the current status the API reports for a target,
+// which is not part of the go-sdk model and is only returned for SLOs that have been evaluated.
+type sloTargetStatus struct {
+	CurrentCompliance    *float64 `json:"currentCompliance"`
+	RemainingErrorBudget *float64 `json:"remainingErrorBudget"`
+}
+
+// sloWithStatus is an SLO read from the API along with the status of each of its targets.
+type sloWithStatus struct {
+	*slo.SloObject
+	status []*sloTargetStatus
+}
+
+func (s *sloWithStatus) UnmarshalJSON(data []byte) error {
+	s.SloObject = &slo.SloObject{}
+	if err := json.Unmarshal(data, s.SloObject); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Targets []struct {
+			Status *sloTargetStatus `json:"status"`
+		} `json:"targets"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	s.status = make([]*sloTargetStatus, len(raw.Targets))
+	for i, target := range raw.Targets {
+		s.status[i] = target.Status
+	}
+	return nil
+}
+
+type sloSearchResults struct {
+	Count   int              `json:"count"`
+	Results []*sloWithStatus `json:"results"`
+}
+
+func dataSourceSlo() *schema.Resource {
+	sloSchema := computedSloSchema()
+	sloSchema["id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", nameLabel},
+		Description:  "ID of the SLO to look up",
+	}
+	sloSchema[nameLabel] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"id", nameLabel},
+		Description:  "Name of the SLO to look up",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSloRead,
+		Schema:      sloSchema,
+		Description: "Use this data source to look up an SLO by its ID or name, along with its current status.",
+	}
+}
+
+// computedSloSchema returns the schema of the SLO resource with every field read only,
+// plus the tags of the SLO and the status of each target.
+func computedSloSchema() map[string]*schema.Schema {
+	sloSchema := computedSchema(sloResource().SchemaMap())
+	sloSchema[tagsLabel] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Tags associated with the SLO",
+	}
+
+	target := sloSchema[targetLabel].Elem.(*schema.Resource)
+	target.Schema[statusLabel] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Current status of the target, which is empty when the API does not report it",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				currentComplianceLabel: {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "Current compliance of the target in the compliance period, as a percentage",
+				},
+				remainingErrorBudgetLabel: {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "Remaining error budget of the target in the compliance period, as a percentage",
+				},
+			},
+		},
+	}
+	return sloSchema
+}
+
+// computedSchema copies the resource schema so that it can be used as the read only fields of a data source.
+func computedSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	computed := make(map[string]*schema.Schema, len(s))
+	for k, v := range s {
+		field := &schema.Schema{
+			Type:        v.Type,
+			Computed:    true,
+			Description: v.Description,
+		}
+		switch elem := v.Elem.(type) {
+		case *schema.Resource:
+			field.Elem = &schema.Resource{Schema: computedSchema(elem.SchemaMap())}
+		case *schema.Schema:
+			field.Elem = &schema.Schema{Type: elem.Type}
+		}
+		computed[k] = field
+	}
+	return computed
+}
+
+func dataSourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	config := meta.(*signalfxConfig)
+
+	var (
+		found *sloWithStatus
+		err   error
+	)
+	if id, ok := d.GetOk("id"); ok {
+		found = &sloWithStatus{}
+		err = config.GetJSON(ctx, "/v2/slo/"+url.PathEscape(id.(string)), nil, found)
+	} else {
+		found, err = searchSloByName(ctx, config, d.Get(nameLabel).(string))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(found.Id)
+	if err := sloAPIToTF(d, found.SloObject); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(tagsLabel, found.Metadata); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The targets are read again since the status is not part of the resource.
+	tfTargets, err := getTfTargets(found.SloObject)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diag.FromErr(d.Set(targetLabel, withSloTargetStatus(tfTargets, found.status)))
+}
+
+func searchSloByName(ctx context.Context, config *signalfxConfig, name string) (*sloWithStatus, error) {
+	var found *sloWithStatus
+	err := searchSlos(ctx, config, url.Values{nameLabel: {name}}, func(s *sloWithStatus) {
+		if found == nil && s.Name == name {
+			found = s
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no SLO found with name %q", name)
+	}
+	return found, nil
+}
+
+// searchSlos calls fn with each SLO that matches the query, reading every page of the results.
+func searchSlos(ctx context.Context, config *signalfxConfig, query url.Values, fn func(*sloWithStatus)) error {
+	for offset := 0; ; offset += sloSearchLimit {
+		params := url.Values{
+			"limit":  {strconv.Itoa(sloSearchLimit)},
+			"offset": {strconv.Itoa(offset)},
+		}
+		for k, v := range query {
+			params[k] = v
+		}
+
+		var results sloSearchResults
+		if err := config.GetJSON(ctx, "/v2/slo/search", params, &results); err != nil {
+			return err
+		}
+		for _, s := range results.Results {
+			fn(s)
+		}
+		if len(results.Results) < sloSearchLimit || offset+len(results.Results) >= results.Count {
+			return nil
+		}
+	}
+}
+
+// withSloTargetStatus adds the status of each target to the targets read by getTfTargets.
+func withSloTargetStatus(tfTargets []map[string]any, status []*sloTargetStatus) []map[string]any {
+	for i, tfTarget := range tfTargets {
+		tfTarget[statusLabel] = []any{}
+		if i >= len(status) || status[i] == nil {
+			continue
+		}
+		tfStatus := make(map[string]any)
+		if status[i].CurrentCompliance != nil {
+			tfStatus[currentComplianceLabel] = *status[i].CurrentCompliance
+		}
+		if status[i].RemainingErrorBudget != nil {
+			tfStatus[remainingErrorBudgetLabel] = *status[i].RemainingErrorBudget
+		}
+		tfTarget[statusLabel] = []any{tfStatus}
+	}
+	return tfTargets
+}
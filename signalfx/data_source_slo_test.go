@@ -0,0 +1,147 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func newTestSloJSON(id, name, status string, tags ...string) string {
+	metadata := "[]"
+	if len(tags) > 0 {
+		metadata = fmt.Sprintf("[%q]", tags[0])
+		for _, tag := range tags[1:] {
+			metadata = metadata[:len(metadata)-1] + fmt.Sprintf(",%q]", tag)
+		}
+	}
+	if status != "" {
+		status = `,"status":` + status
+	}
+	return fmt.Sprintf(`{
+		"id": %q,
+		"name": %q,
+		"type": "RequestBased",
+		"metadata": %s,
+		"inputs": {"programText": "G = data('good').publish('G')", "goodEventsLabel": "G", "totalEventsLabel": "T"},
+		"targets": [{
+			"type": "RollingWindow",
+			"slo": 99.9,
+			"compliancePeriod": "30d",
+			"sloAlertRules": [{
+				"type": "BREACH",
+				"rules": [{"severity": "Critical", "parameters": {"fireLasting": "5m", "percentOfLasting": 100}}]
+			}]%s
+		}]
+	}`, id, name, metadata, status)
+}
+
+func newTestSloSearchRoutes(t *testing.T, slos ...string) map[string]http.HandlerFunc {
+	return map[string]http.HandlerFunc{
+		"GET /v2/slo/search": func(w http.ResponseWriter, r *http.Request) {
+			offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+			require.NoError(t, err, "Must set the offset")
+			limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+			require.NoError(t, err, "Must set the limit")
+
+			page := slos[min(offset, len(slos)):min(offset+limit, len(slos))]
+			_, _ = fmt.Fprintf(w, `{"count": %d, "results": [%s]}`, len(slos), strings.Join(page, ","))
+		},
+	}
+}
+
+func TestDataSourceSloRead(t *testing.T) {
+	t.Parallel()
+
+	routes := newTestSloSearchRoutes(t,
+		newTestSloJSON("slo-1", "checkout latency", ""),
+		newTestSloJSON("slo-2", "checkout", `{"currentCompliance": 99.95, "remainingErrorBudget": 50}`, "team:payments"),
+	)
+	routes["GET /v2/slo/slo-2"] = func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(newTestSloJSON("slo-2", "checkout", `{"currentCompliance": 99.95}`)))
+	}
+	routes["GET /v2/slo/slo-3"] = func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		config map[string]any
+		status []any
+		tags   []any
+		errVal string
+	}{
+		{
+			name:   "by name",
+			config: map[string]any{"name": "checkout"},
+			status: []any{map[string]any{"current_compliance": 99.95, "remaining_error_budget": 50.0}},
+			tags:   []any{"team:payments"},
+		},
+		{
+			name:   "by id",
+			config: map[string]any{"id": "slo-2"},
+			status: []any{map[string]any{"current_compliance": 99.95, "remaining_error_budget": 0.0}},
+			tags:   []any{},
+		},
+		{
+			name:   "unknown name",
+			config: map[string]any{"name": "checkout errors"},
+			errVal: `no SLO found with name "checkout errors"`,
+		},
+		{
+			name:   "unknown id",
+			config: map[string]any{"id": "slo-3"},
+			errVal: `route "/v2/slo/slo-3" had issues with status code 404`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ds := dataSourceSlo()
+			d := schema.TestResourceDataRaw(t, ds.Schema, tc.config)
+
+			diags := ds.ReadContext(context.Background(), d, tftest.NewTestHTTPMockMeta(routes)(t))
+			if tc.errVal != "" {
+				require.True(t, diags.HasError(), "Must error reading SLO")
+				assert.Equal(t, tc.errVal, diags[0].Summary, "Must match the expected error")
+				return
+			}
+			require.False(t, diags.HasError(), "Must not error reading SLO: %v", diags)
+
+			assert.Equal(t, "slo-2", d.Id(), "Must set the id of the SLO")
+			assert.Equal(t, "checkout", d.Get("name"), "Must set the name")
+			assert.Equal(t, tc.tags, d.Get("tags"), "Must set the tags")
+			assert.Equal(t, "G", d.Get("input.0.good_events_label"), "Must set the input")
+			assert.Equal(t, "30d", d.Get("target.0.compliance_period"), "Must set the target")
+			assert.Equal(t, "BREACH", d.Get("target.0.alert_rule.0.type"), "Must set the alert rules")
+			assert.Equal(t, "5m", d.Get("target.0.alert_rule.0.rule.0.parameters.0.fire_lasting"), "Must set the alert rule parameters")
+			assert.Equal(t, tc.status, d.Get("target.0.status"), "Must set the status")
+		})
+	}
+}
+
+func TestWithSloTargetStatus(t *testing.T) {
+	t.Parallel()
+
+	compliance := 99.5
+	tfTargets := withSloTargetStatus(
+		[]map[string]any{{}, {}, {}},
+		[]*sloTargetStatus{{CurrentCompliance: &compliance}, nil},
+	)
+	assert.Equal(t, []map[string]any{
+		{"status": []any{map[string]any{"current_compliance": 99.5}}},
+		{"status": []any{}},
+		{"status": []any{}},
+	}, tfTargets, "Must only set the status the API reports")
+}
@@ -0,0 +1,110 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"hash/fnv"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+)
+
+func dataSourceSlos() *schema.Resource {
+	sloSchema := computedSloSchema()
+	sloSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the SLO",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSlosRead,
+		Schema: map[string]*schema.Schema{
+			tagsLabel: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Only include SLOs that have all of these tags",
+			},
+			"slos": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: sloSchema},
+				Description: "The SLOs that match the filters, ordered by name",
+			},
+		},
+		Description: "Use this data source to list the SLOs, optionally filtered by tags, along with their current status.",
+	}
+}
+
+func dataSourceSlosRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var (
+		config = meta.(*signalfxConfig)
+		tags   = convert.SliceAll(d.Get(tagsLabel).([]any), convert.ToString)
+		found  []*sloWithStatus
+	)
+
+	err := searchSlos(ctx, config, nil, func(s *sloWithStatus) {
+		for _, tag := range tags {
+			if !slices.Contains(s.Metadata, tag) {
+				return
+			}
+		}
+		found = append(found, s)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	slices.SortStableFunc(found, func(a, b *sloWithStatus) int {
+		switch {
+		case a.Name < b.Name:
+			return -1
+		case a.Name > b.Name:
+			return 1
+		}
+		return 0
+	})
+
+	var (
+		hasher = fnv.New64()
+		slos   = make([]map[string]any, 0, len(found))
+	)
+	for _, s := range found {
+		tfSlo, err := getTfSlo(s)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		slos = append(slos, tfSlo)
+		_, _ = hasher.Write([]byte(s.Id))
+	}
+	d.SetId(strconv.FormatUint(hasher.Sum64(), 36))
+
+	return diag.FromErr(d.Set("slos", slos))
+}
+
+func getTfSlo(s *sloWithStatus) (map[string]any, error) {
+	tfSloInput, err := getTfSloInput(s.SloObject)
+	if err != nil {
+		return nil, err
+	}
+	tfTargets, err := getTfTargets(s.SloObject)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"id":             s.Id,
+		nameLabel:        s.Name,
+		descriptionLabel: s.Description,
+		typeLabel:        s.Type,
+		tagsLabel:        s.Metadata,
+		inputLabel:       []map[string]any{tfSloInput},
+		targetLabel:      withSloTargetStatus(tfTargets, s.status),
+	}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func TestDataSourceSlosRead(t *testing.T) {
+	t.Parallel()
+
+	routes := newTestSloSearchRoutes(t,
+		newTestSloJSON("slo-1", "search latency", "", "team:search"),
+		newTestSloJSON("slo-2", "checkout", `{"remainingErrorBudget": 12.5}`, "team:payments", "tier:1"),
+		newTestSloJSON("slo-3", "checkout latency", "", "team:payments"),
+	)
+
+	for _, tc := range []struct {
+		name string
+		tags []any
+		ids  []any
+	}{
+		{name: "no filter", tags: []any{}, ids: []any{"slo-2", "slo-3", "slo-1"}},
+		{name: "single tag", tags: []any{"team:payments"}, ids: []any{"slo-2", "slo-3"}},
+		{name: "all tags", tags: []any{"team:payments", "tier:1"}, ids: []any{"slo-2"}},
+		{name: "no match", tags: []any{"team:storage"}, ids: []any{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ds := dataSourceSlos()
+			d := schema.TestResourceDataRaw(t, ds.Schema, map[string]any{"tags": tc.tags})
+
+			diags := ds.ReadContext(context.Background(), d, tftest.NewTestHTTPMockMeta(routes)(t))
+			require.False(t, diags.HasError(), "Must not error reading SLOs: %v", diags)
+			assert.NotEmpty(t, d.Id(), "Must set an id")
+
+			ids := []any{}
+			for _, s := range d.Get("slos").([]any) {
+				ids = append(ids, s.(map[string]any)["id"])
+			}
+			assert.Equal(t, tc.ids, ids, "Must list the matching SLOs ordered by name")
+		})
+	}
+
+	ds := dataSourceSlos()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]any{"tags": []any{"tier:1"}})
+	require.False(t, ds.ReadContext(context.Background(), d, tftest.NewTestHTTPMockMeta(routes)(t)).HasError(), "Must not error reading SLOs")
+
+	assert.Equal(t, "checkout", d.Get("slos.0.name"), "Must set the name")
+	assert.Equal(t, []any{"team:payments", "tier:1"}, d.Get("slos.0.tags"), "Must set the tags")
+	assert.Equal(t, "T", d.Get("slos.0.input.0.total_events_label"), "Must set the input")
+	assert.Equal(t, 99.9, d.Get("slos.0.target.0.slo"), "Must set the target")
+	assert.Equal(t, 12.5, d.Get("slos.0.target.0.status.0.remaining_error_budget"), "Must set the status")
+}
+
+func TestDataSourceSlosReadError(t *testing.T) {
+	t.Parallel()
+
+	ds := dataSourceSlos()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]any{})
+
+	meta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"GET /v2/slo/search": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		},
+	})(t)
+
+	diags := ds.ReadContext(context.Background(), d, meta)
+	require.True(t, diags.HasError(), "Must error reading SLOs")
+	assert.Equal(t, `route "/v2/slo/search" had issues with status code 401`, diags[0].Summary, "Must report the API error")
+}
@@ -0,0 +1,181 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/signalfx/signalfx-go/detector"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/check"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	ruledef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/rule"
+)
+
+func detectorEscalationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Notifications and their repeats written as steps, which are compiled into the rule's notifications and reminder notification. Later steps can only repeat the notifications of the first step at an even interval. Conflicts with `notifications` and `reminder_notification`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"step": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Description: "The notifications to send once the alert has been active for `after_minutes` without clearing, every step after the first repeats the same notifications.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"after_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      0,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "(default: 0) The number of minutes the alert must be active before the step notifies.",
+							},
+							"notifications": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								Elem: &schema.Schema{
+									Type:             schema.TypeString,
+									ValidateDiagFunc: check.Notification(),
+								},
+								Description: "List of strings specifying where notifications will be sent by this step.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getDetectorEscalationSteps returns the escalation steps of the rule ordered by their delay,
+// or nil when the rule does not have an escalation block.
+func getDetectorEscalationSteps(tfRule map[string]any) []ruledef.EscalationStep {
+	escalations, ok := tfRule["escalation"].([]any)
+	if !ok || len(escalations) == 0 || escalations[0] == nil {
+		return nil
+	}
+
+	var steps []ruledef.EscalationStep
+	for _, raw := range escalations[0].(map[string]any)["step"].([]any) {
+		tfStep, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		after, _ := tfStep["after_minutes"].(int)
+		step := ruledef.EscalationStep{AfterMinutes: after}
+		if values, ok := tfStep["notifications"].([]any); ok {
+			for _, v := range values {
+				s, _ := v.(string)
+				step.Notifications = append(step.Notifications, s)
+			}
+		}
+		steps = append(steps, step)
+	}
+	ruledef.SortEscalationSteps(steps)
+	return steps
+}
+
+// applyDetectorEscalation sets the rule's notifications and reminder from its escalation block.
+func applyDetectorEscalation(tfRule map[string]any, rule *detector.Rule) error {
+	steps := getDetectorEscalationSteps(tfRule)
+	if steps == nil {
+		return nil
+	}
+	if err := detectorEscalationConflicts(tfRule); err != nil {
+		return err
+	}
+
+	notifications, reminder, err := ruledef.CompileEscalation(steps)
+	if err != nil {
+		return fmt.Errorf("rule %q: %w", rule.DetectLabel, err)
+	}
+
+	values := make([]any, len(notifications))
+	for i, v := range notifications {
+		values[i] = v
+	}
+	notify, err := common.NewNotificationList(values)
+	if err != nil {
+		return err
+	}
+	rule.Notifications = notify
+	rule.ReminderNotification = reminder
+	return nil
+}
+
+func detectorEscalationConflicts(tfRule map[string]any) error {
+	if v, ok := tfRule["notifications"].([]any); ok && len(v) > 0 {
+		return fmt.Errorf("rule %q: escalation can not be used with notifications", tfRule["detect_label"])
+	}
+	if v, ok := tfRule["notification"].([]any); ok && len(v) > 0 {
+		return fmt.Errorf("rule %q: escalation can not be used with notification", tfRule["detect_label"])
+	}
+	if v, ok := tfRule["reminder_notification"].([]any); ok && len(v) > 0 {
+		return fmt.Errorf("rule %q: escalation can not be used with reminder_notification", tfRule["detect_label"])
+	}
+	return nil
+}
+
+// validateDetectorEscalations reports escalation policies that can not be compiled when planning.
+// Steps that refer to values that are not known yet are skipped since they are read as empty strings.
+func validateDetectorEscalations(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	rules, ok := d.Get("rule").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	for _, raw := range rules.List() {
+		tfRule := raw.(map[string]any)
+
+		steps := getDetectorEscalationSteps(tfRule)
+		if steps == nil {
+			continue
+		}
+		if err := detectorEscalationConflicts(tfRule); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if slices.ContainsFunc(steps, func(s ruledef.EscalationStep) bool {
+			return slices.Contains(s.Notifications, "")
+		}) {
+			continue
+		}
+		if _, _, err := ruledef.CompileEscalation(steps); err != nil {
+			errs = append(errs, fmt.Errorf("rule %q: %w", tfRule["detect_label"], err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// withDetectorEscalation replaces the rule's notifications and reminder with the escalation
+// block from the prior rule when the API rule is still what the block compiles to,
+// so that a rule configured with an escalation policy is not reported as changed.
+func withDetectorEscalation(rule map[string]any, r *detector.Rule, prior map[string]any) bool {
+	escalation, ok := prior["escalation"].([]any)
+	if !ok || len(escalation) == 0 {
+		return false
+	}
+
+	notifications, _ := rule["notifications"].([]string)
+	steps := getDetectorEscalationSteps(map[string]any{"escalation": escalation})
+	if !ruledef.EscalationMatches(steps, notifications, r.ReminderNotification) {
+		return false
+	}
+
+	rule["escalation"] = escalation
+	rule["notifications"] = []string{}
+	delete(rule, "reminder_notification")
+	return true
+}
@@ -0,0 +1,188 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"testing"
+
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ruledef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/rule"
+)
+
+func newTestEscalation(steps ...map[string]any) []any {
+	values := make([]any, len(steps))
+	for i, s := range steps {
+		values[i] = s
+	}
+	return []any{map[string]any{"step": values}}
+}
+
+func newTestEscalationStep(after int, notifications ...any) map[string]any {
+	return map[string]any{"after_minutes": after, "notifications": notifications}
+}
+
+func TestCompileDetectorEscalation(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name          string
+		escalation    []any
+		notifications []string
+		reminder      *detector.ReminderNotification
+		errVal        string
+	}{
+		{
+			name:          "single step",
+			escalation:    newTestEscalation(newTestEscalationStep(0, "Email,oncall@example.com")),
+			notifications: []string{"Email,oncall@example.com"},
+		},
+		{
+			name: "repeated steps",
+			escalation: newTestEscalation(
+				newTestEscalationStep(30, "Email,oncall@example.com"),
+				newTestEscalationStep(0, "Email,oncall@example.com"),
+				newTestEscalationStep(15, "Email,oncall@example.com"),
+			),
+			notifications: []string{"Email,oncall@example.com"},
+			reminder: &detector.ReminderNotification{
+				IntervalMs: 15 * 60 * 1000,
+				TimeoutMs:  30 * 60 * 1000,
+				Type:       "TIMEOUT",
+			},
+		},
+		{
+			name: "delayed first step",
+			escalation: newTestEscalation(
+				newTestEscalationStep(5, "Email,oncall@example.com"),
+			),
+			errVal: "the first escalation step notifies after 5 minutes, the API can only delay notifications that have already been sent so it must use after_minutes = 0",
+		},
+		{
+			name: "different recipients",
+			escalation: newTestEscalation(
+				newTestEscalationStep(0, "Email,oncall@example.com"),
+				newTestEscalationStep(15, "Email,lead@example.com"),
+			),
+			errVal: "the escalation step after 15 minutes notifies Email,lead@example.com, the API can only repeat the initial notifications Email,oncall@example.com so escalating to different recipients is not supported",
+		},
+		{
+			name: "uneven steps",
+			escalation: newTestEscalation(
+				newTestEscalationStep(0, "Email,oncall@example.com"),
+				newTestEscalationStep(15, "Email,oncall@example.com"),
+				newTestEscalationStep(20, "Email,oncall@example.com"),
+			),
+			errVal: "the escalation step after 20 minutes must notify after 30 minutes, the API repeats notifications at a fixed interval so steps must be evenly spaced",
+		},
+		{
+			name: "duplicate steps",
+			escalation: newTestEscalation(
+				newTestEscalationStep(0, "Email,oncall@example.com"),
+				newTestEscalationStep(0, "Email,oncall@example.com"),
+			),
+			errVal: "more than one escalation step notifies after 0 minutes",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			steps := getDetectorEscalationSteps(map[string]any{"escalation": tc.escalation})
+			notifications, reminder, err := ruledef.CompileEscalation(steps)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must return the expected error")
+				return
+			}
+			assert.NoError(t, err, "Must not error compiling the escalation")
+			assert.Equal(t, tc.notifications, notifications, "Must match the expected notifications")
+			assert.Equal(t, tc.reminder, reminder, "Must match the expected reminder")
+		})
+	}
+}
+
+func TestGetDetectorRuleEscalation(t *testing.T) {
+	t.Parallel()
+
+	tfRule := map[string]any{
+		"description":  "",
+		"disabled":     false,
+		"severity":     "Critical",
+		"detect_label": "CPU",
+		"escalation": newTestEscalation(
+			newTestEscalationStep(0, "Email,oncall@example.com"),
+			newTestEscalationStep(10, "Email,oncall@example.com"),
+		),
+	}
+
+	rule, err := getDetectorRule(tfRule)
+	require.NoError(t, err, "Must not error converting the rule")
+	assert.Len(t, rule.Notifications, 1, "Must set the initial notifications")
+	assert.Equal(t, &detector.ReminderNotification{
+		IntervalMs: 10 * 60 * 1000,
+		TimeoutMs:  10 * 60 * 1000,
+		Type:       "TIMEOUT",
+	}, rule.ReminderNotification, "Must set the reminder")
+
+	tfRule["notifications"] = []any{"Email,lead@example.com"}
+	_, err = getDetectorRule(tfRule)
+	assert.EqualError(t, err, `rule "CPU": escalation can not be used with notifications`, "Must reject conflicting notifications")
+}
+
+func TestWithDetectorEscalation(t *testing.T) {
+	t.Parallel()
+
+	escalation := newTestEscalation(
+		newTestEscalationStep(0, "Email,oncall@example.com"),
+		newTestEscalationStep(10, "Email,oncall@example.com"),
+	)
+
+	tfRule := map[string]any{"description": "", "disabled": false, "severity": "Critical", "detect_label": "CPU", "escalation": escalation}
+	r, err := getDetectorRule(tfRule)
+	require.NoError(t, err, "Must not error converting the rule")
+
+	rule, err := getTfDetectorRule(r)
+	require.NoError(t, err, "Must not error reading the rule")
+	assert.True(t, withDetectorEscalation(rule, r, tfRule), "Must apply the prior escalation")
+	assert.Equal(t, escalation, rule["escalation"], "Must keep the escalation when it matches")
+	assert.Equal(t, []string{}, rule["notifications"], "Must not report the compiled notifications")
+	assert.NotContains(t, rule, "reminder_notification", "Must not report the compiled reminder")
+	assert.Equal(t, resourceRuleHash(tfRule), resourceRuleHash(map[string]any{
+		"description":   "",
+		"disabled":      false,
+		"severity":      "Critical",
+		"detect_label":  "CPU",
+		"escalation":    escalation,
+		"notifications": []any{},
+	}), "Must hash the escalation consistently")
+
+	r.ReminderNotification.TimeoutMs *= 2
+	rule, err = getTfDetectorRule(r)
+	require.NoError(t, err, "Must not error reading the rule")
+	assert.False(t, withDetectorEscalation(rule, r, tfRule), "Must not apply the prior escalation")
+	assert.NotContains(t, rule, "escalation", "Must report the API rule when it has changed")
+	assert.Contains(t, rule, "reminder_notification", "Must report the API reminder")
+}
+
+func TestResourceRuleHashEscalation(t *testing.T) {
+	t.Parallel()
+
+	rule := func(steps ...map[string]any) map[string]any {
+		return map[string]any{"severity": "Critical", "detect_label": "CPU", "escalation": newTestEscalation(steps...)}
+	}
+
+	base := resourceRuleHash(rule(
+		newTestEscalationStep(0, "Email,a@example.com", "Email,b@example.com"),
+		newTestEscalationStep(10, "Email,a@example.com", "Email,b@example.com"),
+	))
+	assert.Equal(t, base, resourceRuleHash(rule(
+		newTestEscalationStep(10, "Email,b@example.com", "Email,a@example.com"),
+		newTestEscalationStep(0, "Email,a@example.com", "Email,b@example.com"),
+	)), "Must not depend on the order of steps or notifications")
+	assert.NotEqual(t, base, resourceRuleHash(rule(
+		newTestEscalationStep(0, "Email,a@example.com", "Email,b@example.com"),
+		newTestEscalationStep(15, "Email,a@example.com", "Email,b@example.com"),
+	)), "Must change when a step changes")
+}
@@ -0,0 +1,103 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go/notification"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+	notificationdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/notification"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+)
+
+// ruleNotification is a notification configured on a rule along with
+// the path of the attribute that configured it.
+type ruleNotification struct {
+	path  string
+	value *notification.Notification
+}
+
+// getRuleNotifications returns the notifications configured by the rule's `notifications`,
+// `notification` blocks and escalation steps.
+// Values that are unknown or invalid are skipped since they are reported by the schema validation.
+func getRuleNotifications(tfRule map[string]any) []ruleNotification {
+	var values []ruleNotification
+	appendStrings := func(path string, raw any) {
+		items, _ := raw.([]any)
+		for i, item := range items {
+			s, ok := item.(string)
+			if !ok {
+				continue
+			}
+			if n, err := common.NewNotificationFromString(s); err == nil {
+				values = append(values, ruleNotification{path: fmt.Sprintf("%s.%d", path, i), value: n})
+			}
+		}
+	}
+
+	appendStrings("notifications", tfRule["notifications"])
+
+	blocks, _ := tfRule["notification"].([]any)
+	for i, block := range blocks {
+		if items, err := notificationdef.DecodeTerraform([]any{block}); err == nil {
+			values = append(values, ruleNotification{path: fmt.Sprintf("notification.%d", i), value: items[0]})
+		}
+	}
+
+	if escalation, ok := tfRule["escalation"].([]any); ok && len(escalation) > 0 {
+		policy, _ := escalation[0].(map[string]any)
+		steps, _ := policy["step"].([]any)
+		for i, step := range steps {
+			step, _ := step.(map[string]any)
+			appendStrings(fmt.Sprintf("escalation.0.step.%d.notifications", i), step["notifications"])
+		}
+	}
+	return values
+}
+
+// validateRuleNotifications returns an error for each notification of the rule
+// that references an integration credential or team that does not exist.
+func validateRuleNotifications(ctx context.Context, meta any, name string, tfRule map[string]any) []error {
+	var errs []error
+	for _, n := range getRuleNotifications(tfRule) {
+		if err := pmeta.ValidateNotificationReference(ctx, meta, n.value); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", name, n.path, err))
+		}
+	}
+	return errs
+}
+
+// validateDetectorNotifications checks the integration credentials and teams
+// referenced by each rule's notifications exist when the preview is enabled.
+// The rules are read from the raw configuration since the lists nested
+// within the rule set are not readable from the diff before the plan is complete.
+func validateDetectorNotifications(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	rules, _ := convert.FromConfigValue(config.GetAttr("rule")).([]any)
+
+	var errs []error
+	for _, raw := range rules {
+		tfRule, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		label, _ := tfRule["detect_label"].(string)
+		name := fmt.Sprintf("rule %q", label)
+		if severity, ok := tfRule["severity"].(string); ok {
+			name = fmt.Sprintf("rule %q (%s)", label, severity)
+		}
+		errs = append(errs, validateRuleNotifications(ctx, meta, name, tfRule)...)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,113 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func TestGetRuleNotifications(t *testing.T) {
+	t.Parallel()
+
+	tfRule := map[string]any{
+		"notifications": []any{"Email,oncall@example.com", nil, "Invalid"},
+		"notification": []any{
+			map[string]any{"slack": []any{map[string]any{"credential_id": "cred", "channel": "alerts"}}},
+		},
+		"escalation": []any{map[string]any{
+			"step": []any{
+				map[string]any{"after_minutes": 0, "notifications": []any{"PagerDuty,first"}},
+				map[string]any{"after_minutes": 10, "notifications": []any{"PagerDuty,second"}},
+			},
+		}},
+	}
+
+	var paths []string
+	for _, n := range getRuleNotifications(tfRule) {
+		paths = append(paths, n.path)
+	}
+	assert.Equal(t, []string{
+		"notifications.0",
+		"notification.0",
+		"escalation.0.step.0.notifications.0",
+		"escalation.0.step.1.notifications.0",
+	}, paths, "Must return each valid notification with its path")
+}
+
+func TestValidateDetectorNotifications(t *testing.T) {
+	t.Parallel()
+
+	newMeta := tftest.NewTestHTTPMockMeta(map[string]http.HandlerFunc{
+		"POST /v2/detector/validate": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		},
+		"GET /v2/integration/cred": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":"cred"}`))
+		},
+		"GET /v2/integration/typo": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		},
+		"GET /v2/team/missing": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		},
+	})
+
+	r := detectorResource()
+	config, err := ctyjson.Unmarshal([]byte(`{
+		"name": "CPU",
+		"program_text": "detect(when(data('cpu.utilization') > 90)).publish('CPU high')",
+		"rule": [{
+			"detect_label": "CPU high",
+			"severity": "Critical",
+			"notifications": ["Slack,cred,alerts", "PagerDuty,typo"],
+			"notification": [{"team": [{"team_id": "missing"}]}]
+		}]
+	}`), r.CoreConfigSchema().ImpliedType())
+	require.NoError(t, err, "Must not error creating config")
+
+	for _, tc := range []struct {
+		name    string
+		enabled bool
+		errVal  string
+	}{
+		{name: "preview disabled", enabled: false, errVal: ""},
+		{
+			name:    "preview enabled",
+			enabled: true,
+			errVal: `rule "CPU high" (Critical) notifications.1: integration credential "typo" does not exist` + "\n" +
+				`rule "CPU high" (Critical) notification.0: team "missing" does not exist`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			meta := newMeta(t).(*pmeta.Meta)
+			meta.Registry = feature.NewRegistry()
+			meta.Registry.MustRegister(feature.PreviewNotificationValidation).SetEnabled(tc.enabled)
+
+			_, err := r.Diff(
+				context.Background(),
+				&terraform.InstanceState{RawConfig: config},
+				terraform.NewResourceConfigShimmed(config, r.CoreConfigSchema()),
+				meta,
+			)
+			if tc.errVal == "" {
+				assert.NoError(t, err, "Must not validate notifications without the preview")
+				return
+			}
+			assert.EqualError(t, err, tc.errVal, "Must name the rule and notification")
+		})
+	}
+}
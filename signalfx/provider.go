@@ -25,9 +25,13 @@ import (
 	sfx "github.com/signalfx/signalfx-go"
 
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/alertmuting"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchivecandidates"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchiveexemptmetric"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchiveexemptmetrics"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/autoarchivesettings"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/organization"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/teammembership"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
 	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
 	tfext "github.com/splunk-terraform/terraform-provider-signalfx/internal/tfextension"
@@ -113,6 +117,11 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "Required if the user is configured to be part of multiple organizations",
 			},
+			"realm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The realm of your Splunk Observability Cloud org, used as part of the resource identity when it can not be determined from the API URL",
+			},
 			"feature_preview": {
 				Type: schema.TypeMap,
 				Elem: &schema.Schema{
@@ -141,14 +150,19 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"signalfx_dimension_values":      dataSourceDimensionValues(),
-			"signalfx_pagerduty_integration": dataSourcePagerDutyIntegration(),
-			organization.DataSourceName:      organization.NewDataSource(),
+			"signalfx_dimension_values":          dataSourceDimensionValues(),
+			"signalfx_pagerduty_integration":     dataSourcePagerDutyIntegration(),
+			"signalfx_slo":                       dataSourceSlo(),
+			"signalfx_slos":                      dataSourceSlos(),
+			organization.DataSourceName:          organization.NewDataSource(),
+			alertmuting.DataSourceName:           alertmuting.NewDataSource(),
+			autoarchivecandidates.DataSourceName: autoarchivecandidates.NewDataSource(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"signalfx_alert_muting_rule":                alertMutingRuleResource(),
 			"signalfx_automated_archival_settings":      autoarchivesettings.NewResource(),
 			"signalfx_automated_archival_exempt_metric": autoarchiveexemptmetric.NewResource(),
+			autoarchiveexemptmetrics.ResourceName:       autoarchiveexemptmetrics.NewResource(),
 			"signalfx_aws_external_integration":         integrationAWSExternalResource(),
 			"signalfx_aws_token_integration":            integrationAWSTokenResource(),
 			"signalfx_aws_integration":                  integrationAWSResource(),
@@ -170,6 +184,7 @@ func Provider() *schema.Provider {
 			"signalfx_single_value_chart":               singleValueChartResource(),
 			"signalfx_slo_chart":                        sloChartResource(),
 			"signalfx_team":                             teamResource(),
+			teammembership.ResourceName:                 teammembership.NewResource(),
 			"signalfx_time_chart":                       timeChartResource(),
 			"signalfx_text_chart":                       textChartResource(),
 			"signalfx_victor_ops_integration":           integrationVictorOpsResource(),
@@ -177,14 +192,13 @@ func Provider() *schema.Provider {
 			"signalfx_log_view":                         logViewResource(),
 			"signalfx_log_timeline":                     logTimelineResource(),
 			"signalfx_table_chart":                      tableChartResource(),
-			"signalfx_metric_ruleset":                   metricRulesetResource(),
-			"signalfx_slo":                              sloResource(),
 		},
 		ConfigureFunc: signalfxConfigure,
 	}
 
 	for _, res := range sfxProvider.ResourcesMap {
 		res = deprecatedMethodDecorator(res)
+		res = pmeta.ResourceIdentityDecorator(res)
 	}
 
 	for _, ds := range sfxProvider.DataSourcesMap {
@@ -199,6 +213,7 @@ func signalfxConfigure(data *schema.ResourceData) (interface{}, error) {
 		Email:          data.Get("email").(string),
 		Password:       data.Get("password").(string),
 		OrganizationID: data.Get("organization_id").(string),
+		Realm:          data.Get("realm").(string),
 		Tags:           convert.SliceAll(data.Get("tags").([]any), convert.ToString),
 		Teams:          convert.SliceAll(data.Get("teams").([]any), convert.ToString),
 	}
@@ -300,6 +315,7 @@ func signalfxConfigure(data *schema.ResourceData) (interface{}, error) {
 	}
 
 	config.Client = client
+	config.HTTPClient = standardClient
 
 	for feat, val := range data.Get("feature_preview").(map[string]any) {
 		err = pmeta.LoadPreviewRegistry(
@@ -0,0 +1,256 @@
+// Copyright Splunk, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalfx
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/signalfx/signalfx-go/dashboard"
+	"github.com/signalfx/signalfx-go/dashboard_group"
+	"github.com/signalfx/signalfx-go/detector"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/feature"
+	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
+)
+
+func newProviderTeamsMeta(tb testing.TB, routes map[string]http.HandlerFunc) *pmeta.Meta {
+	meta := tftest.NewTestHTTPMockMeta(routes)(tb).(*pmeta.Meta)
+	meta.Registry = feature.NewRegistry()
+	_ = meta.Registry.MustRegister(feature.PreviewProviderTeams, feature.WithPreviewGlobalAvailable())
+	meta.Teams = []string{"team-00"}
+	return meta
+}
+
+func TestDashboardGroupProviderTeams(t *testing.T) {
+	t.Parallel()
+
+	var sent *dashboard_group.CreateUpdateDashboardGroupRequest
+	meta := newProviderTeamsMeta(t, map[string]http.HandlerFunc{
+		"POST /v2/dashboardgroup": func(w http.ResponseWriter, r *http.Request) {
+			sent = &dashboard_group.CreateUpdateDashboardGroupRequest{}
+			if err := json.NewDecoder(r.Body).Decode(sent); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(&dashboard_group.DashboardGroup{
+				Id:          "group-01",
+				Name:        sent.Name,
+				Description: sent.Description,
+				Teams:       sent.Teams,
+				AuthorizedWriters: &dashboard_group.AuthorizedWriters{
+					Teams: sent.AuthorizedWriters.Teams,
+					Users: sent.AuthorizedWriters.Users,
+				},
+			})
+		},
+		"GET /v2/dashboardgroup/group-01": func(w http.ResponseWriter, r *http.Request) {
+			_, _ = io.Copy(io.Discard, r.Body)
+			_ = r.Body.Close()
+
+			_ = json.NewEncoder(w).Encode(&dashboard_group.DashboardGroup{
+				Id:    "group-01",
+				Name:  sent.Name,
+				Teams: sent.Teams,
+				// The writer teams have been removed outside of terraform
+				AuthorizedWriters: &dashboard_group.AuthorizedWriters{
+					Users: sent.AuthorizedWriters.Users,
+				},
+			})
+		},
+	})
+
+	rd := schema.TestResourceDataRaw(t, dashboardGroupResource().Schema, map[string]any{
+		"name":                    "example",
+		"teams":                   []any{"team-01"},
+		"authorized_writer_users": []any{"user-01"},
+	})
+
+	require.NoError(t, dashboardgroupCreate(rd, meta), "Must not error creating dashboard group")
+	require.NotNil(t, sent, "Must have sent the create request")
+	assert.Equal(t, []string{"team-00", "team-01"}, sent.Teams, "Must include the provider teams")
+	assert.Equal(t, []string{"team-00"}, sent.AuthorizedWriters.Teams, "Must include provider teams as writers")
+
+	assert.ElementsMatch(t, []any{"team-01"}, rd.Get("teams").(*schema.Set).List(), "Must only keep the configured teams")
+	assert.ElementsMatch(t, []any{"team-00", "team-01"}, rd.Get("teams_all").(*schema.Set).List(), "Must store all the teams")
+	assert.Empty(t, rd.Get("authorized_writer_teams").(*schema.Set).List(), "Must not store provider writer teams")
+	assert.ElementsMatch(t, []any{"team-00"}, rd.Get("authorized_writer_teams_all").(*schema.Set).List(), "Must store all writer teams")
+
+	require.NoError(t, dashboardgroupRead(rd, meta), "Must not error reading dashboard group")
+	assert.Empty(t, rd.Get("authorized_writer_teams_all").(*schema.Set).List(), "Must remove writer teams removed outside of terraform")
+}
+
+// mockObjects stores the objects sent to the mock server so that they can be read back,
+// it relies on the request and response of the API sharing the same field names.
+type mockObjects[T any] struct {
+	mu      sync.Mutex
+	objects map[string]*T
+}
+
+func newMockObjects[T any]() *mockObjects[T] {
+	return &mockObjects[T]{objects: make(map[string]*T)}
+}
+
+// update allows the test to modify the object as if it was changed outside of terraform.
+func (mo *mockObjects[T]) update(id string, fn func(*T)) {
+	mo.mu.Lock()
+	defer mo.mu.Unlock()
+	fn(mo.objects[id])
+}
+
+func (mo *mockObjects[T]) routes(path, id string, deleteStatus int, setID func(*T, string)) map[string]http.HandlerFunc {
+	save := func(w http.ResponseWriter, r *http.Request) {
+		obj := new(T)
+		if err := json.NewDecoder(r.Body).Decode(obj); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		setID(obj, id)
+
+		mo.mu.Lock()
+		defer mo.mu.Unlock()
+		mo.objects[id] = obj
+		_ = json.NewEncoder(w).Encode(obj)
+	}
+	return map[string]http.HandlerFunc{
+		"POST " + path:           save,
+		"PUT " + path + "/" + id: save,
+		"GET " + path + "/" + id: func(w http.ResponseWriter, _ *http.Request) {
+			mo.mu.Lock()
+			defer mo.mu.Unlock()
+			obj, ok := mo.objects[id]
+			if !ok {
+				http.Error(w, "not found", http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(obj)
+		},
+		"DELETE " + path + "/" + id: func(w http.ResponseWriter, _ *http.Request) {
+			mo.mu.Lock()
+			defer mo.mu.Unlock()
+			delete(mo.objects, id)
+			w.WriteHeader(deleteStatus)
+		},
+	}
+}
+
+func newProviderTeamsAcceptanceHandler(name string, res *schema.Resource) *tftest.AcceptanceHandler {
+	return tftest.NewAcceptanceHandler(
+		tftest.WithAcceptanceResources(map[string]*schema.Resource{
+			name: res,
+		}),
+	)
+}
+
+// providerTeamsSteps checks that the provider teams are applied to the resource,
+// and that writer teams removed outside of terraform are added back on the next apply.
+func providerTeamsSteps(name, config string, removeWriterTeams func()) []resource.TestStep {
+	return []resource.TestStep{
+		{
+			Config: config,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(name, "authorized_writer_teams.#", "0"),
+				resource.TestCheckResourceAttr(name, "authorized_writer_teams_all.#", "1"),
+				resource.TestCheckTypeSetElemAttr(name, "authorized_writer_teams_all.*", "team-00"),
+			),
+		},
+		{
+			PreConfig:          removeWriterTeams,
+			Config:             config,
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: config,
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(name, "authorized_writer_teams_all.#", "1"),
+				resource.TestCheckTypeSetElemAttr(name, "authorized_writer_teams_all.*", "team-00"),
+			),
+		},
+	}
+}
+
+func TestAccDashboardGroupProviderTeams(t *testing.T) {
+	groups := newMockObjects[dashboard_group.DashboardGroup]()
+	routes := groups.routes("/v2/dashboardgroup", "group-01", http.StatusNoContent, func(dg *dashboard_group.DashboardGroup, id string) {
+		dg.Id = id
+	})
+
+	newProviderTeamsAcceptanceHandler("signalfx_dashboard_group", dashboardGroupResource()).MockTest(
+		t,
+		func(tb testing.TB) any { return newProviderTeamsMeta(tb, routes) },
+		providerTeamsSteps("signalfx_dashboard_group.example", `
+resource "signalfx_dashboard_group" "example" {
+  name                    = "example"
+  authorized_writer_users = ["user-01"]
+}
+`, func() {
+			groups.update("group-01", func(dg *dashboard_group.DashboardGroup) {
+				dg.AuthorizedWriters.Teams = nil
+			})
+		}),
+	)
+}
+
+func TestAccDashboardProviderTeams(t *testing.T) {
+	dashboards := newMockObjects[dashboard.Dashboard]()
+	routes := dashboards.routes("/v2/dashboard", "dashboard-01", http.StatusOK, func(d *dashboard.Dashboard, id string) {
+		d.Id = id
+	})
+
+	newProviderTeamsAcceptanceHandler("signalfx_dashboard", dashboardResource()).MockTest(
+		t,
+		func(tb testing.TB) any { return newProviderTeamsMeta(tb, routes) },
+		providerTeamsSteps("signalfx_dashboard.example", `
+resource "signalfx_dashboard" "example" {
+  name                    = "example"
+  dashboard_group         = "group-01"
+  authorized_writer_users = ["user-01"]
+}
+`, func() {
+			dashboards.update("dashboard-01", func(d *dashboard.Dashboard) {
+				d.AuthorizedWriters = nil
+			})
+		}),
+	)
+}
+
+func TestAccDetectorProviderTeams(t *testing.T) {
+	detectors := newMockObjects[detector.Detector]()
+	routes := detectors.routes("/v2/detector", "detector-01", http.StatusNoContent, func(d *detector.Detector, id string) {
+		d.Id = id
+	})
+	routes["POST /v2/detector/validate"] = func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	newProviderTeamsAcceptanceHandler("signalfx_detector", detectorResource()).MockTest(
+		t,
+		func(tb testing.TB) any { return newProviderTeamsMeta(tb, routes) },
+		providerTeamsSteps("signalfx_detector.example", `
+resource "signalfx_detector" "example" {
+  name                    = "example"
+  program_text            = "detect(when(data('cpu.utilization').max() > 90)).publish('high')"
+  authorized_writer_users = ["user-01"]
+
+  rule {
+    detect_label = "high"
+    severity     = "Critical"
+  }
+}
+`, func() {
+			detectors.update("detector-01", func(d *detector.Detector) {
+				d.AuthorizedWriters.Teams = nil
+			})
+		}),
+	)
+}
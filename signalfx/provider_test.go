@@ -11,10 +11,15 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sfx "github.com/signalfx/signalfx-go"
 	"github.com/stretchr/testify/assert"
+
+	internalframework "github.com/splunk-terraform/terraform-provider-signalfx/internal/framework"
 )
 
 var OldSystemConfigPath = SystemConfigPath
@@ -30,6 +35,25 @@ func init() {
 	}
 }
 
+// testAccMuxedProviderFactories serves the SDK provider together with the framework provider,
+// which is needed to test resources that have been migrated to the framework.
+var testAccMuxedProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"signalfx": func() (tfprotov5.ProviderServer, error) {
+		sfx := Provider()
+		mux, err := tf5muxserver.NewMuxServer(context.Background(),
+			providerserver.NewProtocol5(internalframework.NewProvider(
+				"test",
+				internalframework.WithProviderLegacyResources(sfx.ResourcesMap),
+			)),
+			sfx.GRPCProvider,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return mux.ProviderServer(), nil
+	},
+}
+
 func resetGlobals() {
 	SystemConfigPath = OldSystemConfigPath
 	HomeConfigPath = OldHomeConfigPath
@@ -392,3 +416,35 @@ func TestReadConfigFileSuccess(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, "XXX", config.AuthToken)
 }
+
+func TestProviderProgramTextDiffSuppress(t *testing.T) {
+	t.Parallel()
+
+	var (
+		old = "A = data('cpu.utilization').publish(label='A')"
+		new = "# CPU usage\nA = data(\"cpu.utilization\").publish(label=\"A\")\n"
+	)
+	for name, r := range Provider().ResourcesMap {
+		s, ok := r.Schema["program_text"]
+		if !ok {
+			continue
+		}
+		assert.NotNil(t, s.DiffSuppressFunc, "Must suppress formatting changes to %s program_text", name)
+		assert.True(t, s.DiffSuppressFunc("program_text", old, new, nil), "Must suppress formatting changes to %s program_text", name)
+		assert.False(t, s.DiffSuppressFunc("program_text", old, "A = data('cpu.utilization').publish(label='B')", nil), "Must not suppress changes to %s program_text", name)
+	}
+
+	state := timeChartResource().Data(nil)
+	state.SetId("chart-01")
+	assert.NoError(t, state.Set("name", "CPU"))
+	assert.NoError(t, state.Set("program_text", old))
+
+	diff, err := timeChartResource().Diff(context.Background(), state.State(), terraform.NewResourceConfigRaw(map[string]any{
+		"name":         "CPU",
+		"program_text": new,
+	}), nil)
+	assert.NoError(t, err, "Must not error creating the diff")
+	if diff != nil {
+		assert.NotContains(t, diff.Attributes, "program_text", "Must not plan a change to program_text")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,10 +84,11 @@ func alertMutingRuleResource() *schema.Resource {
 				},
 			},
 			"start_time": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "starting time of an alert muting rule as a Unix timestamp, in seconds",
-				ForceNew:    true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"start_time", "schedule"},
+				Description:  "starting time of an alert muting rule as a Unix timestamp, in seconds",
+				ForceNew:     true,
 			},
 			"stop_time": {
 				Type:        schema.TypeInt,
@@ -102,7 +104,18 @@ func alertMutingRuleResource() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"expired": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the muting rule has ended, an ended muting rule is replaced when its configuration changes",
+			},
+			"schedule":   alertMutingScheduleSchema(),
+			"occurrence": alertMutingOccurrenceSchema(),
 		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffAlertMutingExpiry,
+			customizeDiffAlertMutingSchedule,
+		),
 		Create: alertMutingRuleCreate,
 		Read:   alertMutingRuleRead,
 		Update: alertMutingRuleUpdate,
@@ -165,6 +178,10 @@ func getPayloadAlertMutingRule(d *schema.ResourceData) (*alertmuting.CreateUpdat
 }
 
 func alertMutingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("schedule"); ok {
+		return alertMutingScheduleCreate(d, meta)
+	}
+
 	config := meta.(*signalfxConfig)
 	payload, err := getPayloadAlertMutingRule(d)
 	if err != nil {
@@ -184,6 +201,10 @@ func alertMutingRuleCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func alertMutingRuleRead(d *schema.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("schedule"); ok {
+		return alertMutingScheduleRead(d, meta)
+	}
+
 	config := meta.(*signalfxConfig)
 
 	amr, err := config.Client.GetAlertMutingRule(context.TODO(), d.Id())
@@ -195,13 +216,96 @@ func alertMutingRuleRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return alertMutingRuleAPIToTF(d, amr)
+	if err := alertMutingRuleAPIToTF(d, amr); err != nil {
+		return err
+	}
+
+	// An ended muting rule is still read so that changes made outside of terraform,
+	// such as extending the stop time, are refreshed and can mark the rule as active again.
+	ended := alertMutingRuleEnded(int(amr.StopTime), amr.Recurrence != nil, time.Now())
+	if ended {
+		log.Printf("[DEBUG] SignalFx: Alert Muting Rule %s has ended", d.Id())
+	}
+	return d.Set("expired", ended)
+}
+
+// alertMutingRuleEnded reports if a muting rule with the stop time, in milliseconds, has ended.
+// A rule without a stop time or with a recurrence never ends.
+func alertMutingRuleEnded(stopTime int, recurring bool, now time.Time) bool {
+	return stopTime > 0 && !recurring && int64(stopTime) <= now.UnixMilli()
+}
+
+// customizeDiffAlertMutingExpiry replaces an expired muting rule when its configuration
+// changes, since the API does not allow a rule that has ended to be updated,
+// and stops a muting rule from being created once its stop time has passed.
+func customizeDiffAlertMutingExpiry(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if _, ok := d.GetOk("schedule"); ok {
+		return nil
+	}
+
+	keys := []string{"description", "detectors", "filter", "recurrence", "start_time", "stop_time"}
+	expired := d.Id() != "" && d.Get("expired").(bool)
+	if d.Id() != "" && (!expired || !d.HasChanges(keys...)) {
+		return nil
+	}
+
+	stop := d.Get("stop_time").(int)
+	recurring := d.Get("recurrence").(*schema.Set).Len() > 0
+	if d.NewValueKnown("stop_time") && alertMutingRuleEnded(stop*1000, recurring, time.Now()) {
+		return fmt.Errorf("stop_time %d has already passed, set a stop_time in the future to create the muting rule", stop)
+	}
+
+	for _, key := range keys {
+		if expired && d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func alertMutingRuleAPIToTF(d *schema.ResourceData, amr *alertmuting.AlertMutingRule) error {
 	debugOutput, _ := json.Marshal(amr)
 	log.Printf("[DEBUG] SignalFx: Got Alert Muting Rule to enState: %s", string(debugOutput))
 
+	if err := alertMutingRuleFiltersToTF(d, amr); err != nil {
+		return err
+	}
+
+	if amr.Filters != nil && len(amr.Filters) > 0 {
+		// The API changes `startTime` to be >= the current
+		// timestamp at the time of the API call. This means
+		// it will pretty much never agree with what the user specified.
+		// To accommodate this we will store the "effective" start time
+		// as a computed attribute, then…
+		if err := d.Set("effective_start_time", amr.StartTime); err != nil {
+			return err
+		}
+		// We will ignore the start time because it doesn't matter.
+		// See above.
+		if err := d.Set("stop_time", amr.StopTime/1000); err != nil {
+			return err
+		}
+	}
+
+	if amr.Recurrence != nil {
+		d.Set("recurrence", []interface{}{
+			map[string]interface{}{
+				"unit":  amr.Recurrence.Unit,
+				"value": amr.Recurrence.Value,
+			},
+		})
+	} else {
+		d.Set("recurrence", nil)
+	}
+
+	return nil
+}
+
+// alertMutingRuleFiltersToTF sets the description, filters and detectors
+// which are shared by every muting rule managed for a schedule.
+func alertMutingRuleFiltersToTF(d *schema.ResourceData, amr *alertmuting.AlertMutingRule) error {
 	if err := d.Set("description", amr.Description); err != nil {
 		return err
 	}
@@ -242,36 +346,15 @@ func alertMutingRuleAPIToTF(d *schema.ResourceData, amr *alertmuting.AlertMuting
 				return err
 			}
 		}
-		// The API changes `startTime` to be >= the current
-		// timestamp at the time of the API call. This means
-		// it will pretty much never agree with what the user specified.
-		// To accommodate this we will store the "effective" start time
-		// as a computed attribute, then…
-		if err := d.Set("effective_start_time", amr.StartTime); err != nil {
-			return err
-		}
-		// We will ignore the start time because it doesn't matter.
-		// See above.
-		if err := d.Set("stop_time", amr.StopTime/1000); err != nil {
-			return err
-		}
 	}
-
-	if amr.Recurrence != nil {
-		d.Set("recurrence", []interface{}{
-			map[string]interface{}{
-				"unit":  amr.Recurrence.Unit,
-				"value": amr.Recurrence.Value,
-			},
-		})
-	} else {
-		d.Set("recurrence", nil)
-	}
-
 	return nil
 }
 
 func alertMutingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("schedule"); ok {
+		return alertMutingScheduleUpdate(d, meta)
+	}
+
 	config := meta.(*signalfxConfig)
 	payload, err := getPayloadAlertMutingRule(d)
 	if err != nil {
@@ -309,8 +392,17 @@ func alertMutingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func alertMutingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("schedule"); ok {
+		return alertMutingScheduleDelete(d, meta)
+	}
+
 	config := meta.(*signalfxConfig)
 
+	if d.Get("expired").(bool) {
+		log.Printf("[DEBUG] SignalFx: Removing ended Alert Muting Rule %s from state", d.Id())
+		return nil
+	}
+
 	err := config.Client.DeleteAlertMutingRule(context.TODO(), d.Id())
 	// Silently ignore muting in the past for there is nothing the client could do with them and attempt to destroy
 	// results in invalid terraform state.
@@ -10,8 +10,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/signalfx/signalfx-go/alertmuting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/tftest"
 )
 
 func TestAccCreateUpdateFutureAlertMutingRule(t *testing.T) {
@@ -125,3 +133,186 @@ func testAccAlertMutingRuleDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestAlertMutingRuleExpiry(t *testing.T) {
+	t.Parallel()
+
+	routes, rules := newTestAlertMutingStore()
+	meta := tftest.NewTestHTTPMockMeta(routes)(t)
+
+	past := time.Now().Add(-time.Hour)
+	rules["amr-ended"] = &alertmuting.AlertMutingRule{
+		Id:          "amr-ended",
+		Description: "changed outside of terraform",
+		StartTime:   past.Add(-time.Hour).UnixMilli(),
+		StopTime:    past.UnixMilli(),
+	}
+
+	for _, tc := range []struct {
+		name     string
+		id       string
+		stopTime int64
+		expectID string
+	}{
+		{name: "ended rule", id: "amr-ended", stopTime: past.Unix(), expectID: "amr-ended"},
+		{name: "removed ended rule", id: "amr-removed", stopTime: past.Unix(), expectID: ""},
+		{name: "removed active rule", id: "amr-removed", stopTime: time.Now().Add(time.Hour).Unix(), expectID: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, alertMutingRuleResource().Schema, map[string]any{
+				"description": "maintenance",
+				"start_time":  int(past.Add(-time.Hour).Unix()),
+				"stop_time":   int(tc.stopTime),
+			})
+			d.SetId(tc.id)
+
+			require.NoError(t, alertMutingRuleRead(d, meta), "Must not error reading rule")
+			assert.Equal(t, tc.expectID, d.Id(), "Must match the expected id")
+			if tc.expectID == "" {
+				return
+			}
+			assert.Equal(t, true, d.Get("expired"), "Must mark the rule as expired")
+			assert.Equal(t, "changed outside of terraform", d.Get("description"), "Must refresh an expired rule")
+
+			require.NoError(t, alertMutingRuleRead(d, meta), "Must not error reading an expired rule")
+			require.NoError(t, alertMutingRuleDelete(d, meta), "Must not error deleting an expired rule")
+		})
+	}
+}
+
+func TestAlertMutingRuleExtendedOutsideTerraform(t *testing.T) {
+	t.Parallel()
+
+	routes, rules := newTestAlertMutingStore()
+	meta := tftest.NewTestHTTPMockMeta(routes)(t)
+
+	var (
+		past    = time.Now().Add(-time.Hour)
+		future  = time.Now().Add(time.Hour)
+		filters = []*alertmuting.AlertMutingRuleFilter{
+			{Property: alertMutingDetectorIdProperty, PropertyValue: alertmuting.StringOrArray{Values: []string{"detector-01"}}},
+		}
+	)
+	rules["amr-01"] = &alertmuting.AlertMutingRule{
+		Id:          "amr-01",
+		Description: "maintenance",
+		Filters:     filters,
+		StartTime:   past.Add(-time.Hour).UnixMilli(),
+		StopTime:    past.UnixMilli(),
+	}
+
+	d := schema.TestResourceDataRaw(t, alertMutingRuleResource().Schema, map[string]any{
+		"description": "maintenance",
+		"detectors":   []any{"detector-01"},
+		"start_time":  int(past.Add(-time.Hour).Unix()),
+		"stop_time":   int(past.Unix()),
+	})
+	d.SetId("amr-01")
+
+	require.NoError(t, alertMutingRuleRead(d, meta), "Must not error reading rule")
+	require.Equal(t, true, d.Get("expired"), "Must mark the rule as expired")
+
+	_, err := meta.(*signalfxConfig).Client.UpdateAlertMutingRule(context.Background(), "amr-01", &alertmuting.CreateUpdateAlertMutingRuleRequest{
+		Description: "maintenance",
+		Filters:     filters,
+		StartTime:   past.Add(-time.Hour).UnixMilli(),
+		StopTime:    future.UnixMilli(),
+	})
+	require.NoError(t, err, "Must not error extending the rule")
+
+	require.NoError(t, alertMutingRuleRead(d, meta), "Must not error reading the extended rule")
+	assert.Equal(t, "amr-01", d.Id(), "Must keep the rule in state")
+	assert.Equal(t, false, d.Get("expired"), "Must no longer mark the rule as expired")
+	assert.Equal(t, int(future.Unix()), d.Get("stop_time"), "Must refresh the extended stop time")
+}
+
+func TestCustomizeDiffAlertMutingExpiry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		r     = alertMutingRuleResource()
+		start = time.Now().Add(-2 * time.Hour).Unix()
+		stop  = time.Now().Add(-time.Hour).Unix()
+	)
+
+	newConfig := func(t *testing.T, description string, stopTime int64) cty.Value {
+		t.Helper()
+
+		config, err := ctyjson.Unmarshal([]byte(fmt.Sprintf(`{
+			"description": %q,
+			"detectors": ["detector-01"],
+			"start_time": %d,
+			"stop_time": %d
+		}`, description, start, stopTime)), r.CoreConfigSchema().ImpliedType())
+		require.NoError(t, err, "Must not error creating config")
+		return config
+	}
+
+	state := &terraform.InstanceState{
+		ID: "amr-01",
+		Attributes: map[string]string{
+			"id":          "amr-01",
+			"description": "maintenance",
+			"detectors.#": "1",
+			"detectors.0": "detector-01",
+			"start_time":  strconv.FormatInt(start, 10),
+			"stop_time":   strconv.FormatInt(stop, 10),
+			"expired":     "true",
+		},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		state       *terraform.InstanceState
+		description string
+		stopTime    int64
+		errVal      string
+		replace     bool
+	}{
+		{
+			name:        "create with a stop time in the past",
+			state:       &terraform.InstanceState{},
+			description: "maintenance",
+			stopTime:    stop,
+			errVal:      fmt.Sprintf("stop_time %d has already passed, set a stop_time in the future to create the muting rule", stop),
+		},
+		{
+			name:        "expired without changes",
+			state:       state,
+			description: "maintenance",
+			stopTime:    stop,
+		},
+		{
+			name:        "expired with a new stop time",
+			state:       state,
+			description: "maintenance",
+			stopTime:    time.Now().Add(time.Hour).Unix(),
+			replace:     true,
+		},
+		{
+			name:        "expired with a changed description",
+			state:       state,
+			description: "changed",
+			stopTime:    stop,
+			errVal:      fmt.Sprintf("stop_time %d has already passed, set a stop_time in the future to create the muting rule", stop),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := newConfig(t, tc.description, tc.stopTime)
+			s := tc.state.DeepCopy()
+			s.RawConfig = config
+
+			diff, err := r.Diff(context.Background(), s, terraform.NewResourceConfigShimmed(config, r.CoreConfigSchema()), nil)
+			if tc.errVal != "" {
+				assert.EqualError(t, err, tc.errVal, "Must not allow a muting rule that has already ended")
+				return
+			}
+			require.NoError(t, err, "Must not error planning rule")
+			assert.Equal(t, tc.replace, diff != nil && diff.RequiresNew(), "Must only replace an expired rule when it changes")
+		})
+	}
+}
@@ -6,11 +6,14 @@ package signalfx
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/signalfx/signalfx-go/dashboard"
@@ -120,7 +123,7 @@ func dashboardResource() *schema.Resource {
 			"grid": &schema.Schema{
 				Type:          schema.TypeList,
 				Optional:      true,
-				ConflictsWith: []string{"column", "chart"},
+				ConflictsWith: []string{"column", "chart", "chart_definition", "layout"},
 				Description:   "Grid dashboard layout. Charts listed will be placed in a grid by row with the same width and height. If a chart can't fit in a row, it will be placed automatically in the next row",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -150,7 +153,7 @@ func dashboardResource() *schema.Resource {
 			"column": &schema.Schema{
 				Type:          schema.TypeList,
 				Optional:      true,
-				ConflictsWith: []string{"grid", "chart"},
+				ConflictsWith: []string{"grid", "chart", "chart_definition", "layout"},
 				Description:   "Column layout. Charts listed, will be placed in a single column with the same width and height",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -184,6 +187,8 @@ func dashboardResource() *schema.Resource {
 					},
 				},
 			},
+			"chart_definition": dashboardChartDefinitionSchema(),
+			"layout":           dashboardLayoutSchema(),
 			"variable": &schema.Schema{
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -392,6 +397,12 @@ func dashboardResource() *schema.Resource {
 				Deprecated:  "Please use permissions_* fields now",
 				Description: "Team IDs that have write access to this dashboard",
 			},
+			"authorized_writer_teams_all": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Team IDs that have write access to this dashboard, including the teams set by the provider",
+			},
 			"authorized_writer_users": &schema.Schema{
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -459,6 +470,12 @@ func dashboardResource() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customdiff.All(
+			pmeta.CustomizeDiffProviderWriterTeams("authorized_writer_teams", "authorized_writer_users", "authorized_writer_teams_all"),
+			customizeDiffDashboardChartDefinitions,
+			customizeDiffDashboardLayout,
+		),
+
 		Create: dashboardCreate,
 		Read:   dashboardRead,
 		Update: dashboardUpdate,
@@ -827,12 +844,37 @@ func dashboardCreate(d *schema.ResourceData, meta interface{}) error {
 		payload.Tags,
 	)
 
+	payload.AuthorizedWriters.Teams = pmeta.MergeProviderWriterTeams(
+		context.TODO(),
+		meta,
+		payload.AuthorizedWriters.Teams,
+		payload.AuthorizedWriters.Users,
+	)
+
+	// The inline charts and section headers are created before the dashboard, and are only
+	// tracked within the state once the dashboard exists, so they are removed again on failure.
+	inlineCharts, err := saveDashboardChartDefinitions(context.TODO(), d, meta)
+	cleanup := func(err error) error {
+		created := slices.Concat(dashboardChartIDs(inlineCharts), dashboardLayoutHeaders(d.Get("layout")))
+		return errors.Join(err, deleteDashboardChartDefinitions(context.TODO(), meta, created))
+	}
+	if err != nil {
+		return cleanup(err)
+	}
+	payload.Charts = append(payload.Charts, inlineCharts...)
+
+	layoutCharts, err := saveDashboardLayout(context.TODO(), d, meta)
+	if err != nil {
+		return cleanup(err)
+	}
+	payload.Charts = append(payload.Charts, layoutCharts...)
+
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Dashboard Create Payload: %s", debugOutput)
 
 	dash, err := config.Client.CreateDashboard(context.TODO(), payload)
 	if err != nil {
-		return err
+		return cleanup(err)
 	}
 	// Since things worked, set the URL and move on
 	appURL, err := buildAppURL(config.CustomAppURL, DashboardAppPath+dash.Id)
@@ -844,7 +886,7 @@ func dashboardCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(dash.Id)
 
-	return dashboardAPIToTF(d, dash)
+	return dashboardAPIToTF(d, dash, meta)
 }
 
 func dashboardRead(d *schema.ResourceData, meta interface{}) error {
@@ -867,10 +909,10 @@ func dashboardRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return dashboardAPIToTF(d, dash)
+	return dashboardAPIToTF(d, dash, meta)
 }
 
-func dashboardAPIToTF(d *schema.ResourceData, dash *dashboard.Dashboard) error {
+func dashboardAPIToTF(d *schema.ResourceData, dash *dashboard.Dashboard, meta interface{}) error {
 	debugOutput, _ := json.Marshal(dash)
 	log.Printf("[DEBUG] SignalFx: Got Dashboard to enState: %s", string(debugOutput))
 
@@ -887,17 +929,18 @@ func dashboardAPIToTF(d *schema.ResourceData, dash *dashboard.Dashboard) error {
 		return err
 	}
 
+	// The writer teams are always set so that teams removed outside
+	// of terraform do not remain in authorized_writer_teams_all.
+	var writerTeams []string
+	if dash.AuthorizedWriters != nil {
+		writerTeams = dash.AuthorizedWriters.Teams
+	}
+	if err := pmeta.SetProviderTeams(context.TODO(), meta, d, "authorized_writer_teams", "authorized_writer_teams_all", writerTeams); err != nil {
+		return err
+	}
+
 	if dash.AuthorizedWriters != nil {
 		aw := dash.AuthorizedWriters
-		if aw.Teams != nil && len(aw.Teams) > 0 {
-			teams := make([]interface{}, len(aw.Teams))
-			for i, v := range aw.Teams {
-				teams[i] = v
-			}
-			if err := d.Set("authorized_writer_teams", schema.NewSet(schema.HashString, teams)); err != nil {
-				return err
-			}
-		}
 		if aw.Users != nil && len(aw.Users) > 0 {
 			users := make([]interface{}, len(aw.Users))
 			for i, v := range aw.Users {
@@ -932,11 +975,20 @@ func dashboardAPIToTF(d *schema.ResourceData, dash *dashboard.Dashboard) error {
 		}
 	}
 
-	// The column and grid layouts are purely a terraform-side function and
+	// Charts created from a chart definition are tracked within
+	// the definition and are excluded from the chart layout.
+	inlineCharts, err := dashboardChartDefinitionsAPIToTF(context.TODO(), d, dash, meta)
+	if err != nil {
+		return err
+	}
+
+	// The column, grid and layout blocks are purely a terraform-side function and
 	// the API has no awareness of it. See the documentation for the dashboard
 	// resource for further discussion.
 	defaultLayout := true
-	if gridTF, ok := d.GetOk("grid"); ok {
+	if layoutTF, ok := d.GetOk("layout"); ok && len(layoutTF.([]interface{})) > 0 {
+		defaultLayout = false
+	} else if gridTF, ok := d.GetOk("grid"); ok {
 		if gridList, tok := gridTF.([]interface{}); tok {
 			if len(gridList) > 0 {
 				defaultLayout = false
@@ -951,15 +1003,18 @@ func dashboardAPIToTF(d *schema.ResourceData, dash *dashboard.Dashboard) error {
 	}
 
 	if defaultLayout {
-		charts := make([]map[string]interface{}, len(dash.Charts))
-		for i, c := range dash.Charts {
+		charts := make([]map[string]interface{}, 0, len(dash.Charts))
+		for _, c := range dash.Charts {
+			if slices.Contains(inlineCharts, c.ChartId) {
+				continue
+			}
 			chart := make(map[string]interface{})
 			chart["chart_id"] = c.ChartId
 			chart["height"] = c.Height
 			chart["width"] = c.Width
 			chart["row"] = c.Row
 			chart["column"] = c.Column
-			charts[i] = chart
+			charts = append(charts, chart)
 		}
 		if err := d.Set("chart", charts); err != nil {
 			return err
@@ -1125,6 +1180,25 @@ func dashboardUpdate(d *schema.ResourceData, meta interface{}) error {
 		payload.Tags,
 	)
 
+	payload.AuthorizedWriters.Teams = pmeta.MergeProviderWriterTeams(
+		context.TODO(),
+		meta,
+		payload.AuthorizedWriters.Teams,
+		payload.AuthorizedWriters.Users,
+	)
+
+	inlineCharts, err := saveDashboardChartDefinitions(context.TODO(), d, meta)
+	if err != nil {
+		return err
+	}
+	payload.Charts = append(payload.Charts, inlineCharts...)
+
+	layoutCharts, err := saveDashboardLayout(context.TODO(), d, meta)
+	if err != nil {
+		return err
+	}
+	payload.Charts = append(payload.Charts, layoutCharts...)
+
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update Dashboard Payload: %s", string(debugOutput))
 
@@ -1132,6 +1206,13 @@ func dashboardUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
+	// Charts are only removed once the dashboard no longer refers to them.
+	if err := deleteDashboardChartDefinitions(context.TODO(), meta, removedDashboardChartDefinitions(d)); err != nil {
+		return err
+	}
+	if err := deleteDashboardChartDefinitions(context.TODO(), meta, removedDashboardLayoutHeaders(d, dashboardLayoutHeaders(d.Get("layout")))); err != nil {
+		return err
+	}
 	log.Printf("[DEBUG] SignalFx: Update Dashboard Response: %v", dash)
 	// Since things worked, set the URL and move on
 	appURL, err := buildAppURL(config.CustomAppURL, DashboardAppPath+dash.Id)
@@ -1142,14 +1223,24 @@ func dashboardUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	d.SetId(dash.Id)
-	return dashboardAPIToTF(d, dash)
+	return dashboardAPIToTF(d, dash, meta)
 }
 
 func dashboardDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*signalfxConfig)
 
-	err := config.Client.DeleteDashboard(context.TODO(), d.Id())
-	return err
+	if err := config.Client.DeleteDashboard(context.TODO(), d.Id()); err != nil {
+		return err
+	}
+
+	var inlineCharts []string
+	for _, v := range d.Get("chart_definition").([]interface{}) {
+		if def, ok := v.(map[string]interface{}); ok && def["chart_id"] != "" {
+			inlineCharts = append(inlineCharts, def["chart_id"].(string))
+		}
+	}
+	inlineCharts = append(inlineCharts, dashboardLayoutHeaders(d.Get("layout"))...)
+	return deleteDashboardChartDefinitions(context.TODO(), meta, inlineCharts)
 }
 
 /*
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	dashboard_group "github.com/signalfx/signalfx-go/dashboard_group"
@@ -35,6 +36,12 @@ func dashboardGroupResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Team IDs to associate the dashboard group to",
 			},
+			"teams_all": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Team IDs associated with the dashboard group, including the teams set by the provider",
+			},
 			"dashboard": &schema.Schema{
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -123,6 +130,12 @@ func dashboardGroupResource() *schema.Resource {
 				Deprecated:  "Please use permissions field now",
 				Description: "Team IDs that have write access to this dashboard",
 			},
+			"authorized_writer_teams_all": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Team IDs that have write access to this dashboard group, including the teams set by the provider",
+			},
 			"authorized_writer_users": &schema.Schema{
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -200,6 +213,11 @@ func dashboardGroupResource() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: customdiff.All(
+			pmeta.CustomizeDiffProviderTeams("teams", "teams_all"),
+			pmeta.CustomizeDiffProviderWriterTeams("authorized_writer_teams", "authorized_writer_users", "authorized_writer_teams_all"),
+		),
+
 		Create: dashboardgroupCreate,
 		Read:   dashboardgroupRead,
 		Update: dashboardgroupUpdate,
@@ -410,6 +428,12 @@ func dashboardgroupCreate(d *schema.ResourceData, meta interface{}) error {
 	payload := getPayloadDashboardGroup(d)
 
 	payload.Teams = pmeta.MergeProviderTeams(context.TODO(), meta, payload.Teams)
+	payload.AuthorizedWriters.Teams = pmeta.MergeProviderWriterTeams(
+		context.TODO(),
+		meta,
+		payload.AuthorizedWriters.Teams,
+		payload.AuthorizedWriters.Users,
+	)
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Dashboard Group Create Payload: %s", debugOutput)
 
@@ -432,21 +456,22 @@ func dashboardGroupAPIToTF(d *schema.ResourceData, dg *dashboard_group.Dashboard
 	if err := d.Set("description", dg.Description); err != nil {
 		return err
 	}
-	if err := d.Set("teams", dg.Teams); err != nil {
+	if err := pmeta.SetProviderTeams(context.TODO(), meta, d, "teams", "teams_all", dg.Teams); err != nil {
+		return err
+	}
+
+	// The writer teams are always set so that teams removed outside
+	// of terraform do not remain in authorized_writer_teams_all.
+	var writerTeams []string
+	if dg.AuthorizedWriters != nil {
+		writerTeams = dg.AuthorizedWriters.Teams
+	}
+	if err := pmeta.SetProviderTeams(context.TODO(), meta, d, "authorized_writer_teams", "authorized_writer_teams_all", writerTeams); err != nil {
 		return err
 	}
 
 	if dg.AuthorizedWriters != nil {
 		aw := dg.AuthorizedWriters
-		if aw.Teams != nil && len(aw.Teams) > 0 {
-			teams := make([]interface{}, len(aw.Teams))
-			for i, v := range aw.Teams {
-				teams[i] = v
-			}
-			if err := d.Set("authorized_writer_teams", schema.NewSet(schema.HashString, teams)); err != nil {
-				return err
-			}
-		}
 		if aw.Users != nil && len(aw.Users) > 0 {
 			users := make([]interface{}, len(aw.Users))
 			for i, v := range aw.Users {
@@ -582,6 +607,12 @@ func dashboardgroupUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("failed to get current dashboard list for %s: %v", d.Id(), err)
 	}
 	payload.Teams = pmeta.MergeProviderTeams(context.TODO(), meta, payload.Teams)
+	payload.AuthorizedWriters.Teams = pmeta.MergeProviderWriterTeams(
+		context.TODO(),
+		meta,
+		payload.AuthorizedWriters.Teams,
+		payload.AuthorizedWriters.Users,
+	)
 
 	payload.DashboardConfigs = append(payload.DashboardConfigs, nonMirroredDashes...)
 	debugOutput, _ := json.Marshal(payload)
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"log"
+	"slices"
 	"sort"
 	"strings"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/check"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/convert"
+	notificationdef "github.com/splunk-terraform/terraform-provider-signalfx/internal/definition/notification"
 	pmeta "github.com/splunk-terraform/terraform-provider-signalfx/internal/providermeta"
 	"github.com/splunk-terraform/terraform-provider-signalfx/internal/visual"
 )
@@ -91,6 +93,8 @@ var (
 			},
 			Description: "One or more alert clear states for which clear notifications are not sent (one or more of: OK, AUTO_RESOLVED, STOPPED, MANUALLY_RESOLVED)",
 		},
+		"notification": notificationdef.NewSchema("Structured notifications sent when an incident occurs, as an alternative to `notifications`. Each notification sets exactly one notification type block."),
+		"escalation":   detectorEscalationSchema(),
 		"reminder_notification": {
 			Optional:    true,
 			Description: "Reminder notification in a detector rule lets you send multiple notifications for active alerts over a defined period of time.",
@@ -129,10 +133,11 @@ func detectorResource() *schema.Resource {
 				Description: "Name of the detector",
 			},
 			"program_text": {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Signalflow program text for the detector. More info at \"https://developers.signalfx.com/docs/signalflow-overview\"",
-				ValidateFunc: validation.StringLenBetween(1, 50000),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Signalflow program text for the detector. More info at \"https://developers.signalfx.com/docs/signalflow-overview\"",
+				ValidateFunc:     validation.StringLenBetween(1, 50000),
+				DiffSuppressFunc: common.SuppressSignalFlowDiff,
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -208,6 +213,12 @@ func detectorResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Team IDs to associate the detector to",
 			},
+			"teams_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Team IDs associated with the detector, including the teams set by the provider",
+			},
 			"rule": {
 				Type:        schema.TypeSet,
 				Required:    true,
@@ -223,6 +234,12 @@ func detectorResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Team IDs that have write access to this dashboard",
 			},
+			"authorized_writer_teams_all": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Team IDs that have write access to this detector, including the teams set by the provider",
+			},
 			"authorized_writer_users": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -306,7 +323,13 @@ func detectorResource() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: customdiff.If(validateProgramTextCondition, validateProgramText),
+		CustomizeDiff: customdiff.All(
+			customdiff.If(validateProgramTextCondition, validateProgramText),
+			validateDetectorEscalations,
+			validateDetectorNotifications,
+			pmeta.CustomizeDiffProviderTeams("teams", "teams_all"),
+			pmeta.CustomizeDiffProviderWriterTeams("authorized_writer_teams", "authorized_writer_users", "authorized_writer_teams_all"),
+		),
 
 		Create: detectorCreate,
 		Read:   detectorRead,
@@ -465,11 +488,23 @@ func getDetectorRule(tfRule map[string]any) (*detector.Rule, error) {
 		rule.Notifications = notify
 	}
 
+	if blocks, ok := tfRule["notification"].([]any); ok {
+		notify, err := notificationdef.DecodeTerraform(blocks)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", rule.DetectLabel, err)
+		}
+		rule.Notifications = append(rule.Notifications, notify...)
+	}
+
 	reminder := convert.ToReminderNotification(tfRule)
 	if reminder != nil {
 		rule.ReminderNotification = reminder
 	}
 
+	if err := applyDetectorEscalation(tfRule, rule); err != nil {
+		return nil, err
+	}
+
 	if states, ok := tfRule["skip_clear_notification_states"].(*schema.Set); ok {
 		for _, s := range states.List() {
 			rule.SkipClearNotificationStates = append(rule.SkipClearNotificationStates, s.(string))
@@ -559,6 +594,13 @@ func detectorCreate(d *schema.ResourceData, meta any) error {
 		payload.Teams,
 	)
 
+	payload.AuthorizedWriters.Teams = pmeta.MergeProviderWriterTeams(
+		context.TODO(),
+		meta,
+		payload.AuthorizedWriters.Teams,
+		payload.AuthorizedWriters.Users,
+	)
+
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Create Detector Payload: %s", string(debugOutput))
 
@@ -599,10 +641,10 @@ func detectorRead(d *schema.ResourceData, meta any) error {
 		return err
 	}
 
-	return detectorAPIToTF(d, det)
+	return detectorAPIToTF(d, det, meta)
 }
 
-func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector) error {
+func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector, meta any) error {
 	debugOutput, _ := json.Marshal(det)
 	log.Printf("[DEBUG] SignalFx: Got Detector to enState: %s", string(debugOutput))
 
@@ -636,7 +678,7 @@ func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector) error {
 	if err := d.Set("tags", det.Tags); err != nil {
 		return err
 	}
-	if err := d.Set("teams", det.Teams); err != nil {
+	if err := pmeta.SetProviderTeams(context.TODO(), meta, d, "teams", "teams_all", det.Teams); err != nil {
 		return err
 	}
 	if err := d.Set("detector_origin", det.DetectorOrigin); err != nil {
@@ -646,17 +688,18 @@ func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector) error {
 		return err
 	}
 
+	// The writer teams are always set so that teams removed outside
+	// of terraform do not remain in authorized_writer_teams_all.
+	var writerTeams []string
+	if det.AuthorizedWriters != nil {
+		writerTeams = det.AuthorizedWriters.Teams
+	}
+	if err := pmeta.SetProviderTeams(context.TODO(), meta, d, "authorized_writer_teams", "authorized_writer_teams_all", writerTeams); err != nil {
+		return err
+	}
+
 	if det.AuthorizedWriters != nil {
 		aw := det.AuthorizedWriters
-		if aw.Teams != nil && len(aw.Teams) > 0 {
-			teams := make([]any, len(aw.Teams))
-			for i, v := range aw.Teams {
-				teams[i] = v
-			}
-			if err := d.Set("authorized_writer_teams", schema.NewSet(schema.HashString, teams)); err != nil {
-				return err
-			}
-		}
 		if aw.Users != nil && len(aw.Users) > 0 {
 			users := make([]any, len(aw.Users))
 			for i, v := range aw.Users {
@@ -714,12 +757,16 @@ func detectorAPIToTF(d *schema.ResourceData, det *detector.Detector) error {
 		}
 	}
 
+	prior := priorDetectorRules(d)
 	rules := make([]map[string]any, len(det.Rules))
 	for i, r := range det.Rules {
 		rule, err := getTfDetectorRule(r)
 		if err != nil {
 			return err
 		}
+		if err := withPriorDetectorRule(rule, r, prior[r.DetectLabel]); err != nil {
+			return err
+		}
 		rules[i] = rule
 	}
 	if err := d.Set("rule", rules); err != nil {
@@ -763,6 +810,39 @@ func getTfDetectorRule(r *detector.Rule) (map[string]any, error) {
 	return rule, nil
 }
 
+// priorDetectorRules returns the rules in the current state keyed by their detect label.
+func priorDetectorRules(d *schema.ResourceData) map[string]map[string]any {
+	rules, ok := d.Get("rule").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	prior := make(map[string]map[string]any, rules.Len())
+	for _, raw := range rules.List() {
+		tfRule := raw.(map[string]any)
+		prior[tfRule["detect_label"].(string)] = tfRule
+	}
+	return prior
+}
+
+// withPriorDetectorRule keeps the rule read from the API in the same form as the prior rule,
+// so that notifications configured using an escalation policy or notification blocks are read the same way.
+func withPriorDetectorRule(rule map[string]any, r *detector.Rule, prior map[string]any) error {
+	if prior == nil || withDetectorEscalation(rule, r, prior) {
+		return nil
+	}
+
+	priorStrings, _ := prior["notifications"].([]any)
+	priorBlocks, _ := prior["notification"].([]any)
+	notifications, blocks, err := notificationdef.EncodeTerraformPrior(r.Notifications, priorStrings, priorBlocks)
+	if err != nil {
+		return err
+	}
+	rule["notifications"] = notifications
+	rule["notification"] = blocks
+	return nil
+}
+
 func detectorUpdate(d *schema.ResourceData, meta any) error {
 	config := meta.(*signalfxConfig)
 	payload, err := getPayloadDetector(d)
@@ -775,6 +855,19 @@ func detectorUpdate(d *schema.ResourceData, meta any) error {
 		payload.Tags,
 	)
 
+	payload.Teams = pmeta.MergeProviderTeams(
+		context.TODO(),
+		meta,
+		payload.Teams,
+	)
+
+	payload.AuthorizedWriters.Teams = pmeta.MergeProviderWriterTeams(
+		context.TODO(),
+		meta,
+		payload.AuthorizedWriters.Teams,
+		payload.AuthorizedWriters.Users,
+	)
+
 	debugOutput, _ := json.Marshal(payload)
 	log.Printf("[DEBUG] SignalFx: Update Detector Payload: %s", string(debugOutput))
 
@@ -793,7 +886,7 @@ func detectorUpdate(d *schema.ResourceData, meta any) error {
 	}
 	d.SetId(det.Id)
 
-	return detectorAPIToTF(d, det)
+	return detectorAPIToTF(d, det, meta)
 }
 
 func detectorDelete(d *schema.ResourceData, meta any) error {
@@ -884,7 +977,15 @@ func resourceRuleHash(v any) int {
 		}
 	}
 
-	// Sort the notifications so that we generate a consistent hash
+	// Escalation steps are ordered by their delay and their notifications are sorted
+	for _, step := range getDetectorEscalationSteps(m) {
+		notifications := slices.Clone(step.Notifications)
+		sort.Strings(notifications)
+		buf.WriteString(fmt.Sprintf("escalation-%d-%s-", step.AfterMinutes, strings.Join(notifications, "-")))
+	}
+
+	// Sort the notifications so that we generate a consistent hash,
+	// notification blocks are included as strings so that either form hashes the same
 	if v, ok := m["notifications"]; ok {
 		notifications := v.([]any)
 		s_notifications := make([]string, len(notifications))
@@ -893,6 +994,9 @@ func resourceRuleHash(v any) int {
 				s_notifications[i] = raw.(string)
 			}
 		}
+		if blocks, ok := m["notification"].([]any); ok {
+			s_notifications = append(s_notifications, notificationdef.Strings(blocks)...)
+		}
 		sort.Strings(s_notifications)
 
 		for _, notification := range s_notifications {
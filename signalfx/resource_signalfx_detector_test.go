@@ -107,6 +107,54 @@ func TestChangesInReminderNotificationRuleHashing(t *testing.T) {
 	assert.NotEqual(t, hashWithChangedTimeout, hashWithoutReminder)
 }
 
+func TestNotificationBlocksRuleHashing(t *testing.T) {
+	rule := func(strs []any, blocks []any) map[string]any {
+		return map[string]any{
+			"description":   "Test Rule Name",
+			"severity":      "Critical",
+			"detect_label":  "Test Detect Label",
+			"disabled":      true,
+			"notifications": strs,
+			"notification":  blocks,
+		}
+	}
+	slack := map[string]any{"slack": []any{map[string]any{"credential_id": "cred", "channel": "alerts"}}}
+
+	hashWithStrings := resourceRuleHash(rule([]any{"Email,test@example.com", "Slack,cred,alerts"}, nil))
+	hashWithBlocks := resourceRuleHash(rule([]any{"Email,test@example.com"}, []any{slack}))
+	assert.Equal(t, hashWithStrings, hashWithBlocks, "Must hash notification blocks the same as strings")
+	assert.NotEqual(t, hashWithStrings, resourceRuleHash(rule([]any{"Email,test@example.com"}, nil)))
+}
+
+func TestWithPriorDetectorRule(t *testing.T) {
+	slack := map[string]any{"slack": []any{map[string]any{"credential_id": "cred", "channel": "alerts"}}}
+	tfRule := map[string]any{
+		"description":   "",
+		"disabled":      false,
+		"severity":      "Critical",
+		"detect_label":  "CPU",
+		"notifications": []any{"Email,test@example.com"},
+		"notification":  []any{slack},
+	}
+
+	r, err := getDetectorRule(tfRule)
+	assert.NoError(t, err, "Must not error converting the rule")
+	assert.Len(t, r.Notifications, 2, "Must combine the strings and blocks")
+
+	rule, err := getTfDetectorRule(r)
+	assert.NoError(t, err, "Must not error reading the rule")
+	assert.NoError(t, withPriorDetectorRule(rule, r, tfRule), "Must not error applying the prior rule")
+	assert.Equal(t, []string{"Email,test@example.com"}, rule["notifications"], "Must keep the strings")
+	assert.Equal(t, []map[string]any{
+		{"slack": []any{map[string]any{"credential_id": "cred", "channel": "alerts"}}},
+	}, rule["notification"], "Must keep the blocks")
+
+	rule, err = getTfDetectorRule(r)
+	assert.NoError(t, err, "Must not error reading the rule")
+	assert.NoError(t, withPriorDetectorRule(rule, r, nil), "Must not error without a prior rule")
+	assert.Equal(t, []string{"Email,test@example.com", "Slack,cred,alerts"}, rule["notifications"], "Must read strings when imported")
+}
+
 func TestValidateSeverityAllowed(t *testing.T) {
 	_, errors := validateSeverity("Critical", "severity")
 	assert.Equal(t, len(errors), 0)
@@ -11,6 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	chart "github.com/signalfx/signalfx-go/chart"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
 )
 
 func eventFeedChartResource() *schema.Resource {
@@ -22,10 +24,11 @@ func eventFeedChartResource() *schema.Resource {
 				Description: "Name of the chart",
 			},
 			"program_text": &schema.Schema{
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Signalflow program text for the chart. More info at \"https://developers.signalfx.com/docs/signalflow-overview\"",
-				ValidateFunc: validation.StringLenBetween(18, 50000),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Signalflow program text for the chart. More info at \"https://developers.signalfx.com/docs/signalflow-overview\"",
+				ValidateFunc:     validation.StringLenBetween(18, 50000),
+				DiffSuppressFunc: common.SuppressSignalFlowDiff,
 			},
 			"description": &schema.Schema{
 				Type:        schema.TypeString,
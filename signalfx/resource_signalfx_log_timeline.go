@@ -25,10 +25,11 @@ func logTimelineResource() *schema.Resource {
 				Description: "Name of the chart",
 			},
 			"program_text": &schema.Schema{
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Signalflow program text for the chart. More info at \"https://developers.signalfx.com/docs/signalflow-overview\"",
-				ValidateFunc: validation.StringLenBetween(16, 50000),
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Signalflow program text for the chart. More info at \"https://developers.signalfx.com/docs/signalflow-overview\"",
+				ValidateFunc:     validation.StringLenBetween(16, 50000),
+				DiffSuppressFunc: common.SuppressSignalFlowDiff,
 			},
 			"description": &schema.Schema{
 				Type:        schema.TypeString,
@@ -233,9 +233,9 @@ resource "signalfx_metric_ruleset" "demo_trans_count_metric_ruleset" {
 
 func TestAccMetricRulesetAggregation(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccMetricRulesetDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviderFactories,
+		CheckDestroy:             testAccMetricRulesetDestroy,
 		Steps: []resource.TestStep{
 			// Validate plan
 			{
@@ -317,9 +317,9 @@ func TestAccMetricRulesetAggregation(t *testing.T) {
 
 func TestAccMetricRulesetArchived(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccMetricRulesetDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviderFactories,
+		CheckDestroy:             testAccMetricRulesetDestroy,
 		Steps: []resource.TestStep{
 			// Validate plan
 			{
@@ -527,9 +527,9 @@ resource "signalfx_metric_ruleset" "cart_size" {
 }	`, startTime, stopTime)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccMetricRulesetDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviderFactories,
+		CheckDestroy:             testAccMetricRulesetDestroy,
 		Steps: []resource.TestStep{
 			// Validate plan
 			{
@@ -646,9 +646,9 @@ resource "signalfx_metric_ruleset" "cart_size" {
 }	`, startTime)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccMetricRulesetDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviderFactories,
+		CheckDestroy:             testAccMetricRulesetDestroy,
 		Steps: []resource.TestStep{
 			// Validate plan
 			{
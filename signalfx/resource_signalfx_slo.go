@@ -4,18 +4,17 @@
 package signalfx
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"sort"
-	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/signalfx/signalfx-go/detector"
 	"github.com/signalfx/signalfx-go/slo"
+
+	"github.com/splunk-terraform/terraform-provider-signalfx/internal/common"
 )
 
 const (
@@ -45,8 +44,9 @@ const (
 	burnRateThreshold2Label     = "burn_rate_threshold_2"
 )
 
+// sloResource returns the schema of the SLO as it was managed by the SDK, which the data sources read SLOs into.
+// The signalfx_slo resource itself is implemented with the plugin framework.
 func sloResource() *schema.Resource {
-
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			nameLabel: {
@@ -79,7 +79,8 @@ func sloResource() *schema.Resource {
 							Required: true,
 							Description: "Signalflow program text for the SLO. More info at \"https://dev.splunk.com/observability/docs/signalflow\". " +
 								"We require this Signalflow program text to contain at least 2 data blocks - one for the total stream and one for the good stream, whose labels are specified by goodEventsLabel and totalEventsLabel",
-							ValidateFunc: validation.StringLenBetween(18, 50000),
+							ValidateFunc:     validation.StringLenBetween(18, 50000),
+							DiffSuppressFunc: common.SuppressSignalFlowDiff,
 						},
 						goodEventsLabel: {
 							Type:         schema.TypeString,
@@ -239,342 +240,13 @@ func sloResource() *schema.Resource {
 				},
 			},
 		},
-
-		SchemaVersion: 1,
-
-		CustomizeDiff: sloValidate,
-		CreateContext: sloCreate,
-		ReadContext:   sloRead,
-		UpdateContext: sloUpdate,
-		DeleteContext: sloDelete,
-
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-	}
-}
-
-func sloValidate(ctx context.Context, sloObject *schema.ResourceDiff, config interface{}) error {
-	payloadSlo, err := getPayloadSlo(sloObject)
-
-	// we are checking the uniqueness of the slo name, so we need some randomness here if it's an SLO update(resource ID exists)
-	if sloObject.Id() != "" {
-		payloadSlo.Name = payloadSlo.Name + time.Now().String()
-	}
-
-	if err != nil {
-		return err
-	}
-
-	err = config.(*signalfxConfig).Client.ValidateSlo(ctx, payloadSlo)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func sloCreate(ctx context.Context, sloResource *schema.ResourceData, config interface{}) diag.Diagnostics {
-	client := config.(*signalfxConfig).Client
-	payload, err := getPayloadSlo(sloResource)
-	if err != nil {
-		return diag.Errorf("Failed creating SLO json payload: %s", err.Error())
-	}
-
-	debugOutput, _ := json.Marshal(payload)
-	log.Printf("[DEBUG] Create SLO Payload: %s", string(debugOutput))
-
-	createdSlo, err := client.CreateSlo(ctx, payload)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	id := createdSlo.Id
-	sloResource.SetId(id)
-
-	err = sloAPIToTF(sloResource, createdSlo)
-	return diag.FromErr(err)
-}
-
-func sloUpdate(ctx context.Context, sloResource *schema.ResourceData, config interface{}) diag.Diagnostics {
-	client := config.(*signalfxConfig).Client
-	payload, err := getPayloadSlo(sloResource)
-	if err != nil {
-		return diag.Errorf("Failed creating SLO json payload: %s", err.Error())
-	}
-
-	debugOutput, _ := json.Marshal(payload)
-	log.Printf("[DEBUG] Update SLO Payload: %s", string(debugOutput))
-
-	updatedSlo, err := client.UpdateSlo(ctx, sloResource.Id(), payload)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = sloAPIToTF(sloResource, updatedSlo)
-	return diag.FromErr(err)
-}
-
-func sloRead(ctx context.Context, d *schema.ResourceData, config interface{}) diag.Diagnostics {
-	client := config.(*signalfxConfig).Client
-
-	returnedSlo, err := client.GetSlo(ctx, d.Id())
-	if err != nil {
-		if isNotFoundError(err) {
-			d.SetId("")
-			return nil
-		}
-		return diag.FromErr(err)
-	}
-
-	err = sloAPIToTF(d, returnedSlo)
-	return diag.FromErr(err)
-}
-
-func sloDelete(ctx context.Context, d *schema.ResourceData, config interface{}) diag.Diagnostics {
-	client := config.(*signalfxConfig).Client
-
-	err := client.DeleteSlo(ctx, d.Id())
-
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
-}
-
-// getPayloadSlo is called with objects: *schema.ResourceDiff or *schema.ResourceData - that's why we need this interface to have only one method
-type Resource interface {
-	Get(key string) interface{}
-}
-
-func getPayloadSlo(sloTfResource Resource) (*slo.SloObject, error) {
-	sloApiObject := &slo.SloObject{
-		BaseSlo: slo.BaseSlo{
-			Name:        sloTfResource.Get(nameLabel).(string),
-			Description: sloTfResource.Get(descriptionLabel).(string),
-			Type:        sloTfResource.Get(typeLabel).(string),
-		},
-	}
-
-	targets, err := getApiTargets(sloTfResource.Get(targetLabel).([]interface{}))
-	if err != nil {
-		return nil, err
-	}
-	sloApiObject.Targets = targets
-
-	return setSloInput(sloTfResource, sloApiObject)
-}
-
-func getApiTargets(tfTargets []interface{}) ([]slo.SloTarget, error) {
-	apiTargets := make([]slo.SloTarget, len(tfTargets))
-
-	for ind, rawTfTarget := range tfTargets {
-		tfTarget := rawTfTarget.(map[string]interface{})
-
-		apiTarget := slo.SloTarget{
-			BaseSloTarget: slo.BaseSloTarget{
-				Slo:  tfTarget[sloLabel].(float64),
-				Type: tfTarget[typeLabel].(string),
-			},
-		}
-
-		switch apiTarget.Type {
-		case slo.RollingWindowTarget:
-			apiTarget.RollingWindowSloTarget = &slo.RollingWindowSloTarget{
-				CompliancePeriod: tfTarget[compliancePeriodLabel].(string),
-			}
-		case slo.CalendarWindowTarget:
-			apiTarget.CalendarWindowSloTarget = &slo.CalendarWindowSloTarget{
-				CycleStart: tfTarget[cycleStartLabel].(string),
-				CycleType:  tfTarget[cycleTypeLabel].(string),
-			}
-		default:
-			return nil, fmt.Errorf("unsupported SLO target type: %s", apiTarget.Type)
-		}
-
-		alertRules, err := getApiAlertRules(tfTarget[alertRuleLabel].([]interface{}))
-		if err != nil {
-			return nil, err
-		}
-
-		apiTarget.SloAlertRules = alertRules
-		apiTargets[ind] = apiTarget
-	}
-
-	return apiTargets, nil
-
-}
-
-func getApiAlertRules(tfAlertRules []interface{}) ([]slo.SloAlertRule, error) {
-	apiAlertRules := make([]slo.SloAlertRule, len(tfAlertRules))
-
-	for ind, rawTfAlertRule := range tfAlertRules {
-		tfAlertRule := rawTfAlertRule.(map[string]interface{})
-
-		alertType := tfAlertRule[typeLabel].(string)
-		switch alertType {
-		case slo.BreachRule:
-			detectorRules, err := getApiDetectorRules[slo.BreachDetectorRule](
-				tfAlertRule[ruleLabel].([]interface{}),
-				func(rule *detector.Rule) *slo.BreachDetectorRule {
-					return &slo.BreachDetectorRule{
-						Rule: *rule,
-					}
-				},
-				func(rule *slo.BreachDetectorRule, parameters map[string]interface{}) {
-					rule.Parameters = &slo.BreachDetectorParameters{
-						FireLasting:      parameters[fireLastingLabel].(string),
-						PercentOfLasting: parameters[percentOfLastingLabel].(float64),
-					}
-				},
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			apiAlertRules[ind].BreachSloAlertRule = &slo.BreachSloAlertRule{
-				Rules: detectorRules,
-			}
-			apiAlertRules[ind].Type = alertType
-
-		case slo.ErrorBudgetLeftRule:
-			detectorRules, err := getApiDetectorRules[slo.ErrorBudgetLeftDetectorRule](
-				tfAlertRule[ruleLabel].([]interface{}),
-				func(rule *detector.Rule) *slo.ErrorBudgetLeftDetectorRule {
-					return &slo.ErrorBudgetLeftDetectorRule{
-						Rule: *rule,
-					}
-				},
-				func(rule *slo.ErrorBudgetLeftDetectorRule, parameters map[string]interface{}) {
-					rule.Parameters = &slo.ErrorBudgetLeftDetectorParameters{
-						FireLasting:            parameters[fireLastingLabel].(string),
-						PercentOfLasting:       parameters[percentOfLastingLabel].(float64),
-						PercentErrorBudgetLeft: parameters[percentErrorBudgetLeftLabel].(float64),
-					}
-				},
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			apiAlertRules[ind].ErrorBudgetLeftSloAlertRule = &slo.ErrorBudgetLeftSloAlertRule{
-				Rules: detectorRules,
-			}
-			apiAlertRules[ind].Type = alertType
-		case slo.BurnRateRule:
-			detectorRules, err := getApiDetectorRules[slo.BurnRateDetectorRule](
-				tfAlertRule[ruleLabel].([]interface{}),
-				func(rule *detector.Rule) *slo.BurnRateDetectorRule {
-					return &slo.BurnRateDetectorRule{
-						Rule: *rule,
-					}
-				},
-				func(rule *slo.BurnRateDetectorRule, parameters map[string]interface{}) {
-					rule.Parameters = &slo.BurnRateDetectorParameters{
-						ShortWindow1:       parameters[shortWindow1Label].(string),
-						LongWindow1:        parameters[longWindow1Label].(string),
-						ShortWindow2:       parameters[shortWindow2Label].(string),
-						LongWindow2:        parameters[longWindow2Label].(string),
-						BurnRateThreshold1: parameters[burnRateThreshold1Label].(float64),
-						BurnRateThreshold2: parameters[burnRateThreshold2Label].(float64),
-					}
-				},
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			apiAlertRules[ind].BurnRateSloAlertRule = &slo.BurnRateSloAlertRule{
-				Rules: detectorRules,
-			}
-			apiAlertRules[ind].Type = alertType
-		default:
-			return nil, fmt.Errorf("unsupported SLO alert rule type: %s", alertType)
-		}
 	}
-
-	return apiAlertRules, nil
 }
 
 type DetectorRuleType interface {
 	slo.BreachDetectorRule | slo.ErrorBudgetLeftDetectorRule | slo.BurnRateDetectorRule
 }
 
-func getApiDetectorRules[DetectorRule DetectorRuleType](
-	tfRules []interface{},
-	newSloDetectorRule func(*detector.Rule) *DetectorRule,
-	setSloDetectorParameters func(rule *DetectorRule, source map[string]interface{})) ([]*DetectorRule, error) {
-
-	apiDetectorRules := make([]*DetectorRule, len(tfRules))
-
-	for ind, tfRule := range tfRules {
-		detectorRule, err := getDetectorRule(tfRule.(map[string]interface{}))
-		if err != nil {
-			return nil, err
-		}
-
-		apiDetectorRules[ind] = newSloDetectorRule(detectorRule)
-
-		parameters, err := getParametersFromRule(tfRule.(map[string]interface{}))
-		if err != nil {
-			return nil, err
-		}
-
-		if parameters != nil {
-			setSloDetectorParameters(apiDetectorRules[ind], parameters)
-		}
-	}
-	return apiDetectorRules, nil
-}
-
-func getParametersFromRule(tfRule map[string]interface{}) (map[string]interface{}, error) {
-	parameters := tfRule[parametersLabel].([]interface{})
-
-	switch len(parameters) {
-	case 0:
-		return nil, nil
-	case 1:
-		if parameters[0] != nil {
-			return parameters[0].(map[string]interface{}), nil
-		} else {
-			return nil, nil
-		}
-	default:
-		return nil, fmt.Errorf("expecting at most one parameter to be present")
-	}
-}
-
-func setSloInput(sloTfResource Resource, sloApiObject *slo.SloObject) (*slo.SloObject, error) {
-	switch sloApiObject.Type {
-	case slo.RequestBased:
-		requestBasedInput, err := getRequestBasedApiInput(sloTfResource)
-		if err != nil {
-			return nil, err
-		}
-		sloApiObject.RequestBasedSlo = &slo.RequestBasedSlo{requestBasedInput}
-	default:
-		return nil, fmt.Errorf("unsupported SLO type: %s", sloApiObject.Type)
-	}
-	return sloApiObject, nil
-}
-
-func getRequestBasedApiInput(sloTfResource Resource) (*slo.RequestBasedSloInput, error) {
-	inputs := sloTfResource.Get(inputLabel).([]interface{})
-
-	if len(inputs) != 1 {
-		return nil, fmt.Errorf("expecting exactly one input to be present")
-	}
-
-	tfInput := inputs[0].(map[string]interface{})
-	requestBasedInput := &slo.RequestBasedSloInput{
-		ProgramText:      tfInput[programTextLabel].(string),
-		GoodEventsLabel:  tfInput[goodEventsLabel].(string),
-		TotalEventsLabel: tfInput[totalEventsLabel].(string),
-	}
-	return requestBasedInput, nil
-}
-
 func sloAPIToTF(sloTfResource *schema.ResourceData, sloApiObject *slo.SloObject) error {
 	debugOutput, _ := json.Marshal(sloApiObject)
 	log.Printf("[DEBUG] Convert SLO to TF State: %s", string(debugOutput))
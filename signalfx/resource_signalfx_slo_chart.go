@@ -66,11 +66,11 @@ func slochartCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		return diag.FromErr(err)
 	}
 	d.SetId(sloChart.Id)
-	return slochartAPIToTF(d, sloChart)
+	return diag.FromErr(slochartAPIToTF(d, sloChart))
 }
 
-func slochartAPIToTF(d *schema.ResourceData, c *chart.Chart) diag.Diagnostics {
-	return diag.FromErr(d.Set("slo_id", c.SloId))
+func slochartAPIToTF(d *schema.ResourceData, c *chart.Chart) error {
+	return d.Set("slo_id", c.SloId)
 }
 
 func slochartRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -91,7 +91,7 @@ func slochartRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		return diag.FromErr(err)
 	}
 
-	return slochartAPIToTF(d, sloChart)
+	return diag.FromErr(slochartAPIToTF(d, sloChart))
 }
 
 func slochartUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -106,7 +106,7 @@ func slochartUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.
 	tflog.Debug(ctx, "SLO chart update response", tfext.NewLogFields().JSON("response", c))
 
 	d.SetId(c.Id)
-	return slochartAPIToTF(d, c)
+	return diag.FromErr(slochartAPIToTF(d, c))
 }
 
 func slochartDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
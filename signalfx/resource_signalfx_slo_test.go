@@ -59,7 +59,7 @@ resource "signalfx_slo" "test_slo" {
 				notifications = ["%s"]
 				parameterized_body = "test"
 				parameterized_subject = "test"
-				parameters {
+				breach_parameters {
 					fire_lasting = "%s"
 				}
 			}
@@ -72,7 +72,7 @@ resource "signalfx_slo" "test_slo" {
 				severity = "Warning"
 				parameterized_body = "test"
 				parameterized_subject = "test"
-				parameters {
+				burn_rate_parameters {
 					short_window_1 = "%s"
 				}
 			}
@@ -105,7 +105,7 @@ resource "signalfx_slo" "test_slo" {
 				notifications = ["%s"]
 				parameterized_body = "test"
 				parameterized_subject = "test"
-				parameters {
+				breach_parameters {
 					fire_lasting = "%s"
 				}
 			}
@@ -118,7 +118,7 @@ resource "signalfx_slo" "test_slo" {
 				severity = "Warning"
 				parameterized_body = "test"
 				parameterized_subject = "test"	
-				parameters {
+				error_budget_left_parameters {
 					percent_error_budget_left = %s					
 				}
 			}
@@ -182,9 +182,9 @@ resource "signalfx_slo" "test_slo" {
 func TestAccCreateUpdateSlo(t *testing.T) {
 	const sloResourceName = "signalfx_slo.test_slo"
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccSloDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccMuxedProviderFactories,
+		CheckDestroy:             testAccSloDestroy,
 		Steps: []resource.TestStep{
 			// Check invalid slo programText input
 			{
@@ -196,7 +196,7 @@ func TestAccCreateUpdateSlo(t *testing.T) {
 			{
 				Config:      invalidSloTargetValue,
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("expected target.0.slo to be in the range \\(0.000000 - 100.000000\\), got 101.000000"),
+				ExpectError: regexp.MustCompile(`target\[0\]\.slo value must be between 0 and 100, got: 101`),
 			},
 			// Validate plan
 			{
@@ -229,14 +229,14 @@ func TestAccCreateUpdateSlo(t *testing.T) {
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.severity", "Critical"),
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.notifications.#", "1"),
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.notifications.0", alertNotification),
-					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.parameters.#", "1"),
-					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.parameters.0.fire_lasting", fireLasting),
+					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.breach_parameters.#", "1"),
+					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.breach_parameters.0.fire_lasting", fireLasting),
 
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.type", "BURN_RATE"),
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.severity", "Warning"),
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.notifications.#", "0"),
-					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.parameters.#", "1"),
-					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.parameters.0.short_window_1", shortWindow),
+					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.burn_rate_parameters.#", "1"),
+					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.burn_rate_parameters.0.short_window_1", shortWindow),
 
 					// Force sleep before refresh at the end of test execution
 					waitBeforeTestStepPlanRefresh,
@@ -273,14 +273,14 @@ func TestAccCreateUpdateSlo(t *testing.T) {
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.severity", "Critical"),
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.notifications.#", "1"),
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.notifications.0", updatedAlertNotification),
-					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.parameters.#", "1"),
-					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.parameters.0.fire_lasting", updateFireLasting),
+					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.breach_parameters.#", "1"),
+					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.0.rule.0.breach_parameters.0.fire_lasting", updateFireLasting),
 
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.type", "ERROR_BUDGET_LEFT"),
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.severity", "Warning"),
 					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.notifications.#", "0"),
-					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.parameters.#", "1"),
-					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.parameters.0.percent_error_budget_left", strconv.Itoa(percentErrorBudgetLeft)),
+					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.error_budget_left_parameters.#", "1"),
+					resource.TestCheckResourceAttr(sloResourceName, "target.0.alert_rule.1.rule.0.error_budget_left_parameters.0.percent_error_budget_left", strconv.Itoa(percentErrorBudgetLeft)),
 				),
 			},
 		},